@@ -0,0 +1,49 @@
+// Command schemagen writes the JSON Schema of the given events, identified
+// by schema ID (e.g. dev.colibri.school.course.deleted.v1), to a directory.
+//
+//	schemagen -out contracts/schemas <schema id>...
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+)
+
+func main() {
+	out := flag.String("out", "contracts/schemas", "directory the schemas are written to")
+	flag.Parse()
+
+	if err := run(*out, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "schemagen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(out string, schemaIDs []string) error {
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+
+	for _, schemaID := range schemaIDs {
+		data, ok := contracts.LookupEvent(schemaID)
+		if !ok {
+			return fmt.Errorf("unknown event %s", schemaID)
+		}
+
+		content, err := json.MarshalIndent(contracts.NewSchema(data), "", "  ")
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(out, schemaID+".json"), append(content, '\n'), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
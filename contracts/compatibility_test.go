@@ -0,0 +1,108 @@
+package contracts
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// The compatibility suite reads what every module of the repository
+// publishes: the schemas of the events it produces, in contracts/schemas,
+// and the expectations of its consumers, in contracts/expectations.
+const (
+	publishedSchemas = "../*/contracts/schemas/*.json"
+	consumerExpects  = "../*/contracts/expectations/*.json"
+)
+
+func readPublishedSchemas(t *testing.T) map[string]*Schema {
+	t.Helper()
+
+	files, err := filepath.Glob(publishedSchemas)
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no published schema found in %s: %v", publishedSchemas, err)
+	}
+
+	schemas := map[string]*Schema{}
+	for _, file := range files {
+		var schema Schema
+		readJSON(t, file, &schema)
+
+		if schema.ID != strings.TrimSuffix(filepath.Base(file), ".json") {
+			t.Errorf("%s: $id %s does not match the file name", file, schema.ID)
+		}
+
+		if _, exists := schemas[schema.ID]; exists {
+			t.Errorf("%s: %s is published by more than one module", file, schema.ID)
+		}
+
+		schemas[schema.ID] = &schema
+	}
+
+	return schemas
+}
+
+func readJSON(t *testing.T, file string, v any) []byte {
+	t.Helper()
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.Unmarshal(content, v); err != nil {
+		t.Fatalf("%s: %v", file, err)
+	}
+
+	return content
+}
+
+func TestPublishedSchemasAreUpToDate(t *testing.T) {
+	files, _ := filepath.Glob(publishedSchemas)
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			var published Schema
+			content := readJSON(t, file, &published)
+
+			data, ok := LookupEvent(published.ID)
+			if !ok {
+				t.Fatalf("%s is not a registered event", published.ID)
+			}
+
+			expected, _ := json.MarshalIndent(NewSchema(data), "", "  ")
+			if !bytes.Equal(bytes.TrimSpace(content), expected) {
+				t.Errorf("%s is stale, run go generate in the producer module", file)
+			}
+		})
+	}
+}
+
+func TestConsumerExpectationsAreSatisfied(t *testing.T) {
+	schemas := readPublishedSchemas(t)
+
+	files, err := filepath.Glob(consumerExpects)
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no consumer expectation found in %s: %v", consumerExpects, err)
+	}
+
+	for _, file := range files {
+		var expectation Expectation
+		readJSON(t, file, &expectation)
+
+		for _, event := range expectation.Events {
+			t.Run(expectation.Consumer+"/"+event.SchemaID(), func(t *testing.T) {
+				schema, published := schemas[event.SchemaID()]
+				if !published {
+					t.Fatalf("%s: no module publishes %s", file, event.SchemaID())
+				}
+
+				if err := schema.Satisfies(event); err != nil {
+					t.Errorf("%s: %v", file, err)
+				}
+			})
+		}
+	}
+}
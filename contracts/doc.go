@@ -0,0 +1,8 @@
+// Package contracts defines the events exchanged between the modules.
+//
+// Every event travels in a CloudEvents 1.0 envelope (see Event) whose type
+// names the event and whose schemaversion extension names the shape of its
+// data. Each shape is a Go type implementing Data, suffixed with its version
+// (e.g. EnrollmentCreatedV1). A breaking change adds a new version next to
+// the previous one, so consumers can accept both while producers migrate.
+package contracts
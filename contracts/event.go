@@ -0,0 +1,87 @@
+package contracts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SpecVersion     = "1.0"
+	DataContentType = "application/json"
+)
+
+var (
+	ErrInvalidEvent       = errors.New("invalid event")
+	ErrUnexpectedDataType = errors.New("unexpected event data type")
+)
+
+// Data is the payload of an event, identified by its type and schema version.
+type Data interface {
+	EventType() string
+	SchemaVersion() int
+}
+
+// Event is a CloudEvents 1.0 envelope in structured JSON mode.
+type Event struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	SchemaVersion   int             `json:"schemaversion"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// NewEvent wraps data in a new envelope with a unique ID.
+func NewEvent(source string, data Data) (*Event, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		SpecVersion:     SpecVersion,
+		ID:              uuid.NewString(),
+		Source:          source,
+		Type:            data.EventType(),
+		Time:            time.Now().UTC(),
+		DataContentType: DataContentType,
+		SchemaVersion:   data.SchemaVersion(),
+		Data:            raw,
+	}, nil
+}
+
+// Validate checks the attributes required by CloudEvents and by the contracts.
+func (e *Event) Validate() error {
+	switch {
+	case e.SpecVersion != SpecVersion:
+		return fmt.Errorf("%w: unsupported specversion %q", ErrInvalidEvent, e.SpecVersion)
+	case e.ID == "":
+		return fmt.Errorf("%w: missing id", ErrInvalidEvent)
+	case e.Source == "":
+		return fmt.Errorf("%w: missing source", ErrInvalidEvent)
+	case e.Type == "":
+		return fmt.Errorf("%w: missing type", ErrInvalidEvent)
+	case e.SchemaVersion <= 0:
+		return fmt.Errorf("%w: missing schemaversion", ErrInvalidEvent)
+	case len(e.Data) == 0:
+		return fmt.Errorf("%w: missing data", ErrInvalidEvent)
+	}
+
+	return nil
+}
+
+// DecodeData unmarshals the event data into model, which must be the type
+// registered for the event type and schema version.
+func (e *Event) DecodeData(model Data) error {
+	if model.EventType() != e.Type || model.SchemaVersion() != e.SchemaVersion {
+		return fmt.Errorf("%w: %s v%d into %T", ErrUnexpectedDataType, e.Type, e.SchemaVersion, model)
+	}
+
+	return json.Unmarshal(e.Data, model)
+}
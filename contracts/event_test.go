@@ -0,0 +1,94 @@
+package contracts
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewEvent(t *testing.T) {
+	data := CourseDeletedV1{ID: uuid.New()}
+
+	event, err := NewEvent(SchoolSource, data)
+	if err != nil {
+		t.Fatalf("NewEvent() error = %v", err)
+	}
+
+	if err := event.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	if event.Type != CourseDeletedType || event.SchemaVersion != 1 || event.Source != SchoolSource {
+		t.Errorf("NewEvent() = %+v, unexpected attributes", event)
+	}
+
+	var decoded CourseDeletedV1
+	if err := event.DecodeData(&decoded); err != nil {
+		t.Fatalf("DecodeData() error = %v", err)
+	}
+
+	if decoded != data {
+		t.Errorf("DecodeData() = %+v, want %+v", decoded, data)
+	}
+}
+
+func TestEvent_JSONRoundTrip(t *testing.T) {
+	event, _ := NewEvent(SchoolSource, StudentDeletedV1{ID: uuid.New()})
+
+	raw, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var decoded Event
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if err := decoded.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestEvent_Validate(t *testing.T) {
+	tests := map[string]func(e *Event){
+		"specversion":   func(e *Event) { e.SpecVersion = "0.3" },
+		"id":            func(e *Event) { e.ID = "" },
+		"source":        func(e *Event) { e.Source = "" },
+		"type":          func(e *Event) { e.Type = "" },
+		"schemaversion": func(e *Event) { e.SchemaVersion = 0 },
+		"data":          func(e *Event) { e.Data = nil },
+	}
+
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			event, _ := NewEvent(SchoolSource, CourseDeletedV1{ID: uuid.New()})
+			mutate(event)
+
+			if err := event.Validate(); !errors.Is(err, ErrInvalidEvent) {
+				t.Errorf("Validate() error = %v, want %v", err, ErrInvalidEvent)
+			}
+		})
+	}
+}
+
+func TestEvent_DecodeData(t *testing.T) {
+	event, _ := NewEvent(SchoolSource, CourseDeletedV1{ID: uuid.New()})
+
+	t.Run("other type", func(t *testing.T) {
+		if err := event.DecodeData(&StudentDeletedV1{}); !errors.Is(err, ErrUnexpectedDataType) {
+			t.Errorf("DecodeData() error = %v, want %v", err, ErrUnexpectedDataType)
+		}
+	})
+
+	t.Run("other schema version", func(t *testing.T) {
+		event := *event
+		event.SchemaVersion = 2
+
+		if err := event.DecodeData(&CourseDeletedV1{}); !errors.Is(err, ErrUnexpectedDataType) {
+			t.Errorf("DecodeData() error = %v, want %v", err, ErrUnexpectedDataType)
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package contracts
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var ErrBrokenContract = errors.New("broken contract")
+
+// Expectation declares the events a consumer reads and, for each of them,
+// the data fields it relies on.
+type Expectation struct {
+	Consumer string             `json:"consumer"`
+	Events   []EventExpectation `json:"events"`
+}
+
+// EventExpectation maps the dot separated path of every field a consumer
+// requires (e.g. "course.value") to its JSON type.
+type EventExpectation struct {
+	Type          string            `json:"type"`
+	SchemaVersion int               `json:"schemaVersion"`
+	Fields        map[string]string `json:"fields"`
+}
+
+func (e EventExpectation) SchemaID() string {
+	return SchemaID(e.Type, e.SchemaVersion)
+}
+
+// Satisfies checks that every field expected by the consumer is always sent
+// by the producer, with the expected type.
+func (s *Schema) Satisfies(expectation EventExpectation) error {
+	paths := make([]string, 0, len(expectation.Fields))
+	for path := range expectation.Fields {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	var errs []error
+	for _, path := range paths {
+		if err := s.satisfiesField(path, expectation.Fields[path]); err != nil {
+			errs = append(errs, fmt.Errorf("%w: %s %s", ErrBrokenContract, expectation.SchemaID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Schema) satisfiesField(path, fieldType string) error {
+	current := s
+	for name := range strings.SplitSeq(path, ".") {
+		property, exists := current.Properties[name]
+		if !exists {
+			return fmt.Errorf("does not have field %s", path)
+		}
+
+		if !slices.Contains(current.Required, name) {
+			return fmt.Errorf("does not require field %s", path)
+		}
+
+		current = property
+	}
+
+	if current.Type != fieldType {
+		return fmt.Errorf("field %s is %s, expected %s", path, current.Type, fieldType)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package contracts
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSchema_Satisfies(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     Data
+		fields   map[string]string
+		expected string
+	}{
+		{
+			name:   "required fields",
+			data:   EnrollmentCreatedV2{},
+			fields: map[string]string{"student.id": "string", "course.value": "number", "installments": "integer"},
+		},
+		{
+			name:     "missing field",
+			data:     EnrollmentCreatedV2{},
+			fields:   map[string]string{"course.name": "string"},
+			expected: "does not have field course.name",
+		},
+		{
+			name:     "optional field",
+			data:     EnrollmentCreatedV1{},
+			fields:   map[string]string{"status": "string"},
+			expected: "does not require field status",
+		},
+		{
+			name:     "optional parent",
+			data:     EnrollmentCreatedV1{},
+			fields:   map[string]string{"course.id": "string"},
+			expected: "does not require field course.id",
+		},
+		{
+			name:     "other type",
+			data:     CourseCreatedV1{},
+			fields:   map[string]string{"value": "string"},
+			expected: "field value is number, expected string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectation := EventExpectation{
+				Type:          tt.data.EventType(),
+				SchemaVersion: tt.data.SchemaVersion(),
+				Fields:        tt.fields,
+			}
+
+			err := NewSchema(tt.data).Satisfies(expectation)
+
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("Satisfies() error = %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, ErrBrokenContract) || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Satisfies() error = %v, want %q", err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package contracts
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FinantialSource = "/colibri/finantial-module"
+
+	AccountCreatedType       = "dev.colibri.finantial.account.created"
+	AccountRejectedType      = "dev.colibri.finantial.account.rejected"
+	AccountStatusUpdatedType = "dev.colibri.finantial.account.status-updated"
+	AccountRenegotiatedType  = "dev.colibri.finantial.account.renegotiated"
+)
+
+// AccountCreatedV1 confirms that the account of an enrollment was opened.
+type AccountCreatedV1 struct {
+	ID           uuid.UUID   `json:"id" validate:"required"`
+	StudentID    uuid.UUID   `json:"studentId" validate:"required"`
+	CourseID     uuid.UUID   `json:"courseId" validate:"required"`
+	Installments uint8       `json:"installments" validate:"required"`
+	Value        json.Number `json:"value" validate:"required"`
+	CreatedAt    time.Time   `json:"createdAt" validate:"required"`
+}
+
+func (AccountCreatedV1) EventType() string  { return AccountCreatedType }
+func (AccountCreatedV1) SchemaVersion() int { return 1 }
+
+// AccountRejectedV1 reports that no account could be opened for an
+// enrollment. Reason is the finantial-module error code.
+type AccountRejectedV1 struct {
+	StudentID uuid.UUID `json:"studentId" validate:"required"`
+	CourseID  uuid.UUID `json:"courseId" validate:"required"`
+	Reason    string    `json:"reason" validate:"required"`
+}
+
+func (AccountRejectedV1) EventType() string  { return AccountRejectedType }
+func (AccountRejectedV1) SchemaVersion() int { return 1 }
+
+// AccountStatusUpdatedV1 is published when an account becomes overdue, is
+// settled or is cancelled. Status is ADIMPLENTE, INADIMPLENTE or CANCELADO.
+type AccountStatusUpdatedV1 struct {
+	ID           uuid.UUID   `json:"id" validate:"required"`
+	StudentID    uuid.UUID   `json:"studentId" validate:"required"`
+	CourseID     uuid.UUID   `json:"courseId" validate:"required"`
+	Installments uint8       `json:"installments" validate:"required"`
+	Value        json.Number `json:"value" validate:"required"`
+	Status       string      `json:"status" validate:"required,oneof=ADIMPLENTE INADIMPLENTE CANCELADO"`
+	CreatedAt    time.Time   `json:"createdAt" validate:"required"`
+}
+
+func (AccountStatusUpdatedV1) EventType() string  { return AccountStatusUpdatedType }
+func (AccountStatusUpdatedV1) SchemaVersion() int { return 1 }
+
+// AccountRenegotiatedV1 is published when overdue invoices of an account are
+// renegotiated into a new plan. Status is the account status after the
+// agreement, INADIMPLENTE when other overdue invoices were left out of it.
+type AccountRenegotiatedV1 struct {
+	ID           uuid.UUID   `json:"id" validate:"required"`
+	StudentID    uuid.UUID   `json:"studentId" validate:"required"`
+	CourseID     uuid.UUID   `json:"courseId" validate:"required"`
+	AgreementID  uuid.UUID   `json:"agreementId" validate:"required"`
+	Value        json.Number `json:"value" validate:"required"`
+	Installments uint8       `json:"installments" validate:"required"`
+	Status       string      `json:"status" validate:"required,oneof=ADIMPLENTE INADIMPLENTE"`
+	CreatedAt    time.Time   `json:"createdAt" validate:"required"`
+}
+
+func (AccountRenegotiatedV1) EventType() string  { return AccountRenegotiatedType }
+func (AccountRenegotiatedV1) SchemaVersion() int { return 1 }
@@ -0,0 +1,5 @@
+module github.com/colibriproject-dev/colibri-sdk-go-examples/contracts
+
+go 1.24
+
+require github.com/google/uuid v1.6.0
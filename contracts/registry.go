@@ -0,0 +1,30 @@
+package contracts
+
+// Events returns every version of every event defined by the contracts.
+func Events() []Data {
+	return []Data{
+		CourseCreatedV1{},
+		CourseUpdatedV1{},
+		CourseDeletedV1{},
+		StudentDeletedV1{},
+		EnrollmentCreatedV1{},
+		EnrollmentCreatedV2{},
+		EnrollmentDeletedV1{},
+		EnrollmentCancelledV1{},
+		AccountCreatedV1{},
+		AccountRejectedV1{},
+		AccountStatusUpdatedV1{},
+		AccountRenegotiatedV1{},
+	}
+}
+
+// LookupEvent returns the event data registered under a schema ID.
+func LookupEvent(schemaID string) (Data, bool) {
+	for _, data := range Events() {
+		if SchemaID(data.EventType(), data.SchemaVersion()) == schemaID {
+			return data, true
+		}
+	}
+
+	return nil, false
+}
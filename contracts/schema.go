@@ -0,0 +1,109 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const SchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+var (
+	uuidType   = reflect.TypeFor[uuid.UUID]()
+	timeType   = reflect.TypeFor[time.Time]()
+	numberType = reflect.TypeFor[json.Number]()
+)
+
+// Schema is the subset of JSON Schema used to publish the data of an event.
+type Schema struct {
+	Dialect    string             `json:"$schema,omitempty"`
+	ID         string             `json:"$id,omitempty"`
+	Title      string             `json:"title,omitempty"`
+	Type       string             `json:"type"`
+	Format     string             `json:"format,omitempty"`
+	Enum       []string           `json:"enum,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+}
+
+// SchemaID identifies the schema of one version of an event, and names the
+// file it is published to.
+func SchemaID(eventType string, version int) string {
+	return fmt.Sprintf("%s.v%d", eventType, version)
+}
+
+// NewSchema describes the JSON encoding of data. Fields validated as
+// required are required, and oneof validations become enums.
+func NewSchema(data Data) *Schema {
+	schema := newTypeSchema(reflect.TypeOf(data))
+	schema.Dialect = SchemaDialect
+	schema.ID = SchemaID(data.EventType(), data.SchemaVersion())
+	schema.Title = reflect.TypeOf(data).Name()
+
+	return schema
+}
+
+func newTypeSchema(t reflect.Type) *Schema {
+	switch t {
+	case uuidType:
+		return &Schema{Type: "string", Format: "uuid"}
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case numberType:
+		return &Schema{Type: "number"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return newTypeSchema(t.Elem())
+	case reflect.Struct:
+		return newStructSchema(t)
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: newTypeSchema(t.Elem())}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	}
+
+	panic(fmt.Sprintf("contracts: unsupported type %s in event data", t))
+}
+
+func newStructSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		property := newTypeSchema(field.Type)
+		for rule := range strings.SplitSeq(field.Tag.Get("validate"), ",") {
+			switch {
+			case rule == "required" && !strings.Contains(options, "omitempty"):
+				schema.Required = append(schema.Required, name)
+			case strings.HasPrefix(rule, "oneof="):
+				property.Enum = strings.Fields(strings.TrimPrefix(rule, "oneof="))
+			}
+		}
+
+		schema.Properties[name] = property
+	}
+
+	return schema
+}
@@ -0,0 +1,52 @@
+package contracts
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNewSchema(t *testing.T) {
+	schema := NewSchema(AccountStatusUpdatedV1{})
+
+	if schema.ID != "dev.colibri.finantial.account.status-updated.v1" || schema.Title != "AccountStatusUpdatedV1" {
+		t.Errorf("NewSchema() identified as %s %s", schema.ID, schema.Title)
+	}
+
+	expected := map[string]Schema{
+		"id":           {Type: "string", Format: "uuid"},
+		"installments": {Type: "integer"},
+		"value":        {Type: "number"},
+		"status":       {Type: "string", Enum: []string{"ADIMPLENTE", "INADIMPLENTE", "CANCELADO"}},
+		"createdAt":    {Type: "string", Format: "date-time"},
+	}
+	for name, property := range expected {
+		if !reflect.DeepEqual(*schema.Properties[name], property) {
+			t.Errorf("NewSchema() property %s = %+v, want %+v", name, *schema.Properties[name], property)
+		}
+	}
+
+	required := []string{"id", "studentId", "courseId", "installments", "value", "status", "createdAt"}
+	if !reflect.DeepEqual(schema.Required, required) {
+		t.Errorf("NewSchema() required = %v, want %v", schema.Required, required)
+	}
+}
+
+func TestNewSchema_Nested(t *testing.T) {
+	schema := NewSchema(EnrollmentCreatedV1{})
+
+	course := schema.Properties["course"]
+	if course.Type != "object" || course.Properties["id"].Format != "uuid" {
+		t.Errorf("NewSchema() course = %+v", course)
+	}
+
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Schema
+	if err := json.Unmarshal(raw, &decoded); err != nil || !reflect.DeepEqual(&decoded, schema) {
+		t.Errorf("NewSchema() does not survive a JSON round trip: %v", err)
+	}
+}
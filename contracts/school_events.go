@@ -0,0 +1,113 @@
+package contracts
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SchoolSource = "/colibri/school-module"
+
+	CourseCreatedType       = "dev.colibri.school.course.created"
+	CourseUpdatedType       = "dev.colibri.school.course.updated"
+	CourseDeletedType       = "dev.colibri.school.course.deleted"
+	StudentDeletedType      = "dev.colibri.school.student.deleted"
+	EnrollmentCreatedType   = "dev.colibri.school.enrollment.created"
+	EnrollmentDeletedType   = "dev.colibri.school.enrollment.deleted"
+	EnrollmentCancelledType = "dev.colibri.school.enrollment.cancelled"
+)
+
+type CourseCreatedV1 struct {
+	ID        uuid.UUID   `json:"id" validate:"required"`
+	Name      string      `json:"name" validate:"required"`
+	Value     json.Number `json:"value" validate:"required"`
+	CreatedAt time.Time   `json:"createdAt" validate:"required"`
+}
+
+func (CourseCreatedV1) EventType() string  { return CourseCreatedType }
+func (CourseCreatedV1) SchemaVersion() int { return 1 }
+
+type CourseUpdatedV1 struct {
+	ID        uuid.UUID   `json:"id" validate:"required"`
+	Name      string      `json:"name" validate:"required"`
+	Value     json.Number `json:"value" validate:"required"`
+	UpdatedAt time.Time   `json:"updatedAt" validate:"required"`
+}
+
+func (CourseUpdatedV1) EventType() string  { return CourseUpdatedType }
+func (CourseUpdatedV1) SchemaVersion() int { return 1 }
+
+type CourseDeletedV1 struct {
+	ID uuid.UUID `json:"id" validate:"required"`
+}
+
+func (CourseDeletedV1) EventType() string  { return CourseDeletedType }
+func (CourseDeletedV1) SchemaVersion() int { return 1 }
+
+type StudentDeletedV1 struct {
+	ID uuid.UUID `json:"id" validate:"required"`
+}
+
+func (StudentDeletedV1) EventType() string  { return StudentDeletedType }
+func (StudentDeletedV1) SchemaVersion() int { return 1 }
+
+type EnrollmentStudentV1 struct {
+	ID uuid.UUID `json:"id" validate:"required"`
+}
+
+type EnrollmentCourseV1 struct {
+	ID uuid.UUID `json:"id" validate:"required"`
+}
+
+// EnrollmentCreatedV1 does not carry the course value.
+//
+// Deprecated: use EnrollmentCreatedV2.
+type EnrollmentCreatedV1 struct {
+	Student      EnrollmentStudentV1 `json:"student"`
+	Course       EnrollmentCourseV1  `json:"course"`
+	Installments uint8               `json:"installments" validate:"required"`
+	Status       string              `json:"status"`
+	CreatedAt    time.Time           `json:"createdAt" validate:"required"`
+}
+
+func (EnrollmentCreatedV1) EventType() string  { return EnrollmentCreatedType }
+func (EnrollmentCreatedV1) SchemaVersion() int { return 1 }
+
+type EnrollmentCourseV2 struct {
+	ID    uuid.UUID   `json:"id" validate:"required"`
+	Value json.Number `json:"value" validate:"required"`
+}
+
+// EnrollmentCreatedV2 adds the course value, from which the enrollment is
+// billed.
+type EnrollmentCreatedV2 struct {
+	Student      EnrollmentStudentV1 `json:"student" validate:"required"`
+	Course       EnrollmentCourseV2  `json:"course" validate:"required"`
+	Installments uint8               `json:"installments" validate:"required"`
+	Status       string              `json:"status" validate:"required"`
+	CreatedAt    time.Time           `json:"createdAt" validate:"required"`
+}
+
+func (EnrollmentCreatedV2) EventType() string  { return EnrollmentCreatedType }
+func (EnrollmentCreatedV2) SchemaVersion() int { return 2 }
+
+type EnrollmentDeletedV1 struct {
+	StudentID uuid.UUID `json:"studentId" validate:"required"`
+	CourseID  uuid.UUID `json:"courseId" validate:"required"`
+}
+
+func (EnrollmentDeletedV1) EventType() string  { return EnrollmentDeletedType }
+func (EnrollmentDeletedV1) SchemaVersion() int { return 1 }
+
+// EnrollmentCancelledV1 is published when an enrollment is cancelled before
+// it was confirmed, so any account opened for it must be undone.
+type EnrollmentCancelledV1 struct {
+	StudentID uuid.UUID `json:"studentId" validate:"required"`
+	CourseID  uuid.UUID `json:"courseId" validate:"required"`
+	Reason    string    `json:"reason" validate:"required"`
+}
+
+func (EnrollmentCancelledV1) EventType() string  { return EnrollmentCancelledType }
+func (EnrollmentCancelledV1) SchemaVersion() int { return 1 }
@@ -25,3 +25,4 @@ awslocal sns subscribe --topic-arn arn:aws:sns:us-east-1:000000000000:FINANCIAL_
          --notification-endpoint arn:aws:sqs:us-east-1:000000000000:FINANCIAL_INSTALLMENT_SCHOOL
 
 awslocal s3api create-bucket --bucket meu-bucket --acl public-read
+awslocal s3api create-bucket --bucket financial-cnab
@@ -0,0 +1,98 @@
+// Package bulk builds the multi-row INSERT statements used to store many rows
+// in a few round trips to the database.
+package bulk
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+)
+
+// maxStatementParams is the number of bind parameters PostgreSQL accepts in
+// a single statement.
+const maxStatementParams = 65535
+
+const defaultChunkSize = 1000
+
+// Insert inserts rows into a table with multi-row INSERT statements whose
+// values are sent as bind parameters. Rows are split in chunks of ChunkSize,
+// shrunk when needed to stay within the parameter limit of PostgreSQL. The
+// statements join the transaction carried by the context, so a failed chunk
+// rolls back the ones before it when run in a unit of work.
+type Insert[T any] struct {
+	Table     string
+	Columns   []string
+	Values    func(row *T) []any
+	ChunkSize int
+}
+
+// Statement is an INSERT statement with its arguments.
+type Statement struct {
+	Query string
+	Args  []any
+}
+
+func (b Insert[T]) Execute(ctx context.Context, rows []T) error {
+	for _, statement := range b.Statements(rows) {
+		if err := sqlDB.NewStatement(ctx, statement.Query, statement.Args...).Execute(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Statements builds the statements that insert the rows, one per chunk.
+func (b Insert[T]) Statements(rows []T) []Statement {
+	chunkSize := b.chunkSize()
+	statements := make([]Statement, 0, (len(rows)+chunkSize-1)/chunkSize)
+	for start := 0; start < len(rows); start += chunkSize {
+		statements = append(statements, b.statement(rows[start:min(start+chunkSize, len(rows))]))
+	}
+
+	return statements
+}
+
+func (b Insert[T]) chunkSize() int {
+	chunkSize := b.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+
+	return max(min(chunkSize, maxStatementParams/len(b.Columns)), 1)
+}
+
+func (b Insert[T]) statement(rows []T) Statement {
+	columns := len(b.Columns)
+	args := make([]any, 0, len(rows)*columns)
+
+	var query strings.Builder
+	query.Grow(len(rows) * columns * 8)
+	query.WriteString("INSERT INTO ")
+	query.WriteString(b.Table)
+	query.WriteString(" (")
+	query.WriteString(strings.Join(b.Columns, ", "))
+	query.WriteString(") VALUES ")
+
+	for i := range rows {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+
+		query.WriteByte('(')
+		for column := range columns {
+			if column > 0 {
+				query.WriteString(", ")
+			}
+			query.WriteByte('$')
+			query.WriteString(strconv.Itoa(len(args) + column + 1))
+		}
+		query.WriteByte(')')
+
+		args = append(args, b.Values(&rows[i])...)
+	}
+
+	return Statement{Query: query.String(), Args: args}
+}
@@ -0,0 +1,78 @@
+package bulk_test
+
+import (
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/bulk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type row struct {
+	ID   int
+	Name string
+}
+
+func newInsert(columns []string, chunkSize int) bulk.Insert[row] {
+	return bulk.Insert[row]{
+		Table:     "rows",
+		Columns:   columns,
+		ChunkSize: chunkSize,
+		Values: func(model *row) []any {
+			values := []any{model.ID, model.Name}
+			for len(values) < len(columns) {
+				values = append(values, nil)
+			}
+			return values
+		},
+	}
+}
+
+func newRows(total int) []row {
+	rows := make([]row, total)
+	for i := range rows {
+		rows[i] = row{ID: i + 1, Name: "row"}
+	}
+
+	return rows
+}
+
+func TestInsert_Statements(t *testing.T) {
+	columns := []string{"id", "name"}
+
+	t.Run("Should bind every value as a parameter", func(t *testing.T) {
+		statements := newInsert(columns, 10).Statements(newRows(2))
+
+		require.Len(t, statements, 1)
+		assert.Equal(t, "INSERT INTO rows (id, name) VALUES ($1, $2), ($3, $4)", statements[0].Query)
+		assert.Equal(t, []any{1, "row", 2, "row"}, statements[0].Args)
+	})
+
+	t.Run("Should split the rows in chunks", func(t *testing.T) {
+		statements := newInsert(columns, 2).Statements(newRows(5))
+
+		require.Len(t, statements, 3)
+		assert.Equal(t, "INSERT INTO rows (id, name) VALUES ($1, $2), ($3, $4)", statements[1].Query)
+		assert.Equal(t, []any{5, "row"}, statements[2].Args)
+	})
+
+	t.Run("Should shrink the chunks to stay within the parameter limit", func(t *testing.T) {
+		wide := make([]string, 10)
+		for i := range wide {
+			wide[i] = "column"
+		}
+
+		statements := newInsert(wide, 100000).Statements(newRows(7000))
+
+		require.Len(t, statements, 2)
+		for _, statement := range statements {
+			assert.LessOrEqual(t, len(statement.Args), 65535)
+		}
+	})
+
+	t.Run("Should build no statement without rows", func(t *testing.T) {
+		statements := newInsert(columns, 0).Statements(nil)
+
+		assert.Empty(t, statements)
+	})
+}
@@ -0,0 +1,161 @@
+package consumers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/validator"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+)
+
+type actionHandler func(ctx context.Context, providerMessage *messaging.ProviderMessage) error
+
+type eventHandler func(ctx context.Context, event *contracts.Event) error
+
+// ActionRouter is a queue consumer that dispatches each message to the
+// handler registered for its action, or for its event type and schema
+// version when the message is an event. Messages whose action or schema
+// version has no handler are rejected, unless the action was explicitly
+// ignored. Rejections and invalid payloads are permanent errors,
+// dead-lettered without retrying.
+type ActionRouter struct {
+	queueName string
+	handlers  map[string]actionHandler
+	events    map[string]map[int]eventHandler
+}
+
+func NewActionRouter(queueName string) *ActionRouter {
+	return &ActionRouter{
+		queueName: queueName,
+		handlers:  map[string]actionHandler{},
+		events:    map[string]map[int]eventHandler{},
+	}
+}
+
+// Handle registers the handler of an action. The message payload is decoded
+// into T and validated before the handler is called.
+func Handle[T any](r *ActionRouter, action string, handler func(ctx context.Context, model *T) error) {
+	r.register(action, func(ctx context.Context, providerMessage *messaging.ProviderMessage) error {
+		var model T
+		if err := providerMessage.DecodeAndValidateMessage(&model); err != nil {
+			return Permanent(fmt.Errorf("invalid %s message: %w", action, err))
+		}
+
+		return handler(ctx, &model)
+	})
+}
+
+// HandleEvent registers the handler of one schema version of an event, taken
+// from T. The event data is decoded into T and validated before the handler
+// is called. Registering several versions of the same event lets the queue
+// accept them all while producers migrate.
+func HandleEvent[T any, PT interface {
+	*T
+	contracts.Data
+}](r *ActionRouter, handler func(ctx context.Context, data *T) error) {
+	var model PT = new(T)
+	r.registerEvent(model.EventType(), model.SchemaVersion(), func(ctx context.Context, event *contracts.Event) error {
+		data := PT(new(T))
+		if err := event.DecodeData(data); err != nil {
+			return Permanent(fmt.Errorf("invalid %s event: %w", event.Type, err))
+		}
+
+		if err := validator.Struct(data); err != nil {
+			return Permanent(fmt.Errorf("invalid %s event: %w", event.Type, err))
+		}
+
+		return handler(ctx, data)
+	})
+}
+
+// Ignore acknowledges the messages of actions that are published to the
+// subscribed topic but are of no interest to this queue.
+func (r *ActionRouter) Ignore(actions ...string) {
+	for _, action := range actions {
+		r.register(action, func(ctx context.Context, providerMessage *messaging.ProviderMessage) error {
+			logging.Debug(ctx).
+				AddParam("queue", r.queueName).
+				AddParam("action", providerMessage.Action).
+				Msg("Message action ignored")
+			return nil
+		})
+	}
+}
+
+func (r *ActionRouter) register(action string, handler actionHandler) {
+	if _, exists := r.handlers[action]; exists {
+		panic(fmt.Sprintf("action %s already registered for queue %s", action, r.queueName))
+	}
+
+	r.handlers[action] = handler
+}
+
+func (r *ActionRouter) registerEvent(eventType string, version int, handler eventHandler) {
+	versions, exists := r.events[eventType]
+	if !exists {
+		versions = map[int]eventHandler{}
+		r.events[eventType] = versions
+		r.register(eventType, func(ctx context.Context, providerMessage *messaging.ProviderMessage) error {
+			return r.consumeEvent(ctx, providerMessage, versions)
+		})
+	}
+
+	if _, exists := versions[version]; exists {
+		panic(fmt.Sprintf("event %s v%d already registered for queue %s", eventType, version, r.queueName))
+	}
+
+	versions[version] = handler
+}
+
+func (r *ActionRouter) consumeEvent(ctx context.Context, providerMessage *messaging.ProviderMessage, versions map[int]eventHandler) error {
+	event, err := DecodeEvent(providerMessage)
+	if err != nil {
+		return Permanent(fmt.Errorf("invalid %s event: %w", providerMessage.Action, err))
+	}
+
+	handler, ok := versions[event.SchemaVersion]
+	if !ok {
+		logging.Warn(ctx).
+			AddParam("queue", r.queueName).
+			AddParam("type", event.Type).
+			AddParam("schemaVersion", event.SchemaVersion).
+			AddParam("eventId", event.ID).
+			Msg("Event with unsupported schema version rejected")
+		return Permanent(errors.New(ErrUnsupportedSchemaVersion))
+	}
+
+	return handler(ctx, event)
+}
+
+// Actions returns the registered actions, including the ignored ones.
+func (r *ActionRouter) Actions() []string {
+	actions := make([]string, 0, len(r.handlers))
+	for action := range r.handlers {
+		actions = append(actions, action)
+	}
+	slices.Sort(actions)
+
+	return actions
+}
+
+func (r *ActionRouter) Consume(ctx context.Context, providerMessage *messaging.ProviderMessage) error {
+	handler, ok := r.handlers[providerMessage.Action]
+	if !ok {
+		logging.Warn(ctx).
+			AddParam("queue", r.queueName).
+			AddParam("action", providerMessage.Action).
+			AddParam("messageId", providerMessage.ID).
+			Msg("Message with unknown action rejected")
+		return Permanent(errors.New(ErrUnknownMessageAction))
+	}
+
+	return handler(ctx, providerMessage)
+}
+
+func (r *ActionRouter) QueueName() string {
+	return r.queueName
+}
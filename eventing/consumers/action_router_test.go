@@ -0,0 +1,206 @@
+package consumers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/consumers"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type routedPayload struct {
+	ID uuid.UUID `json:"id" validate:"required"`
+}
+
+func TestActionRouter(t *testing.T) {
+	t.Run("Should return new action router with queue name", func(t *testing.T) {
+		result := consumers.NewActionRouter("TEST_QUEUE")
+		assert.NotNil(t, result)
+		assert.Equal(t, "TEST_QUEUE", result.QueueName())
+		assert.Empty(t, result.Actions())
+	})
+
+	t.Run("Should panic when action is registered twice", func(t *testing.T) {
+		router := consumers.NewActionRouter("TEST_QUEUE")
+		router.Ignore("CREATE")
+
+		assert.Panics(t, func() { router.Ignore("CREATE") })
+	})
+}
+
+func TestActionRouter_Consume(t *testing.T) {
+	var received []*routedPayload
+	var handlerErr error
+
+	router := consumers.NewActionRouter("TEST_QUEUE")
+	consumers.Handle(router, "DELETE", func(ctx context.Context, model *routedPayload) error {
+		received = append(received, model)
+		return handlerErr
+	})
+	router.Ignore("CREATE")
+
+	id := uuid.New()
+
+	t.Run("Should list registered actions", func(t *testing.T) {
+		assert.Equal(t, []string{"CREATE", "DELETE"}, router.Actions())
+	})
+
+	t.Run("Should decode payload and call action handler", func(t *testing.T) {
+		received, handlerErr = nil, nil
+
+		err := router.Consume(ctx, &messaging.ProviderMessage{Action: "DELETE", Message: map[string]any{"id": id}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*routedPayload{{ID: id}}, received)
+	})
+
+	t.Run("Should return error of the action handler", func(t *testing.T) {
+		received, handlerErr = nil, errors.New("mock error in handler")
+
+		err := router.Consume(ctx, &messaging.ProviderMessage{Action: "DELETE", Message: map[string]any{"id": id}})
+
+		assert.ErrorIs(t, err, handlerErr)
+	})
+
+	t.Run("Should return error when payload is invalid", func(t *testing.T) {
+		received, handlerErr = nil, nil
+
+		err := router.Consume(ctx, &messaging.ProviderMessage{Action: "DELETE", Message: map[string]any{}})
+
+		assert.Error(t, err)
+		assert.Empty(t, received)
+	})
+
+	t.Run("Should acknowledge ignored action", func(t *testing.T) {
+		received, handlerErr = nil, nil
+
+		err := router.Consume(ctx, &messaging.ProviderMessage{Action: "CREATE", Message: map[string]any{"id": id}})
+
+		assert.NoError(t, err)
+		assert.Empty(t, received)
+	})
+
+	t.Run("Should reject unknown action", func(t *testing.T) {
+		received, handlerErr = nil, nil
+
+		err := router.Consume(ctx, &messaging.ProviderMessage{Action: "UPDATE", Message: map[string]any{"id": id}})
+
+		assert.EqualError(t, err, consumers.ErrUnknownMessageAction)
+		assert.Empty(t, received)
+	})
+}
+
+func TestActionRouter_PermanentErrors(t *testing.T) {
+	router := consumers.NewActionRouter("TEST_QUEUE")
+	consumers.Handle(router, "DELETE", func(ctx context.Context, model *routedPayload) error {
+		return errors.New("mock error in handler")
+	})
+
+	t.Run("Should return permanent error for unknown action", func(t *testing.T) {
+		err := router.Consume(ctx, &messaging.ProviderMessage{Action: "UPDATE"})
+		assert.True(t, consumers.IsPermanent(err))
+	})
+
+	t.Run("Should return permanent error for invalid payload", func(t *testing.T) {
+		err := router.Consume(ctx, &messaging.ProviderMessage{Action: "DELETE", Message: map[string]any{}})
+		assert.True(t, consumers.IsPermanent(err))
+	})
+
+	t.Run("Should not mark handler error as permanent", func(t *testing.T) {
+		err := router.Consume(ctx, &messaging.ProviderMessage{Action: "DELETE", Message: map[string]any{"id": uuid.New()}})
+		assert.False(t, consumers.IsPermanent(err))
+	})
+}
+
+type testEventV2 struct {
+	ID   uuid.UUID `json:"id" validate:"required"`
+	Name string    `json:"name" validate:"required"`
+}
+
+func (testEventV2) EventType() string  { return testEventType }
+func (testEventV2) SchemaVersion() int { return 2 }
+
+type testEventV3 struct {
+	ID uuid.UUID `json:"id"`
+}
+
+func (testEventV3) EventType() string  { return testEventType }
+func (testEventV3) SchemaVersion() int { return 3 }
+
+func TestActionRouter_HandleEvent(t *testing.T) {
+	var receivedV1 []*testEventV1
+	var receivedV2 []*testEventV2
+
+	router := consumers.NewActionRouter("TEST_QUEUE")
+	consumers.HandleEvent(router, func(ctx context.Context, data *testEventV1) error {
+		receivedV1 = append(receivedV1, data)
+		return nil
+	})
+	consumers.HandleEvent(router, func(ctx context.Context, data *testEventV2) error {
+		receivedV2 = append(receivedV2, data)
+		return nil
+	})
+
+	id := uuid.New()
+
+	t.Run("Should register the event type as action once", func(t *testing.T) {
+		assert.Equal(t, []string{testEventType}, router.Actions())
+	})
+
+	t.Run("Should dispatch event to the handler of its schema version", func(t *testing.T) {
+		receivedV1, receivedV2 = nil, nil
+
+		assert.NoError(t, router.Consume(ctx, newEventMessage(t, "/test", testEventV1{ID: id})))
+		assert.NoError(t, router.Consume(ctx, newEventMessage(t, "/test", testEventV2{ID: id, Name: "test"})))
+
+		assert.Equal(t, []*testEventV1{{ID: id}}, receivedV1)
+		assert.Equal(t, []*testEventV2{{ID: id, Name: "test"}}, receivedV2)
+	})
+
+	t.Run("Should return permanent error for unsupported schema version", func(t *testing.T) {
+		receivedV1, receivedV2 = nil, nil
+
+		err := router.Consume(ctx, newEventMessage(t, "/test", testEventV3{ID: id}))
+
+		assert.EqualError(t, err, consumers.ErrUnsupportedSchemaVersion)
+		assert.True(t, consumers.IsPermanent(err))
+		assert.Empty(t, receivedV1)
+		assert.Empty(t, receivedV2)
+	})
+
+	t.Run("Should return permanent error for invalid event data", func(t *testing.T) {
+		receivedV1, receivedV2 = nil, nil
+
+		err := router.Consume(ctx, newEventMessage(t, "/test", testEventV2{ID: id}))
+
+		assert.True(t, consumers.IsPermanent(err))
+		assert.Empty(t, receivedV2)
+	})
+
+	t.Run("Should return permanent error when event type does not match action", func(t *testing.T) {
+		receivedV1, receivedV2 = nil, nil
+		providerMessage := newEventMessage(t, "/test", testEventV1{ID: id})
+		providerMessage.Message.(*contracts.Event).Type = "dev.colibri.test.deleted"
+
+		err := router.Consume(ctx, providerMessage)
+
+		assert.ErrorIs(t, err, contracts.ErrInvalidEvent)
+		assert.True(t, consumers.IsPermanent(err))
+		assert.Empty(t, receivedV1)
+	})
+
+	t.Run("Should return permanent error when message is not an event", func(t *testing.T) {
+		err := router.Consume(ctx, &messaging.ProviderMessage{Action: testEventType, Message: map[string]any{"id": id}})
+		assert.True(t, consumers.IsPermanent(err))
+	})
+
+	t.Run("Should panic when schema version is registered twice", func(t *testing.T) {
+		assert.Panics(t, func() {
+			consumers.HandleEvent(router, func(ctx context.Context, data *testEventV1) error { return nil })
+		})
+	})
+}
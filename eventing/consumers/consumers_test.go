@@ -0,0 +1,40 @@
+package consumers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/test"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/google/uuid"
+)
+
+var (
+	ctx = context.Background()
+)
+
+const testEventType = "dev.colibri.test.created"
+
+type testEventV1 struct {
+	ID uuid.UUID `json:"id" validate:"required"`
+}
+
+func (testEventV1) EventType() string  { return testEventType }
+func (testEventV1) SchemaVersion() int { return 1 }
+
+// newEventMessage wraps data in an event as the outbox relay publishes it.
+func newEventMessage(t *testing.T, source string, data contracts.Data) *messaging.ProviderMessage {
+	event, err := contracts.NewEvent(source, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &messaging.ProviderMessage{ID: uuid.New(), Action: event.Type, Message: event}
+}
+
+func TestMain(m *testing.M) {
+	test.InitializeBaseTest()
+
+	m.Run()
+}
@@ -0,0 +1,33 @@
+package consumers
+
+import "errors"
+
+const (
+	ErrUnknownMessageAction     string = "errUnknownMessageAction"
+	ErrUnsupportedSchemaVersion string = "errUnsupportedSchemaVersion"
+)
+
+// permanentError marks a failure that would happen again on every attempt,
+// such as an unknown action or an invalid payload.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks err as not worth retrying: the message goes straight to the
+// dead letters.
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+func IsPermanent(err error) bool {
+	var target *permanentError
+	return errors.As(err, &target)
+}
@@ -0,0 +1,27 @@
+package consumers
+
+import (
+	"fmt"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+)
+
+// DecodeEvent reads the CloudEvents envelope carried by the message, whose
+// type must match the message action.
+func DecodeEvent(providerMessage *messaging.ProviderMessage) (*contracts.Event, error) {
+	var event contracts.Event
+	if err := providerMessage.DecodeMessage(&event); err != nil {
+		return nil, err
+	}
+
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+
+	if event.Type != providerMessage.Action {
+		return nil, fmt.Errorf("%w: type %s does not match action %s", contracts.ErrInvalidEvent, event.Type, providerMessage.Action)
+	}
+
+	return &event, nil
+}
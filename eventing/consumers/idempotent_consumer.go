@@ -0,0 +1,81 @@
+package consumers
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/inbox"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/google/uuid"
+)
+
+// BusinessKeyConsumer is implemented by consumers whose messages carry a
+// key identifying the business event, used to detect the same event being
+// delivered again under a different message ID.
+type BusinessKeyConsumer interface {
+	BusinessKey(providerMessage *messaging.ProviderMessage) string
+}
+
+// IdempotentConsumer records each consumed message in the inbox, in the same
+// transaction as the wrapped consumer, and skips redelivered messages.
+type IdempotentConsumer struct {
+	Consumer        messaging.QueueConsumer
+	InboxRepository inbox.Repository
+	UnitOfWork      transactions.UnitOfWork
+}
+
+func NewIdempotentConsumer(consumer messaging.QueueConsumer) messaging.QueueConsumer {
+	return &IdempotentConsumer{
+		Consumer:        consumer,
+		InboxRepository: inbox.NewDBRepository(),
+		UnitOfWork:      transactions.NewSQLUnitOfWork(),
+	}
+}
+
+func (c *IdempotentConsumer) Consume(ctx context.Context, providerMessage *messaging.ProviderMessage) error {
+	model := &inbox.Message{
+		Consumer:  c.QueueName(),
+		MessageID: messageID(providerMessage),
+		Action:    providerMessage.Action,
+	}
+
+	if keyConsumer, ok := c.Consumer.(BusinessKeyConsumer); ok {
+		model.BusinessKey = keyConsumer.BusinessKey(providerMessage)
+	}
+
+	return c.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		inserted, err := c.InboxRepository.Insert(ctx, model)
+		if err != nil {
+			return err
+		}
+
+		if !inserted {
+			logging.Info(ctx).
+				AddParam("queue", model.Consumer).
+				AddParam("messageId", model.MessageID).
+				AddParam("businessKey", model.BusinessKey).
+				Msg("Duplicate message ignored")
+			return nil
+		}
+
+		return c.Consumer.Consume(ctx, providerMessage)
+	})
+}
+
+func (c *IdempotentConsumer) QueueName() string {
+	return c.Consumer.QueueName()
+}
+
+// messageID identifies the message by its event ID, which is kept when the
+// outbox relay publishes the same event again, falling back to the broker
+// message ID for messages that are not events.
+func messageID(providerMessage *messaging.ProviderMessage) uuid.UUID {
+	if event, err := DecodeEvent(providerMessage); err == nil {
+		if id, err := uuid.Parse(event.ID); err == nil {
+			return id
+		}
+	}
+
+	return providerMessage.ID
+}
@@ -0,0 +1,142 @@
+package consumers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/consumers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/inbox"
+	inboxmock "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/inbox/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeConsumer struct {
+	calls int
+	err   error
+}
+
+func (f *fakeConsumer) Consume(ctx context.Context, providerMessage *messaging.ProviderMessage) error {
+	f.calls++
+	return f.err
+}
+
+func (f *fakeConsumer) QueueName() string {
+	return "FAKE_QUEUE"
+}
+
+type fakeBusinessKeyConsumer struct {
+	fakeConsumer
+}
+
+func (f *fakeBusinessKeyConsumer) BusinessKey(providerMessage *messaging.ProviderMessage) string {
+	return "business-key"
+}
+
+func TestNewIdempotentConsumer(t *testing.T) {
+	t.Run("Should return new idempotent consumer with the wrapped queue name", func(t *testing.T) {
+		result := consumers.NewIdempotentConsumer(&fakeConsumer{})
+		assert.NotNil(t, result)
+		assert.Equal(t, "FAKE_QUEUE", result.QueueName())
+	})
+}
+
+func TestIdempotentConsumer_Consume(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockInboxRepository := inboxmock.NewMockRepository(controller)
+	defer controller.Finish()
+
+	providerMessage := &messaging.ProviderMessage{ID: uuid.New(), Action: "UPDATE_ACCOUNT_STATUS"}
+	expectedInbox := &inbox.Message{
+		Consumer:  "FAKE_QUEUE",
+		MessageID: providerMessage.ID,
+		Action:    providerMessage.Action,
+	}
+
+	newConsumer := func(inner messaging.QueueConsumer) consumers.IdempotentConsumer {
+		return consumers.IdempotentConsumer{
+			Consumer:        inner,
+			InboxRepository: mockInboxRepository,
+			UnitOfWork:      transaction.NewMockTransaction(),
+		}
+	}
+
+	t.Run("Should return error when occurred error in Insert", func(t *testing.T) {
+		inner := &fakeConsumer{}
+		consumer := newConsumer(inner)
+		mockInboxRepository.EXPECT().Insert(gomock.Any(), expectedInbox).Return(false, errors.New("mock error in Insert"))
+
+		err := consumer.Consume(ctx, providerMessage)
+
+		assert.Error(t, err)
+		assert.Zero(t, inner.calls)
+	})
+
+	t.Run("Should skip message already in inbox", func(t *testing.T) {
+		inner := &fakeConsumer{}
+		consumer := newConsumer(inner)
+		mockInboxRepository.EXPECT().Insert(gomock.Any(), expectedInbox).Return(false, nil)
+
+		err := consumer.Consume(ctx, providerMessage)
+
+		assert.NoError(t, err)
+		assert.Zero(t, inner.calls)
+	})
+
+	t.Run("Should consume new message", func(t *testing.T) {
+		inner := &fakeConsumer{}
+		consumer := newConsumer(inner)
+		mockInboxRepository.EXPECT().Insert(gomock.Any(), expectedInbox).Return(true, nil)
+
+		err := consumer.Consume(ctx, providerMessage)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, inner.calls)
+	})
+
+	t.Run("Should return error of the wrapped consumer", func(t *testing.T) {
+		expected := errors.New("mock error in Consume")
+		inner := &fakeConsumer{err: expected}
+		consumer := newConsumer(inner)
+		mockInboxRepository.EXPECT().Insert(gomock.Any(), expectedInbox).Return(true, nil)
+
+		err := consumer.Consume(ctx, providerMessage)
+
+		assert.ErrorIs(t, err, expected)
+	})
+
+	t.Run("Should record business key when the wrapped consumer provides one", func(t *testing.T) {
+		inner := &fakeBusinessKeyConsumer{}
+		consumer := newConsumer(inner)
+		withKey := *expectedInbox
+		withKey.BusinessKey = "business-key"
+		mockInboxRepository.EXPECT().Insert(gomock.Any(), &withKey).Return(true, nil)
+
+		err := consumer.Consume(ctx, providerMessage)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, inner.calls)
+	})
+
+	t.Run("Should use the event id as message id", func(t *testing.T) {
+		inner := &fakeConsumer{}
+		consumer := newConsumer(inner)
+		eventMessage := newEventMessage(t, "/test", testEventV1{ID: uuid.New()})
+		event := eventMessage.Message.(*contracts.Event)
+		mockInboxRepository.EXPECT().Insert(gomock.Any(), &inbox.Message{
+			Consumer:  "FAKE_QUEUE",
+			MessageID: uuid.MustParse(event.ID),
+			Action:    event.Type,
+		}).Return(true, nil)
+
+		err := consumer.Consume(ctx, eventMessage)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, inner.calls)
+	})
+}
@@ -0,0 +1,161 @@
+package consumers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+)
+
+const (
+	defaultRetryMaxAttempts    = 5
+	defaultRetryInitialBackoff = 200 * time.Millisecond
+	defaultRetryMaxBackoff     = 10 * time.Second
+)
+
+// RetryPolicy defines how many times a message is consumed before it is
+// dead-lettered and how long to wait between attempts.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// NewRetryPolicy reads the policy of a queue from the environment. The
+// <QUEUE>_RETRY_* variables override the CONSUMER_RETRY_* defaults.
+func NewRetryPolicy(queueName string) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    intEnv(queueName, "MAX_ATTEMPTS", defaultRetryMaxAttempts),
+		InitialBackoff: durationEnv(queueName, "INITIAL_BACKOFF", defaultRetryInitialBackoff),
+		MaxBackoff:     durationEnv(queueName, "MAX_BACKOFF", defaultRetryMaxBackoff),
+	}
+}
+
+// Backoff returns the wait after the given failed attempt, doubling from
+// InitialBackoff up to MaxBackoff.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	wait := p.InitialBackoff
+	for i := 1; i < attempt && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+
+	return min(wait, p.MaxBackoff)
+}
+
+// RetryConsumer retries the wrapped consumer with exponential backoff and
+// records the message as a dead letter once the attempts are exhausted.
+// Retrying happens in process, and is the only retry mechanism: the SDK
+// deletes the broker message from the queue as soon as it is delivered, so a
+// queue redrive policy would never apply.
+type RetryConsumer struct {
+	Consumer             messaging.QueueConsumer
+	Policy               RetryPolicy
+	DeadLetterRepository deadletters.Repository
+}
+
+func NewRetryConsumer(consumer messaging.QueueConsumer) messaging.QueueConsumer {
+	return &RetryConsumer{
+		Consumer:             consumer,
+		Policy:               NewRetryPolicy(consumer.QueueName()),
+		DeadLetterRepository: deadletters.NewDBRepository(),
+	}
+}
+
+func (c *RetryConsumer) Consume(ctx context.Context, providerMessage *messaging.ProviderMessage) error {
+	var err error
+	attempt := 0
+	for attempt < c.Policy.MaxAttempts {
+		attempt++
+		if err = c.Consumer.Consume(ctx, providerMessage); err == nil {
+			return nil
+		}
+
+		if IsPermanent(err) || attempt == c.Policy.MaxAttempts {
+			break
+		}
+
+		logging.Warn(ctx).
+			Err(err).
+			AddParam("queue", c.QueueName()).
+			AddParam("messageId", providerMessage.ID).
+			AddParam("attempt", attempt).
+			Msg("Could not consume message, retrying")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.Policy.Backoff(attempt)):
+		}
+	}
+
+	return c.deadLetter(ctx, providerMessage, attempt, err)
+}
+
+func (c *RetryConsumer) deadLetter(ctx context.Context, providerMessage *messaging.ProviderMessage, attempts int, cause error) error {
+	payload, err := json.Marshal(providerMessage.Message)
+	if err != nil {
+		return errors.Join(cause, err)
+	}
+
+	model := &deadletters.DeadLetter{
+		Queue:         c.QueueName(),
+		MessageID:     providerMessage.ID,
+		Origin:        providerMessage.Origin,
+		Action:        providerMessage.Action,
+		Payload:       string(payload),
+		CorrelationID: providerMessage.CorrelationID,
+		Error:         cause.Error(),
+		Attempts:      attempts,
+	}
+
+	if err := c.DeadLetterRepository.Insert(ctx, model); err != nil {
+		return errors.Join(cause, err)
+	}
+
+	logging.Error(ctx).
+		Err(cause).
+		AddParam("queue", model.Queue).
+		AddParam("messageId", model.MessageID).
+		AddParam("action", model.Action).
+		AddParam("attempts", attempts).
+		Msg("Message dead-lettered")
+
+	return nil
+}
+
+func (c *RetryConsumer) QueueName() string {
+	return c.Consumer.QueueName()
+}
+
+func retryEnv(queueName, name string) string {
+	if value := os.Getenv(strings.ToUpper(queueName) + "_RETRY_" + name); value != "" {
+		return value
+	}
+
+	return os.Getenv("CONSUMER_RETRY_" + name)
+}
+
+func durationEnv(queueName, name string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(retryEnv(queueName, name))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+
+	return value
+}
+
+func intEnv(queueName, name string, fallback int) int {
+	value, err := strconv.Atoi(retryEnv(queueName, name))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+
+	return value
+}
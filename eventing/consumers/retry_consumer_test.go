@@ -0,0 +1,142 @@
+package consumers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/consumers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	deadlettersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type flakyConsumer struct {
+	calls    int
+	failures int
+	err      error
+}
+
+func (f *flakyConsumer) Consume(ctx context.Context, providerMessage *messaging.ProviderMessage) error {
+	f.calls++
+	if f.calls <= f.failures {
+		return f.err
+	}
+	return nil
+}
+
+func (f *flakyConsumer) QueueName() string {
+	return "FAKE_QUEUE"
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+	t.Run("Should return default retry policy", func(t *testing.T) {
+		result := consumers.NewRetryPolicy("FAKE_QUEUE")
+
+		assert.Equal(t, 5, result.MaxAttempts)
+		assert.Equal(t, 200*time.Millisecond, result.InitialBackoff)
+		assert.Equal(t, 10*time.Second, result.MaxBackoff)
+	})
+
+	t.Run("Should override defaults with queue variables", func(t *testing.T) {
+		t.Setenv("CONSUMER_RETRY_MAX_ATTEMPTS", "3")
+		t.Setenv("CONSUMER_RETRY_MAX_BACKOFF", "1s")
+		t.Setenv("FAKE_QUEUE_RETRY_MAX_ATTEMPTS", "8")
+
+		result := consumers.NewRetryPolicy("FAKE_QUEUE")
+
+		assert.Equal(t, 8, result.MaxAttempts)
+		assert.Equal(t, 200*time.Millisecond, result.InitialBackoff)
+		assert.Equal(t, time.Second, result.MaxBackoff)
+	})
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := consumers.RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	assert.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.Backoff(2))
+	assert.Equal(t, 800*time.Millisecond, policy.Backoff(4))
+	assert.Equal(t, time.Second, policy.Backoff(5))
+	assert.Equal(t, time.Second, policy.Backoff(30))
+}
+
+func TestRetryConsumer_Consume(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockDeadLetterRepository := deadlettersmock.NewMockRepository(controller)
+	defer controller.Finish()
+
+	providerMessage := &messaging.ProviderMessage{
+		ID:            uuid.New(),
+		Origin:        "finantial-module",
+		Action:        "UPDATE_ACCOUNT_STATUS",
+		Message:       map[string]any{"status": "ADIMPLENTE"},
+		CorrelationID: "correlation-id",
+	}
+
+	newConsumer := func(inner messaging.QueueConsumer) *consumers.RetryConsumer {
+		return &consumers.RetryConsumer{
+			Consumer:             inner,
+			Policy:               consumers.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+			DeadLetterRepository: mockDeadLetterRepository,
+		}
+	}
+
+	t.Run("Should retry until the wrapped consumer succeeds", func(t *testing.T) {
+		inner := &flakyConsumer{failures: 2, err: errors.New("mock error in consume")}
+		mockDeadLetterRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := newConsumer(inner).Consume(ctx, providerMessage)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, inner.calls)
+	})
+
+	t.Run("Should dead-letter message when attempts are exhausted", func(t *testing.T) {
+		inner := &flakyConsumer{failures: 3, err: errors.New("mock error in consume")}
+		mockDeadLetterRepository.EXPECT().Insert(ctx, &deadletters.DeadLetter{
+			Queue:         "FAKE_QUEUE",
+			MessageID:     providerMessage.ID,
+			Origin:        providerMessage.Origin,
+			Action:        providerMessage.Action,
+			Payload:       `{"status":"ADIMPLENTE"}`,
+			CorrelationID: providerMessage.CorrelationID,
+			Error:         "mock error in consume",
+			Attempts:      3,
+		}).Return(nil)
+
+		err := newConsumer(inner).Consume(ctx, providerMessage)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, inner.calls)
+	})
+
+	t.Run("Should dead-letter message without retrying when error is permanent", func(t *testing.T) {
+		inner := &flakyConsumer{failures: 3, err: consumers.Permanent(errors.New("mock permanent error"))}
+		mockDeadLetterRepository.EXPECT().Insert(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, model *deadletters.DeadLetter) error {
+				assert.Equal(t, 1, model.Attempts)
+				assert.Equal(t, "mock permanent error", model.Error)
+				return nil
+			})
+
+		err := newConsumer(inner).Consume(ctx, providerMessage)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, inner.calls)
+	})
+
+	t.Run("Should return error when dead letter could not be recorded", func(t *testing.T) {
+		inner := &flakyConsumer{failures: 3, err: errors.New("mock error in consume")}
+		mockDeadLetterRepository.EXPECT().Insert(ctx, gomock.Any()).Return(errors.New("mock error in Insert"))
+
+		err := newConsumer(inner).Consume(ctx, providerMessage)
+
+		assert.ErrorIs(t, err, inner.err)
+		assert.ErrorContains(t, err, "mock error in Insert")
+	})
+}
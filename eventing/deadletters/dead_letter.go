@@ -0,0 +1,53 @@
+package deadletters
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/google/uuid"
+)
+
+type Status string
+
+const (
+	PENDING   Status = "PENDING"
+	REPLAYED  Status = "REPLAYED"
+	DISCARDED Status = "DISCARDED"
+)
+
+// DeadLetter is a consumed message that could not be processed after all
+// retry attempts, kept until it is replayed or discarded.
+type DeadLetter struct {
+	ID            uuid.UUID          `json:"id"`
+	Queue         string             `json:"queue"`
+	MessageID     uuid.UUID          `json:"messageId"`
+	Origin        string             `json:"origin"`
+	Action        string             `json:"action"`
+	Payload       string             `json:"payload"`
+	CorrelationID string             `json:"correlationId"`
+	Error         string             `json:"error"`
+	Attempts      int                `json:"attempts"`
+	Status        Status             `json:"status"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	UpdatedAt     time.Time          `json:"updatedAt"`
+	ResolvedAt    types.NullDateTime `json:"resolvedAt"`
+}
+
+// ToProviderMessage rebuilds the original message to be consumed again.
+func (d *DeadLetter) ToProviderMessage() *messaging.ProviderMessage {
+	return &messaging.ProviderMessage{
+		ID:            d.MessageID,
+		Origin:        d.Origin,
+		Action:        d.Action,
+		Message:       json.RawMessage(d.Payload),
+		CorrelationID: d.CorrelationID,
+	}
+}
+
+type Filter struct {
+	Queue  string `form:"queue"`
+	Status Status `form:"status"`
+	Limit  int    `form:"limit"`
+}
@@ -0,0 +1,67 @@
+//go:generate mockgen -source repository.go -destination mock/repository_mock.go -package deadlettersmock
+package deadletters
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	FindAll(ctx context.Context, filter *Filter) ([]DeadLetter, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*DeadLetter, error)
+	// Insert records a failed message. A message dead-lettered again by the
+	// same queue reopens its existing record.
+	Insert(ctx context.Context, model *DeadLetter) error
+	UpdateStatus(ctx context.Context, model *DeadLetter) error
+}
+
+type DBRepository struct{}
+
+func NewDBRepository() *DBRepository {
+	return &DBRepository{}
+}
+
+func (r *DBRepository) FindAll(ctx context.Context, filter *Filter) ([]DeadLetter, error) {
+	const query = `
+		SELECT id, queue, message_id, origin, action, payload, correlation_id, error, attempts, status, created_at, updated_at, resolved_at
+		FROM dead_letters
+		WHERE ($1 = '' OR queue = $1) AND ($2 = '' OR status::TEXT = $2)
+		ORDER BY created_at DESC
+		LIMIT $3`
+
+	return sqlDB.NewQuery[DeadLetter](ctx, query, filter.Queue, filter.Status, filter.Limit).Many()
+}
+
+func (r *DBRepository) FindByID(ctx context.Context, id uuid.UUID) (*DeadLetter, error) {
+	const query = `
+		SELECT id, queue, message_id, origin, action, payload, correlation_id, error, attempts, status, created_at, updated_at, resolved_at
+		FROM dead_letters
+		WHERE id = $1`
+
+	return sqlDB.NewQuery[DeadLetter](ctx, query, id).One()
+}
+
+func (r *DBRepository) Insert(ctx context.Context, model *DeadLetter) error {
+	const query = `
+		INSERT INTO dead_letters (queue, message_id, origin, action, payload, correlation_id, error, attempts)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (queue, message_id) DO UPDATE
+		SET error = EXCLUDED.error,
+			attempts = dead_letters.attempts + EXCLUDED.attempts,
+			status = 'PENDING',
+			updated_at = NOW(),
+			resolved_at = NULL`
+
+	return sqlDB.NewStatement(ctx, query, model.Queue, model.MessageID, model.Origin, model.Action, model.Payload, model.CorrelationID, model.Error, model.Attempts).Execute()
+}
+
+func (r *DBRepository) UpdateStatus(ctx context.Context, model *DeadLetter) error {
+	const query = `
+		UPDATE dead_letters
+		SET status = $2, error = $3, attempts = $4, resolved_at = $5, updated_at = NOW()
+		WHERE id = $1`
+
+	return sqlDB.NewStatement(ctx, query, model.ID, model.Status, model.Error, model.Attempts, model.ResolvedAt).Execute()
+}
@@ -0,0 +1,9 @@
+// Package eventing holds the messaging infrastructure shared by the modules:
+// the unit of work (transactions), the transactional outbox with its relay
+// (outbox), the consumed message inbox (inbox), the failed message records
+// (deadletters), the consumer wrappers built on them (consumers) and the
+// multi-row inserts used to store many rows at once (bulk).
+//
+// Every module keeps the tables used by these packages in its own database,
+// created by its migrations with the columns the repositories read.
+package eventing
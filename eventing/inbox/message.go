@@ -0,0 +1,18 @@
+package inbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Message records a message already handled by a consumer, identified by the
+// provider message ID and, when available, by a business key that survives
+// the message being published again with a new ID.
+type Message struct {
+	Consumer    string    `json:"consumer"`
+	MessageID   uuid.UUID `json:"messageId"`
+	BusinessKey string    `json:"businessKey"`
+	Action      string    `json:"action"`
+	ReceivedAt  time.Time `json:"receivedAt"`
+}
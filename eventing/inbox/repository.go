@@ -0,0 +1,43 @@
+//go:generate mockgen -source repository.go -destination mock/repository_mock.go -package inboxmock
+package inbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+)
+
+type Repository interface {
+	// Insert returns false when the message or its business key was already
+	// recorded for the consumer.
+	Insert(ctx context.Context, model *Message) (bool, error)
+	DeleteReceivedBefore(ctx context.Context, date time.Time) error
+}
+
+type DBRepository struct{}
+
+func NewDBRepository() *DBRepository {
+	return &DBRepository{}
+}
+
+func (r *DBRepository) Insert(ctx context.Context, model *Message) (bool, error) {
+	const query = `
+		INSERT INTO inbox (consumer, message_id, business_key, action)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING
+		RETURNING true`
+
+	inserted, err := sqlDB.NewQuery[bool](ctx, query, model.Consumer, model.MessageID, model.BusinessKey, model.Action).One()
+	if err != nil {
+		return false, err
+	}
+
+	return inserted != nil && *inserted, nil
+}
+
+func (r *DBRepository) DeleteReceivedBefore(ctx context.Context, date time.Time) error {
+	const query = `DELETE FROM inbox WHERE received_at < $1`
+
+	return sqlDB.NewStatement(ctx, query, date).Execute()
+}
@@ -0,0 +1,32 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+)
+
+type Status string
+
+const (
+	PENDING Status = "PENDING"
+	SENT    Status = "SENT"
+	FAILED  Status = "FAILED"
+)
+
+// Message is an event stored with the state change that produced it,
+// waiting to be published by the relay.
+type Message struct {
+	ID            uuid.UUID          `json:"id"`
+	Topic         string             `json:"topic"`
+	Action        string             `json:"action"`
+	Payload       string             `json:"payload"`
+	CorrelationID string             `json:"correlationId"`
+	Status        Status             `json:"status"`
+	Attempts      int                `json:"attempts"`
+	LastError     string             `json:"lastError"`
+	NextAttemptAt time.Time          `json:"nextAttemptAt"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	SentAt        types.NullDateTime `json:"sentAt"`
+}
@@ -0,0 +1,80 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/google/uuid"
+)
+
+// Producer stores messages in the outbox instead of publishing them, so they
+// are committed or discarded with the transaction carried by the context.
+// The relay publishes them to the topic afterwards.
+type Producer struct {
+	Source     string
+	Topic      string
+	Repository Repository
+}
+
+// NewProducer returns a producer of the events of source to topic.
+func NewProducer(source, topic string) *Producer {
+	return &Producer{
+		Source:     source,
+		Topic:      topic,
+		Repository: NewDBRepository(),
+	}
+}
+
+// Publish wraps data in a new event and stores it. The event type is used as
+// the message action.
+func (p *Producer) Publish(ctx context.Context, data contracts.Data) error {
+	message, err := p.newMessage(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	return p.Repository.Insert(ctx, message)
+}
+
+// PublishAll stores an event for each data with a single insert, so a batch
+// of events costs one round trip to the database.
+func (p *Producer) PublishAll(ctx context.Context, data []contracts.Data) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	messages := make([]Message, 0, len(data))
+	for _, item := range data {
+		message, err := p.newMessage(ctx, item)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, *message)
+	}
+
+	return p.Repository.InsertAll(ctx, messages)
+}
+
+func (p *Producer) newMessage(ctx context.Context, data contracts.Data) (*Message, error) {
+	event, err := contracts.NewEvent(p.Source, data)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	correlationID, _ := ctx.Value(logging.CorrelationIDParam).(string)
+
+	return &Message{
+		ID:            uuid.New(),
+		Topic:         p.Topic,
+		Action:        event.Type,
+		Payload:       string(payload),
+		CorrelationID: correlationID,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package outbox_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
+	outboxmock "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProducer(t *testing.T) {
+	t.Run("Should return new producer of the source to the topic", func(t *testing.T) {
+		result := outbox.NewProducer(contracts.SchoolSource, "SCHOOL_COURSE")
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.Repository)
+		assert.Equal(t, contracts.SchoolSource, result.Source)
+		assert.Equal(t, "SCHOOL_COURSE", result.Topic)
+	})
+}
+
+func TestProducer_PublishAll(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockOutboxRepository := outboxmock.NewMockRepository(controller)
+	defer controller.Finish()
+
+	producer := outbox.Producer{Source: contracts.SchoolSource, Topic: "SCHOOL_COURSE", Repository: mockOutboxRepository}
+	data := []contracts.Data{
+		contracts.CourseCreatedV1{ID: uuid.New(), Name: "Go"},
+		contracts.CourseCreatedV1{ID: uuid.New(), Name: "Rust"},
+	}
+
+	t.Run("Should store every event with a single insert", func(t *testing.T) {
+		var stored []outbox.Message
+		mockOutboxRepository.EXPECT().InsertAll(ctx, gomock.Any()).
+			DoAndReturn(func(_ any, messages []outbox.Message) error {
+				stored = messages
+				return nil
+			})
+
+		err := producer.PublishAll(ctx, data)
+
+		assert.NoError(t, err)
+		assert.Len(t, stored, len(data))
+		for i, message := range stored {
+			var event contracts.Event
+			assert.NoError(t, json.Unmarshal([]byte(message.Payload), &event))
+			assert.NotEqual(t, uuid.Nil, message.ID)
+			assert.Equal(t, "SCHOOL_COURSE", message.Topic)
+			assert.Equal(t, data[i].EventType(), message.Action)
+			assert.Equal(t, contracts.SchoolSource, event.Source)
+		}
+	})
+
+	t.Run("Should not insert anything without events", func(t *testing.T) {
+		assert.NoError(t, producer.PublishAll(ctx, nil))
+	})
+
+	t.Run("Should return error when the insert fails", func(t *testing.T) {
+		mockOutboxRepository.EXPECT().InsertAll(ctx, gomock.Any()).Return(errors.New("mock error"))
+
+		err := producer.PublishAll(ctx, data)
+
+		assert.EqualError(t, err, "mock error")
+	})
+}
@@ -0,0 +1,178 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/observer"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+)
+
+const (
+	defaultInterval    = time.Second
+	defaultBatchSize   = 100
+	defaultMaxAttempts = 10
+	defaultLease       = time.Minute
+
+	baseBackoff = 5 * time.Second
+	maxBackoff  = 10 * time.Minute
+)
+
+// Relay publishes the messages stored in the outbox. Each batch is leased to
+// the relay before it is published, so several instances can run the relay
+// at the same time without holding row locks while the broker is called. A
+// message is published at least once: when marking it fails, or the relay
+// stops before doing it, it is published again once the lease expires.
+type Relay struct {
+	Repository  Repository
+	Publish     func(ctx context.Context, topic, action string, message any) error
+	Interval    time.Duration
+	BatchSize   int
+	MaxAttempts int
+	Lease       time.Duration
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewRelay() *Relay {
+	return &Relay{
+		Repository:  NewDBRepository(),
+		Publish:     publish,
+		Interval:    durationEnv("OUTBOX_RELAY_INTERVAL", defaultInterval),
+		BatchSize:   intEnv("OUTBOX_RELAY_BATCH_SIZE", defaultBatchSize),
+		MaxAttempts: intEnv("OUTBOX_MAX_ATTEMPTS", defaultMaxAttempts),
+		Lease:       durationEnv("OUTBOX_RELAY_LEASE", defaultLease),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+func publish(ctx context.Context, topic, action string, message any) error {
+	return messaging.NewProducer(topic).Publish(ctx, action, message)
+}
+
+// Start runs the relay in background until the application shuts down.
+func (r *Relay) Start() {
+	observer.Attach(r)
+	go r.loop()
+}
+
+func (r *Relay) Close() {
+	close(r.stop)
+	<-r.done
+	logging.Info(context.Background()).Msg("Outbox relay stopped")
+}
+
+func (r *Relay) loop() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		}
+
+		ctx := context.Background()
+		for {
+			processed, err := r.Flush(ctx)
+			if err != nil {
+				logging.Error(ctx).Err(err).Msg("Could not relay outbox messages")
+				break
+			}
+
+			if processed < r.BatchSize || r.stopped() {
+				break
+			}
+		}
+	}
+}
+
+func (r *Relay) stopped() bool {
+	select {
+	case <-r.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// Flush publishes one batch of pending messages and returns how many were
+// processed, including the ones whose publication failed.
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	messages, err := r.Repository.Claim(ctx, r.BatchSize, r.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range messages {
+		if err := r.relay(ctx, &messages[i]); err != nil {
+			return i, err
+		}
+	}
+
+	return len(messages), nil
+}
+
+func (r *Relay) relay(ctx context.Context, model *Message) error {
+	publishCtx := ctx
+	if model.CorrelationID != "" {
+		publishCtx = context.WithValue(ctx, logging.CorrelationIDParam, model.CorrelationID)
+	}
+
+	err := r.Publish(publishCtx, model.Topic, model.Action, json.RawMessage(model.Payload))
+	if err == nil {
+		return r.Repository.MarkAsSent(ctx, model.ID)
+	}
+
+	model.Attempts++
+	model.LastError = err.Error()
+	model.NextAttemptAt = time.Now().Add(backoff(model.Attempts))
+	if model.Attempts >= r.MaxAttempts {
+		model.Status = FAILED
+		logging.Error(ctx).
+			Err(err).
+			AddParam("id", model.ID).
+			AddParam("topic", model.Topic).
+			AddParam("action", model.Action).
+			Msg("Outbox message failed after max attempts")
+	}
+
+	return r.Repository.UpdateAttempt(ctx, model)
+}
+
+// backoff doubles the wait after each failed attempt, up to maxBackoff.
+func backoff(attempts int) time.Duration {
+	wait := baseBackoff
+	for i := 1; i < attempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+
+	return min(wait, maxBackoff)
+}
+
+func durationEnv(name string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(name))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+
+	return value
+}
+
+func intEnv(name string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+
+	return value
+}
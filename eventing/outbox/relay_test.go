@@ -0,0 +1,138 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
+	outboxmock "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var ctx = context.Background()
+
+type published struct {
+	topic   string
+	action  string
+	message any
+}
+
+func TestNewRelay(t *testing.T) {
+	t.Run("Should return new relay with default settings", func(t *testing.T) {
+		result := outbox.NewRelay()
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.Repository)
+		assert.Equal(t, time.Minute, result.Lease)
+		assert.NotNil(t, result.Publish)
+		assert.Equal(t, time.Second, result.Interval)
+		assert.Equal(t, 100, result.BatchSize)
+		assert.Equal(t, 10, result.MaxAttempts)
+	})
+}
+
+func TestRelay_Flush(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockOutboxRepository := outboxmock.NewMockRepository(controller)
+	defer controller.Finish()
+
+	var sent []published
+	var publishErr error
+	relay := outbox.Relay{
+		Repository: mockOutboxRepository,
+		Publish: func(ctx context.Context, topic, action string, message any) error {
+			sent = append(sent, published{topic, action, message})
+			return publishErr
+		},
+		BatchSize:   10,
+		MaxAttempts: 3,
+		Lease:       time.Minute,
+	}
+
+	newMessage := func() outbox.Message {
+		return outbox.Message{
+			ID:      uuid.New(),
+			Topic:   "SCHOOL_COURSE",
+			Action:  "CREATE_COURSE",
+			Payload: `{"name":"Course name"}`,
+			Status:  outbox.PENDING,
+		}
+	}
+
+	t.Run("Should return error when occurred error in Claim", func(t *testing.T) {
+		sent, publishErr = nil, nil
+		mockOutboxRepository.EXPECT().Claim(gomock.Any(), 10, time.Minute).Return(nil, errors.New("mock error in Claim"))
+
+		processed, err := relay.Flush(ctx)
+
+		assert.Error(t, err)
+		assert.Zero(t, processed)
+		assert.Empty(t, sent)
+	})
+
+	t.Run("Should publish pending messages and mark them as sent", func(t *testing.T) {
+		sent, publishErr = nil, nil
+		message := newMessage()
+		mockOutboxRepository.EXPECT().Claim(gomock.Any(), 10, time.Minute).Return([]outbox.Message{message}, nil)
+		mockOutboxRepository.EXPECT().MarkAsSent(gomock.Any(), message.ID).Return(nil)
+
+		processed, err := relay.Flush(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, processed)
+		assert.Equal(t, []published{{message.Topic, message.Action, json.RawMessage(message.Payload)}}, sent)
+	})
+
+	t.Run("Should schedule a new attempt when occurred error in Publish", func(t *testing.T) {
+		sent, publishErr = nil, errors.New("mock error in Publish")
+		message := newMessage()
+		mockOutboxRepository.EXPECT().Claim(gomock.Any(), 10, time.Minute).Return([]outbox.Message{message}, nil)
+		mockOutboxRepository.EXPECT().MarkAsSent(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockOutboxRepository.EXPECT().UpdateAttempt(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, model *outbox.Message) error {
+			assert.Equal(t, outbox.PENDING, model.Status)
+			assert.Equal(t, 1, model.Attempts)
+			assert.Equal(t, "mock error in Publish", model.LastError)
+			assert.True(t, model.NextAttemptAt.After(time.Now()))
+			return nil
+		})
+
+		processed, err := relay.Flush(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, processed)
+	})
+
+	t.Run("Should mark message as failed when reaching max attempts", func(t *testing.T) {
+		sent, publishErr = nil, errors.New("mock error in Publish")
+		message := newMessage()
+		message.Attempts = 2
+		mockOutboxRepository.EXPECT().Claim(gomock.Any(), 10, time.Minute).Return([]outbox.Message{message}, nil)
+		mockOutboxRepository.EXPECT().UpdateAttempt(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, model *outbox.Message) error {
+			assert.Equal(t, outbox.FAILED, model.Status)
+			assert.Equal(t, 3, model.Attempts)
+			return nil
+		})
+
+		processed, err := relay.Flush(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, processed)
+	})
+
+	t.Run("Should leave the rest of the batch leased when occurred error in MarkAsSent", func(t *testing.T) {
+		sent, publishErr = nil, nil
+		first, second := newMessage(), newMessage()
+		mockOutboxRepository.EXPECT().Claim(gomock.Any(), 10, time.Minute).Return([]outbox.Message{first, second}, nil)
+		mockOutboxRepository.EXPECT().MarkAsSent(gomock.Any(), first.ID).Return(errors.New("mock error in MarkAsSent"))
+
+		processed, err := relay.Flush(ctx)
+
+		assert.Error(t, err)
+		assert.Zero(t, processed)
+		assert.Len(t, sent, 1)
+	})
+}
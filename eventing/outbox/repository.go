@@ -0,0 +1,91 @@
+//go:generate mockgen -source repository.go -destination mock/repository_mock.go -package outboxmock
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/bulk"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Insert(ctx context.Context, model *Message) error
+	// InsertAll inserts the messages with multi-row statements, joining the
+	// transaction carried by the context.
+	InsertAll(ctx context.Context, models []Message) error
+	// Claim leases up to limit pending messages to the caller, hiding them
+	// from other relays until they are marked or the lease expires. The
+	// messages are claimed in a statement of their own, so they can be
+	// published without holding a transaction.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]Message, error)
+	MarkAsSent(ctx context.Context, id uuid.UUID) error
+	UpdateAttempt(ctx context.Context, model *Message) error
+}
+
+// messagesBulkInsert stores the messages of InsertAll, each statement
+// inserting up to 1000 of them.
+var messagesBulkInsert = bulk.Insert[Message]{
+	Table:   "outbox",
+	Columns: []string{"id", "topic", "action", "payload", "correlation_id"},
+	Values: func(model *Message) []any {
+		return []any{model.ID, model.Topic, model.Action, model.Payload, model.CorrelationID}
+	},
+}
+
+type DBRepository struct{}
+
+func NewDBRepository() *DBRepository {
+	return &DBRepository{}
+}
+
+func (r *DBRepository) Insert(ctx context.Context, model *Message) error {
+	const query = `
+		INSERT INTO outbox (id, topic, action, payload, correlation_id)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	return sqlDB.NewStatement(ctx, query, model.ID, model.Topic, model.Action, model.Payload, model.CorrelationID).Execute()
+}
+
+func (r *DBRepository) InsertAll(ctx context.Context, models []Message) error {
+	return messagesBulkInsert.Execute(ctx, models)
+}
+
+func (r *DBRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]Message, error) {
+	const query = `
+		WITH claimed AS (
+			UPDATE outbox
+			SET locked_until = NOW() + MAKE_INTERVAL(secs => $2)
+			WHERE id IN (
+				SELECT id
+				FROM outbox
+				WHERE status = 'PENDING' AND next_attempt_at <= NOW()
+					AND (locked_until IS NULL OR locked_until <= NOW())
+				ORDER BY created_at
+				LIMIT $1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, topic, action, payload, correlation_id, status, attempts, last_error, next_attempt_at, created_at, sent_at
+		)
+		SELECT id, topic, action, payload, correlation_id, status, attempts, last_error, next_attempt_at, created_at, sent_at
+		FROM claimed
+		ORDER BY created_at`
+
+	return sqlDB.NewQuery[Message](ctx, query, limit, lease.Seconds()).Many()
+}
+
+func (r *DBRepository) MarkAsSent(ctx context.Context, id uuid.UUID) error {
+	const query = `UPDATE outbox SET status = 'SENT', sent_at = NOW(), locked_until = NULL WHERE id = $1`
+
+	return sqlDB.NewStatement(ctx, query, id).Execute()
+}
+
+func (r *DBRepository) UpdateAttempt(ctx context.Context, model *Message) error {
+	const query = `
+		UPDATE outbox
+		SET status = $2, attempts = $3, last_error = $4, next_attempt_at = $5, locked_until = NULL
+		WHERE id = $1`
+
+	return sqlDB.NewStatement(ctx, query, model.ID, model.Status, model.Attempts, model.LastError, model.NextAttemptAt).Execute()
+}
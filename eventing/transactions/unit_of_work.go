@@ -0,0 +1,42 @@
+//go:generate mockgen -source unit_of_work.go -destination mock/unit_of_work_mock.go -package transactionsmock
+package transactions
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+)
+
+type UnitOfWork interface {
+	// Execute runs fn in a database transaction. Every repository call made
+	// with the context received by fn joins it, and the transaction is rolled
+	// back when fn returns an error.
+	Execute(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// SQLUnitOfWork wraps the SDK transaction so that use cases can call each
+// other: when the context already carries a transaction, fn joins it and the
+// outermost unit of work decides whether to commit.
+type SQLUnitOfWork struct {
+	Transaction transaction.Transaction
+}
+
+func NewSQLUnitOfWork() *SQLUnitOfWork {
+	return &SQLUnitOfWork{
+		Transaction: sqlDB.NewTransaction(),
+	}
+}
+
+func (u *SQLUnitOfWork) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	if InTransaction(ctx) {
+		return fn(ctx)
+	}
+
+	return u.Transaction.Execute(ctx, fn)
+}
+
+// InTransaction reports whether the context carries an open transaction.
+func InTransaction(ctx context.Context) bool {
+	return ctx.Value(sqlDB.SqlTxContext) != nil
+}
@@ -0,0 +1,70 @@
+package transactions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTransaction struct {
+	calls int
+}
+
+func (f *fakeTransaction) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	f.calls++
+	return fn(context.WithValue(ctx, sqlDB.SqlTxContext, f))
+}
+
+func TestSQLUnitOfWork(t *testing.T) {
+	t.Run("Should return new sql unit of work", func(t *testing.T) {
+		result := transactions.NewSQLUnitOfWork()
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.Transaction)
+	})
+}
+
+func TestSQLUnitOfWork_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Should start a transaction when context has none", func(t *testing.T) {
+		tx := &fakeTransaction{}
+		uow := transactions.SQLUnitOfWork{Transaction: tx}
+
+		err := uow.Execute(ctx, func(ctx context.Context) error {
+			assert.True(t, transactions.InTransaction(ctx))
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, tx.calls)
+	})
+
+	t.Run("Should join the transaction already in context", func(t *testing.T) {
+		tx := &fakeTransaction{}
+		uow := transactions.SQLUnitOfWork{Transaction: tx}
+
+		err := uow.Execute(ctx, func(ctx context.Context) error {
+			return uow.Execute(ctx, func(ctx context.Context) error {
+				return nil
+			})
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, tx.calls)
+	})
+
+	t.Run("Should return the error of the inner function", func(t *testing.T) {
+		expected := errors.New("mock error")
+		uow := transactions.SQLUnitOfWork{Transaction: &fakeTransaction{}}
+
+		err := uow.Execute(ctx, func(ctx context.Context) error {
+			return expected
+		})
+
+		assert.ErrorIs(t, err, expected)
+	})
+}
@@ -20,4 +20,6 @@ awslocal sns subscribe --topic-arn arn:aws:sns:us-east-1:000000000000:SCHOOL_STU
 
 awslocal sns create-topic --name FINANCIAL_INSTALLMENT
 
-echo "localstack topics and queues started"
\ No newline at end of file
+awslocal s3api create-bucket --bucket financial-cnab
+
+echo "localstack topics, queues and buckets started"
\ No newline at end of file
@@ -1,11 +1,20 @@
 package main
 
 import (
+	"context"
+	"errors"
+
 	"github.com/colibriproject-dev/colibri-sdk-go"
+	eventconsumers "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/consumers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/application/consumers"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/application/controllers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/scheduler"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/storage"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
 )
 
@@ -13,18 +22,46 @@ func init() {
 	colibri.InitializeApp()
 	messaging.Initialize()
 	sqlDB.Initialize()
+	storage.Initialize()
 }
 
 // @title colibri-sdk-go-examples/finantial-module
 // @version 1.0
 // @description Microservice responsible for finantial management
 func main() {
-	messaging.NewConsumer(consumers.NewSchoolCourseConsumer())
-	messaging.NewConsumer(consumers.NewSchoolEnrollmentConsumer())
-	messaging.NewConsumer(consumers.NewSchoolStudentConsumer())
+	queueConsumers := []messaging.QueueConsumer{
+		eventconsumers.NewIdempotentConsumer(consumers.NewSchoolCourseConsumer()),
+		eventconsumers.NewIdempotentConsumer(consumers.NewSchoolEnrollmentConsumer()),
+		eventconsumers.NewIdempotentConsumer(consumers.NewSchoolStudentConsumer()),
+	}
+	for _, consumer := range queueConsumers {
+		messaging.NewConsumer(eventconsumers.NewRetryConsumer(consumer))
+	}
 
 	restserver.AddRoutes(controllers.NewAccountController().Routes())
 	restserver.AddRoutes(controllers.NewInvoiceController().Routes())
-	restserver.AddRoutes(controllers.NewScheduledController().Routes())
+	restserver.AddRoutes(controllers.NewPaymentController().Routes())
+	restserver.AddRoutes(controllers.NewCnabController().Routes())
+	restserver.AddRoutes(controllers.NewJobController().Routes())
+	restserver.AddRoutes(controllers.NewDeadLetterController(queueConsumers...).Routes())
+	restserver.AddRoutes(controllers.NewReconciliationController().Routes())
+	restserver.AddRoutes(controllers.NewHolidayController().Routes())
+	restserver.AddRoutes(controllers.NewDiscountController().Routes())
+	restserver.AddRoutes(controllers.NewAgreementController().Routes())
+	restserver.AddRoutes(controllers.NewStatementController().Routes())
+	restserver.AddRoutes(controllers.NewPayerController().Routes())
+	restserver.AddRoutes(controllers.NewRefundController().Routes())
+
+	jobs := scheduler.Instance()
+	if err := errors.Join(
+		jobs.Register("process-overdue-invoices", "JOB_PROCESS_OVERDUE_INVOICES_CRON", "0 3 * * *", usecases.NewInvoiceUsecase().ProcessAllOverdueInvoices),
+		jobs.Register("purge-inbox", "JOB_PURGE_INBOX_CRON", "30 4 * * *", usecases.NewInboxUsecase().Purge),
+		jobs.Register("reconcile-enrollments", "JOB_RECONCILE_ENROLLMENTS_CRON", "0 5 * * *", usecases.NewReconciliationUsecase().Run),
+	); err != nil {
+		logging.Fatal(context.Background()).Err(err).Msg("Could not register scheduled jobs")
+	}
+	jobs.Start()
+	outbox.NewRelay().Start()
+
 	restserver.ListenAndServe()
 }
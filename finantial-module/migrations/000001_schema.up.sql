@@ -31,5 +31,5 @@ CREATE TABLE invoices (
     created_at  TIMESTAMP     NOT NULL DEFAULT NOW(),
     paid_at     TIMESTAMP,
     CONSTRAINT invoices_pk PRIMARY KEY (id),
-    CONSTRAINT invoices_accounts_fk FOREIGN KEY (account_id) REFERENCES accounts (id) ON DELETE CASCADE ON UPDATE CASCADE
+    CONSTRAINT invoices_accounts_fk FOREIGN KEY (account_id) REFERENCES accounts (id) ON DELETE RESTRICT ON UPDATE CASCADE
 );
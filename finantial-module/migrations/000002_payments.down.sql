@@ -0,0 +1,6 @@
+-- DROP SCHEMA
+DROP TABLE IF EXISTS payments;
+ALTER TABLE invoices DROP COLUMN IF EXISTS paid_value;
+
+-- DROP types
+DROP TYPE IF EXISTS PAYMENT_METHOD;
@@ -0,0 +1,26 @@
+-- CREATE TYPES
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'PAYMENT_METHOD') THEN
+		CREATE TYPE PAYMENT_METHOD AS ENUM ('PIX', 'BOLETO', 'CARTAO_CREDITO', 'CARTAO_DEBITO', 'TRANSFERENCIA', 'DINHEIRO');
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- ALTER SCHEMA
+ALTER TABLE invoices ADD COLUMN paid_value DECIMAL(19,2) NOT NULL DEFAULT 0;
+
+-- CREATE SCHEMA
+CREATE TABLE payments (
+    id          UUID           NOT NULL DEFAULT uuid_generate_v1mc(),
+    invoice_id  UUID           NOT NULL,
+    value       DECIMAL(19,2)  NOT NULL,
+    method      PAYMENT_METHOD NOT NULL,
+    overpaid    DECIMAL(19,2)  NOT NULL DEFAULT 0,
+    paid_at     TIMESTAMP      NOT NULL,
+    created_at  TIMESTAMP      NOT NULL DEFAULT NOW(),
+    CONSTRAINT payments_pk PRIMARY KEY (id),
+    CONSTRAINT payments_invoices_fk FOREIGN KEY (invoice_id) REFERENCES invoices (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX payments_invoice_id_idx ON payments (invoice_id);
@@ -0,0 +1,5 @@
+-- DROP SCHEMA
+ALTER TABLE invoices DROP COLUMN IF EXISTS paid_charges;
+ALTER TABLE invoices DROP COLUMN IF EXISTS late_charged_at;
+ALTER TABLE invoices DROP COLUMN IF EXISTS late_interest;
+ALTER TABLE invoices DROP COLUMN IF EXISTS late_fee;
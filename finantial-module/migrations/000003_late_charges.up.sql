@@ -0,0 +1,5 @@
+-- ALTER SCHEMA
+ALTER TABLE invoices ADD COLUMN late_fee DECIMAL(19,2) NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN late_interest DECIMAL(19,2) NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN late_charged_at TIMESTAMP;
+ALTER TABLE invoices ADD COLUMN paid_charges DECIMAL(19,2) NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+-- ALTER SCHEMA
+ALTER TABLE invoices DROP COLUMN IF EXISTS pix_expires_at;
+ALTER TABLE invoices DROP COLUMN IF EXISTS pix_created_at;
+ALTER TABLE invoices DROP COLUMN IF EXISTS pix_amount;
+ALTER TABLE invoices DROP COLUMN IF EXISTS pix_location;
+ALTER TABLE invoices DROP COLUMN IF EXISTS pix_txid;
@@ -0,0 +1,6 @@
+-- ALTER SCHEMA
+ALTER TABLE invoices ADD COLUMN pix_txid VARCHAR(35) NOT NULL DEFAULT '';
+ALTER TABLE invoices ADD COLUMN pix_location VARCHAR(77) NOT NULL DEFAULT '';
+ALTER TABLE invoices ADD COLUMN pix_amount DECIMAL(19,2) NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN pix_created_at TIMESTAMP;
+ALTER TABLE invoices ADD COLUMN pix_expires_at TIMESTAMP;
@@ -0,0 +1,9 @@
+-- DROP SCHEMA
+DROP INDEX IF EXISTS invoices_our_number_idx;
+ALTER TABLE invoices DROP COLUMN IF EXISTS digitable_line;
+ALTER TABLE invoices DROP COLUMN IF EXISTS barcode;
+ALTER TABLE invoices DROP COLUMN IF EXISTS our_number;
+ALTER TABLE invoices DROP COLUMN IF EXISTS bank_code;
+
+-- DROP SEQUENCES
+DROP SEQUENCE IF EXISTS invoices_our_number_seq;
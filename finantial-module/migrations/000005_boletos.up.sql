@@ -0,0 +1,10 @@
+-- CREATE SEQUENCES
+CREATE SEQUENCE IF NOT EXISTS invoices_our_number_seq;
+
+-- ALTER SCHEMA
+ALTER TABLE invoices ADD COLUMN bank_code VARCHAR(3) NOT NULL DEFAULT '';
+ALTER TABLE invoices ADD COLUMN our_number VARCHAR(20) NOT NULL DEFAULT '';
+ALTER TABLE invoices ADD COLUMN barcode VARCHAR(44) NOT NULL DEFAULT '';
+ALTER TABLE invoices ADD COLUMN digitable_line VARCHAR(47) NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX invoices_our_number_idx ON invoices (bank_code, our_number) WHERE our_number <> '';
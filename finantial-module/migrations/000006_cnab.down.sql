@@ -0,0 +1,7 @@
+-- DROP SCHEMA
+DROP TABLE IF EXISTS payers;
+DROP TABLE IF EXISTS cnab_settlements;
+ALTER TABLE invoices DROP COLUMN IF EXISTS remitted_at;
+
+-- DROP SEQUENCES
+DROP SEQUENCE IF EXISTS cnab_remittance_seq;
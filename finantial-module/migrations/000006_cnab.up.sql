@@ -0,0 +1,25 @@
+-- CREATE SEQUENCES
+CREATE SEQUENCE IF NOT EXISTS cnab_remittance_seq;
+
+-- ALTER SCHEMA
+ALTER TABLE invoices ADD COLUMN remitted_at TIMESTAMP;
+
+-- CREATE SCHEMA
+CREATE TABLE cnab_settlements (
+    bank_code   VARCHAR(3)    NOT NULL,
+    our_number  VARCHAR(20)   NOT NULL,
+    occurred_at DATE          NOT NULL,
+    paid_value  DECIMAL(19,2) NOT NULL,
+    invoice_id  UUID          NOT NULL,
+    imported_at TIMESTAMP     NOT NULL DEFAULT NOW(),
+    CONSTRAINT cnab_settlements_pk PRIMARY KEY (bank_code, our_number, occurred_at, paid_value),
+    CONSTRAINT cnab_settlements_invoices_fk FOREIGN KEY (invoice_id) REFERENCES invoices (id) ON DELETE CASCADE
+);
+
+CREATE TABLE payers (
+    student_id UUID         NOT NULL,
+    name       VARCHAR(100) NOT NULL,
+    document   VARCHAR(14)  NOT NULL,
+    updated_at TIMESTAMP    NOT NULL,
+    CONSTRAINT payers_pk PRIMARY KEY (student_id)
+);
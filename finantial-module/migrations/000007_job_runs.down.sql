@@ -0,0 +1,6 @@
+-- DROP SCHEMA
+DROP TABLE IF EXISTS job_runs;
+
+-- DROP types
+DROP TYPE IF EXISTS JOB_RUN_STATUS;
+DROP TYPE IF EXISTS JOB_TRIGGER;
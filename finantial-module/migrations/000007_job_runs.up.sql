@@ -0,0 +1,28 @@
+-- CREATE TYPES
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'JOB_TRIGGER') THEN
+		CREATE TYPE JOB_TRIGGER AS ENUM ('SCHEDULED', 'MANUAL');
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'JOB_RUN_STATUS') THEN
+		CREATE TYPE JOB_RUN_STATUS AS ENUM ('RUNNING', 'SUCCEEDED', 'FAILED');
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- CREATE SCHEMA
+CREATE TABLE job_runs (
+    id            UUID           NOT NULL DEFAULT uuid_generate_v1mc(),
+    job_name      VARCHAR(100)   NOT NULL,
+    trigger       JOB_TRIGGER    NOT NULL,
+    status        JOB_RUN_STATUS NOT NULL,
+    scheduled_for TIMESTAMP,
+    started_at    TIMESTAMP      NOT NULL DEFAULT NOW(),
+    finished_at   TIMESTAMP,
+    error         TEXT           NOT NULL DEFAULT '',
+    instance      VARCHAR(255)   NOT NULL DEFAULT '',
+    CONSTRAINT job_runs_pk PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX job_runs_scheduled_for_idx ON job_runs (job_name, scheduled_for) WHERE scheduled_for IS NOT NULL;
+CREATE INDEX job_runs_started_at_idx ON job_runs (job_name, started_at DESC);
@@ -0,0 +1,5 @@
+-- DROP SCHEMA
+DROP TABLE IF EXISTS outbox;
+
+-- DROP types
+DROP TYPE IF EXISTS OUTBOX_STATUS;
@@ -0,0 +1,27 @@
+-- CREATE TYPES
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'OUTBOX_STATUS') THEN
+		CREATE TYPE OUTBOX_STATUS AS ENUM ('PENDING', 'SENT', 'FAILED');
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- CREATE SCHEMA
+CREATE TABLE outbox (
+    id              UUID          NOT NULL,
+    topic           VARCHAR(100)  NOT NULL,
+    action          VARCHAR(100)  NOT NULL,
+    payload         JSONB         NOT NULL,
+    correlation_id  VARCHAR(100)  NOT NULL DEFAULT '',
+    status          OUTBOX_STATUS NOT NULL DEFAULT 'PENDING',
+    attempts        INT4          NOT NULL DEFAULT 0,
+    last_error      TEXT          NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP     NOT NULL DEFAULT NOW(),
+    locked_until    TIMESTAMP,
+    created_at      TIMESTAMP     NOT NULL DEFAULT NOW(),
+    sent_at         TIMESTAMP,
+    CONSTRAINT outbox_pk PRIMARY KEY (id)
+);
+
+CREATE INDEX outbox_pending_idx ON outbox (next_attempt_at) WHERE status = 'PENDING';
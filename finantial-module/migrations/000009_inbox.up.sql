@@ -0,0 +1,12 @@
+-- CREATE SCHEMA
+CREATE TABLE inbox (
+    consumer     VARCHAR(100) NOT NULL,
+    message_id   UUID         NOT NULL,
+    business_key VARCHAR(255) NOT NULL DEFAULT '',
+    action       VARCHAR(100) NOT NULL,
+    received_at  TIMESTAMP    NOT NULL DEFAULT NOW(),
+    CONSTRAINT inbox_pk PRIMARY KEY (consumer, message_id)
+);
+
+CREATE UNIQUE INDEX inbox_business_key_idx ON inbox (consumer, business_key) WHERE business_key <> '';
+CREATE INDEX inbox_received_at_idx ON inbox (received_at);
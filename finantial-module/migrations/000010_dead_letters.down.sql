@@ -0,0 +1,5 @@
+-- DROP SCHEMA
+DROP TABLE IF EXISTS dead_letters;
+
+-- DROP types
+DROP TYPE IF EXISTS DEAD_LETTER_STATUS;
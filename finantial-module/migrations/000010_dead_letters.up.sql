@@ -0,0 +1,29 @@
+-- CREATE TYPES
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'DEAD_LETTER_STATUS') THEN
+		CREATE TYPE DEAD_LETTER_STATUS AS ENUM ('PENDING', 'REPLAYED', 'DISCARDED');
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- CREATE SCHEMA
+CREATE TABLE dead_letters (
+    id             UUID               NOT NULL DEFAULT uuid_generate_v1mc(),
+    queue          VARCHAR(100)       NOT NULL,
+    message_id     UUID               NOT NULL,
+    origin         VARCHAR(255)       NOT NULL DEFAULT '',
+    action         VARCHAR(100)       NOT NULL,
+    payload        JSONB              NOT NULL,
+    correlation_id VARCHAR(100)       NOT NULL DEFAULT '',
+    error          TEXT               NOT NULL,
+    attempts       INT4               NOT NULL,
+    status         DEAD_LETTER_STATUS NOT NULL DEFAULT 'PENDING',
+    created_at     TIMESTAMP          NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMP          NOT NULL DEFAULT NOW(),
+    resolved_at    TIMESTAMP,
+    CONSTRAINT dead_letters_pk PRIMARY KEY (id),
+    CONSTRAINT dead_letters_message_uk UNIQUE (queue, message_id)
+);
+
+CREATE INDEX dead_letters_status_idx ON dead_letters (status, created_at DESC);
@@ -0,0 +1,9 @@
+-- CREATE SCHEMA
+CREATE TABLE courses (
+    id         UUID          NOT NULL,
+    name       VARCHAR(255)  NOT NULL,
+    value      DECIMAL(19,2) NOT NULL,
+    updated_at TIMESTAMP     NOT NULL,
+    deleted_at TIMESTAMP,
+    CONSTRAINT courses_pk PRIMARY KEY (id)
+);
@@ -0,0 +1,2 @@
+-- DROP INDEX
+DROP INDEX IF EXISTS accounts_enrollment_idx;
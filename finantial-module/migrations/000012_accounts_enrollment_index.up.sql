@@ -0,0 +1,2 @@
+-- CREATE INDEX
+CREATE INDEX IF NOT EXISTS accounts_enrollment_idx ON accounts (student_id, course_id, id);
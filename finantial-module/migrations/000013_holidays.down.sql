@@ -0,0 +1,2 @@
+-- DROP SCHEMA
+DROP TABLE IF EXISTS holidays;
@@ -0,0 +1,7 @@
+-- CREATE SCHEMA
+CREATE TABLE holidays (
+    date       DATE         NOT NULL,
+    name       VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    CONSTRAINT holidays_pk PRIMARY KEY (date)
+);
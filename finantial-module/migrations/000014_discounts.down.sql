@@ -0,0 +1,10 @@
+-- ALTER SCHEMA
+ALTER TABLE invoices DROP COLUMN IF EXISTS discount;
+ALTER TABLE invoices DROP COLUMN IF EXISTS gross_value;
+
+-- DROP SCHEMA
+DROP TABLE IF EXISTS invoice_discounts;
+DROP TABLE IF EXISTS discounts;
+
+-- DROP types
+DROP TYPE IF EXISTS DISCOUNT_TYPE;
@@ -0,0 +1,45 @@
+-- CREATE TYPES
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'DISCOUNT_TYPE') THEN
+		CREATE TYPE DISCOUNT_TYPE AS ENUM ('PERCENTAGE', 'FIXED');
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- CREATE SCHEMA
+CREATE TABLE discounts (
+    id          UUID          NOT NULL DEFAULT uuid_generate_v1mc(),
+    student_id  UUID          NOT NULL,
+    course_id   UUID,
+    name        VARCHAR(100)  NOT NULL,
+    type        DISCOUNT_TYPE NOT NULL,
+    value       DECIMAL(19,2) NOT NULL,
+    percentage  DECIMAL(5,2)  NOT NULL DEFAULT 0,
+    stackable   BOOLEAN       NOT NULL DEFAULT TRUE,
+    valid_from  DATE          NOT NULL,
+    valid_until DATE,
+    created_at  TIMESTAMP     NOT NULL DEFAULT NOW(),
+    CONSTRAINT discounts_pk PRIMARY KEY (id)
+);
+
+CREATE INDEX discounts_student_idx ON discounts (student_id);
+
+CREATE TABLE invoice_discounts (
+    invoice_id  UUID          NOT NULL,
+    position    INT2          NOT NULL,
+    discount_id UUID,
+    name        VARCHAR(100)  NOT NULL,
+    type        DISCOUNT_TYPE NOT NULL,
+    value       DECIMAL(19,2) NOT NULL,
+    percentage  DECIMAL(5,2)  NOT NULL DEFAULT 0,
+    amount      DECIMAL(19,2) NOT NULL,
+    CONSTRAINT invoice_discounts_pk PRIMARY KEY (invoice_id, position),
+    CONSTRAINT invoice_discounts_invoices_fk FOREIGN KEY (invoice_id) REFERENCES invoices (id) ON DELETE CASCADE,
+    CONSTRAINT invoice_discounts_discounts_fk FOREIGN KEY (discount_id) REFERENCES discounts (id) ON DELETE SET NULL
+);
+
+-- ALTER SCHEMA
+ALTER TABLE invoices ADD COLUMN gross_value DECIMAL(19,2) NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN discount DECIMAL(19,2) NOT NULL DEFAULT 0;
+UPDATE invoices SET gross_value = value;
@@ -0,0 +1,12 @@
+-- DROP INDEX
+DROP INDEX IF EXISTS invoices_installment_idx;
+
+-- ALTER SCHEMA
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS invoices_replaced_by_fk;
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS invoices_agreements_fk;
+ALTER TABLE invoices DROP COLUMN IF EXISTS replaced_by;
+ALTER TABLE invoices DROP COLUMN IF EXISTS agreement_id;
+ALTER TABLE invoices DROP COLUMN IF EXISTS cancelled_at;
+
+-- DROP SCHEMA
+DROP TABLE IF EXISTS agreements;
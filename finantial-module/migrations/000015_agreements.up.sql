@@ -0,0 +1,27 @@
+-- CREATE SCHEMA
+CREATE TABLE agreements (
+    id           UUID          NOT NULL DEFAULT uuid_generate_v1mc(),
+    account_id   UUID          NOT NULL,
+    principal    DECIMAL(19,2) NOT NULL,
+    late_charges DECIMAL(19,2) NOT NULL,
+    value        DECIMAL(19,2) NOT NULL,
+    down_payment DECIMAL(19,2) NOT NULL DEFAULT 0,
+    installments INT2          NOT NULL,
+    created_at   TIMESTAMP     NOT NULL DEFAULT NOW(),
+    CONSTRAINT agreements_pk PRIMARY KEY (id),
+    CONSTRAINT agreements_accounts_fk FOREIGN KEY (account_id) REFERENCES accounts (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX agreements_account_idx ON agreements (account_id);
+
+-- ALTER SCHEMA
+ALTER TABLE invoices ADD COLUMN cancelled_at TIMESTAMP;
+ALTER TABLE invoices ADD COLUMN agreement_id UUID;
+ALTER TABLE invoices ADD COLUMN replaced_by UUID;
+ALTER TABLE invoices ADD CONSTRAINT invoices_agreements_fk FOREIGN KEY (agreement_id) REFERENCES agreements (id);
+ALTER TABLE invoices ADD CONSTRAINT invoices_replaced_by_fk FOREIGN KEY (replaced_by) REFERENCES agreements (id);
+
+-- CREATE INDEX
+-- Agreement invoices are numbered within the agreement, with the down payment
+-- as installment 0.
+CREATE UNIQUE INDEX invoices_installment_idx ON invoices (account_id, agreement_id, installment) NULLS NOT DISTINCT;
@@ -0,0 +1,14 @@
+-- ALTER SCHEMA
+ALTER TABLE invoices DROP COLUMN IF EXISTS written_off_at;
+
+-- DROP SCHEMA
+DROP TABLE IF EXISTS refunds;
+DROP TABLE IF EXISTS cancellations;
+
+-- DROP types
+DROP TYPE IF EXISTS REFUND_STATUS;
+
+-- ALTER TYPES
+-- PostgreSQL cannot drop an enum value, so CANCELADO is kept in
+-- ACCOUNT_STATUS; the accounts using it are moved back to ADIMPLENTE.
+UPDATE accounts SET status = 'ADIMPLENTE' WHERE status = 'CANCELADO';
@@ -0,0 +1,48 @@
+-- CREATE TYPES
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'REFUND_STATUS') THEN
+		CREATE TYPE REFUND_STATUS AS ENUM ('PENDING', 'PAID');
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- ALTER TYPES
+ALTER TYPE ACCOUNT_STATUS ADD VALUE IF NOT EXISTS 'CANCELADO';
+
+-- CREATE SCHEMA
+CREATE TABLE cancellations (
+    id               UUID          NOT NULL DEFAULT uuid_generate_v1mc(),
+    account_id       UUID          NOT NULL,
+    reason           TEXT          NOT NULL DEFAULT '',
+    prorated_value   DECIMAL(19,2) NOT NULL,
+    final_charge     DECIMAL(19,2) NOT NULL,
+    refund           DECIMAL(19,2) NOT NULL,
+    final_invoice_id UUID,
+    created_at       TIMESTAMP     NOT NULL DEFAULT NOW(),
+    CONSTRAINT cancellations_pk PRIMARY KEY (id),
+    CONSTRAINT cancellations_account_uk UNIQUE (account_id),
+    CONSTRAINT cancellations_accounts_fk FOREIGN KEY (account_id) REFERENCES accounts (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT cancellations_invoices_fk FOREIGN KEY (final_invoice_id) REFERENCES invoices (id)
+);
+
+CREATE TABLE refunds (
+    id              UUID          NOT NULL DEFAULT uuid_generate_v1mc(),
+    account_id      UUID          NOT NULL,
+    cancellation_id UUID          NOT NULL,
+    value           DECIMAL(19,2) NOT NULL,
+    status          REFUND_STATUS NOT NULL,
+    created_at      TIMESTAMP     NOT NULL DEFAULT NOW(),
+    paid_at         TIMESTAMP,
+    CONSTRAINT refunds_pk PRIMARY KEY (id),
+    CONSTRAINT refunds_cancellation_uk UNIQUE (cancellation_id),
+    CONSTRAINT refunds_accounts_fk FOREIGN KEY (account_id) REFERENCES accounts (id) ON DELETE RESTRICT ON UPDATE CASCADE,
+    CONSTRAINT refunds_cancellations_fk FOREIGN KEY (cancellation_id) REFERENCES cancellations (id) ON DELETE RESTRICT
+);
+
+CREATE INDEX refunds_status_idx ON refunds (status);
+
+-- ALTER SCHEMA
+-- Cancelled invoices whose boleto was sent to the bank are written off in
+-- the next remittance.
+ALTER TABLE invoices ADD COLUMN written_off_at TIMESTAMP;
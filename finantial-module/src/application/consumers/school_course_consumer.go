@@ -0,0 +1,65 @@
+package consumers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	eventconsumers "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/consumers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/google/uuid"
+)
+
+type SchoolCourseConsumer struct {
+	*eventconsumers.ActionRouter
+	Usecase usecases.CourseUsecases
+}
+
+func NewSchoolCourseConsumer() *SchoolCourseConsumer {
+	c := &SchoolCourseConsumer{
+		ActionRouter: eventconsumers.NewActionRouter("SCHOOL_COURSE_FINANCIAL"),
+		Usecase:      usecases.NewCourseUsecase(),
+	}
+
+	eventconsumers.HandleEvent(c.ActionRouter, c.createCourse)
+	eventconsumers.HandleEvent(c.ActionRouter, c.updateCourse)
+	eventconsumers.HandleEvent(c.ActionRouter, c.deleteCourse)
+
+	return c
+}
+
+func (c *SchoolCourseConsumer) createCourse(ctx context.Context, data *contracts.CourseCreatedV1) error {
+	logging.Info(ctx).
+		AddParam("courseID", data.ID).
+		Msg("Course created received")
+
+	return c.saveCourse(ctx, data.ID, data.Name, data.Value, data.CreatedAt)
+}
+
+func (c *SchoolCourseConsumer) updateCourse(ctx context.Context, data *contracts.CourseUpdatedV1) error {
+	logging.Info(ctx).
+		AddParam("courseID", data.ID).
+		Msg("Course updated received")
+
+	return c.saveCourse(ctx, data.ID, data.Name, data.Value, data.UpdatedAt)
+}
+
+func (c *SchoolCourseConsumer) saveCourse(ctx context.Context, id uuid.UUID, name string, rawValue json.Number, updatedAt time.Time) error {
+	value, err := models.ParseMoney(rawValue.String())
+	if err != nil {
+		return eventconsumers.Permanent(err)
+	}
+
+	return c.Usecase.Save(ctx, &models.Course{ID: id, Name: name, Value: value, UpdatedAt: updatedAt})
+}
+
+func (c *SchoolCourseConsumer) deleteCourse(ctx context.Context, data *contracts.CourseDeletedV1) error {
+	logging.Info(ctx).
+		AddParam("courseID", data.ID).
+		Msg("Course deleted received")
+
+	return c.Usecase.Delete(ctx, data.ID)
+}
@@ -0,0 +1,92 @@
+package consumers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	eventconsumers "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/consumers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+)
+
+type SchoolEnrollmentConsumer struct {
+	*eventconsumers.ActionRouter
+	Usecase usecases.AccountUsecases
+}
+
+func NewSchoolEnrollmentConsumer() *SchoolEnrollmentConsumer {
+	c := &SchoolEnrollmentConsumer{
+		ActionRouter: eventconsumers.NewActionRouter("SCHOOL_ENROLLMENT_FINANCIAL"),
+		Usecase:      usecases.NewAccountUsecase(),
+	}
+
+	eventconsumers.HandleEvent(c.ActionRouter, c.createEnrollment)
+	eventconsumers.HandleEvent(c.ActionRouter, c.deleteEnrollment)
+	eventconsumers.HandleEvent(c.ActionRouter, c.cancelEnrollment)
+
+	return c
+}
+
+func (c *SchoolEnrollmentConsumer) createEnrollment(ctx context.Context, data *contracts.EnrollmentCreatedV2) error {
+	logging.Info(ctx).
+		AddParam("studentID", data.Student.ID).
+		AddParam("courseID", data.Course.ID).
+		Msg("Enrollment created received")
+
+	value, err := models.ParseMoney(data.Course.Value.String())
+	if err != nil {
+		return eventconsumers.Permanent(err)
+	}
+
+	model := &models.Enrollment{
+		Student:      models.Student{ID: data.Student.ID},
+		Course:       models.Course{ID: data.Course.ID, Value: value},
+		Installments: data.Installments,
+		CreatedAt:    data.CreatedAt,
+	}
+
+	return c.Usecase.Create(ctx, model.ToAccount())
+}
+
+func (c *SchoolEnrollmentConsumer) deleteEnrollment(ctx context.Context, data *contracts.EnrollmentDeletedV1) error {
+	logging.Info(ctx).
+		AddParam("studentID", data.StudentID).
+		AddParam("courseID", data.CourseID).
+		Msg("Enrollment deleted received")
+
+	return c.Usecase.CancelByStudentAndCourse(ctx, data.StudentID, data.CourseID, models.CancellationEnrollmentDeleted)
+}
+
+func (c *SchoolEnrollmentConsumer) cancelEnrollment(ctx context.Context, data *contracts.EnrollmentCancelledV1) error {
+	logging.Info(ctx).
+		AddParam("studentID", data.StudentID).
+		AddParam("courseID", data.CourseID).
+		AddParam("reason", data.Reason).
+		Msg("Enrollment cancelled received")
+
+	return c.Usecase.CancelByStudentAndCourse(ctx, data.StudentID, data.CourseID, data.Reason)
+}
+
+// BusinessKey identifies a created enrollment by student, course and creation
+// time, so that re-enrolling after a deletion is not taken as a duplicate.
+func (c *SchoolEnrollmentConsumer) BusinessKey(providerMessage *messaging.ProviderMessage) string {
+	if providerMessage.Action != contracts.EnrollmentCreatedType {
+		return ""
+	}
+
+	event, err := eventconsumers.DecodeEvent(providerMessage)
+	if err != nil {
+		return ""
+	}
+
+	var data contracts.EnrollmentCreatedV2
+	if err := event.DecodeData(&data); err != nil || data.CreatedAt.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%s:%s:%s", providerMessage.Action, data.Student.ID, data.Course.ID, data.CreatedAt.UTC().Format(time.RFC3339Nano))
+}
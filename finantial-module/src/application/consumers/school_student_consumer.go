@@ -0,0 +1,35 @@
+package consumers
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	eventconsumers "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/consumers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+)
+
+type SchoolStudentConsumer struct {
+	*eventconsumers.ActionRouter
+	Usecase usecases.AccountUsecases
+}
+
+func NewSchoolStudentConsumer() *SchoolStudentConsumer {
+	c := &SchoolStudentConsumer{
+		ActionRouter: eventconsumers.NewActionRouter("SCHOOL_STUDENT_FINANCIAL"),
+		Usecase:      usecases.NewAccountUsecase(),
+	}
+
+	eventconsumers.HandleEvent(c.ActionRouter, c.deleteStudent)
+
+	return c
+}
+
+func (c *SchoolStudentConsumer) deleteStudent(ctx context.Context, data *contracts.StudentDeletedV1) error {
+	logging.Info(ctx).
+		AddParam("studentID", data.ID).
+		Msg("Student deleted received")
+
+	return c.Usecase.CancelByStudent(ctx, data.ID, models.CancellationStudentDeleted)
+}
@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+	"github.com/google/uuid"
+)
+
+type AgreementController struct {
+	Usecase usecases.AgreementUsecases
+}
+
+func NewAgreementController() *AgreementController {
+	return &AgreementController{
+		Usecase: usecases.NewAgreementUsecase(),
+	}
+}
+
+func (p *AgreementController) Routes() []restserver.Route {
+	return []restserver.Route{
+		{
+			URI:      "accounts/{id}/agreements",
+			Method:   http.MethodGet,
+			Function: p.GetAllByAccount,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "accounts/{id}/agreements",
+			Method:   http.MethodPost,
+			Function: p.Create,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Get account renegotiation agreements
+// @Tags agreements
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.AgreementDetail
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Param id path string true "Account ID"
+// @Router /public/accounts/{id}/agreements [get]
+func (p *AgreementController) GetAllByAccount(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	list, err := p.Usecase.GetAllByAccount(ctx.Context(), paramId)
+	if err != nil {
+		if err.Error() == exceptions.ErrAccountNotFound {
+			ctx.ErrorResponse(http.StatusNotFound, err)
+			return
+		}
+
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, list)
+}
+
+// @Summary Renegotiate overdue invoices
+// @Description Cancels the selected overdue invoices and splits their updated balance into a new plan, with an optional down payment
+// @Tags agreements
+// @Accept json
+// @Produce json
+// @Success 201 {object} models.AgreementDetail
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Param id path string true "Account ID"
+// @Param request body models.AgreementRequest true "request body"
+// @Router /public/accounts/{id}/agreements [post]
+func (p *AgreementController) Create(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	var body models.AgreementRequest
+	if err := ctx.DecodeBody(&body); err != nil {
+		ctx.ErrorResponse(http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	detail, err := p.Usecase.Create(ctx.Context(), paramId, &body)
+	if err != nil {
+		switch err.Error() {
+		case exceptions.ErrAccountNotFound:
+			ctx.ErrorResponse(http.StatusNotFound, err)
+		case exceptions.ErrAgreementInvoiceNotOverdue, exceptions.ErrAgreementDownPaymentTooHigh:
+			ctx.ErrorResponse(http.StatusConflict, err)
+		default:
+			ctx.ErrorResponse(http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JsonResponse(http.StatusCreated, detail)
+}
@@ -0,0 +1,163 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/cnab"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+)
+
+const maxReturnFileSize = 10 << 20
+
+type CnabController struct {
+	Usecase usecases.CnabUsecases
+}
+
+func NewCnabController() *CnabController {
+	return &CnabController{
+		Usecase: usecases.NewCnabUsecase(),
+	}
+}
+
+func (p *CnabController) Routes() []restserver.Route {
+	return []restserver.Route{
+		{
+			URI:      "cnab/remittances",
+			Method:   http.MethodPost,
+			Function: p.ExportRemittance,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "cnab/remittances/{name}",
+			Method:   http.MethodGet,
+			Function: p.DownloadRemittance,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "cnab/returns",
+			Method:   http.MethodPost,
+			Function: p.ImportReturn,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Export CNAB remittance file with the boletos not yet sent to the bank
+// @Tags cnab
+// @Produce plain
+// @Success 200 {file} file
+// @Success 204
+// @Failure 400
+// @Failure 422
+// @Failure 500
+// @Failure 503
+// @Param format query string false "CNAB layout (240 or 400)" default(400)
+// @Router /public/cnab/remittances [post]
+func (p *CnabController) ExportRemittance(ctx restserver.WebContext) {
+	format, err := cnab.ParseFormat(ctx.QueryParam("format"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	file, err := p.Usecase.ExportRemittance(ctx.Context(), format)
+	if err != nil {
+		switch err.Error() {
+		case exceptions.ErrCnabNothingToRemit:
+			ctx.EmptyResponse(http.StatusNoContent)
+		case exceptions.ErrCnabPayerMissing:
+			ctx.ErrorResponse(http.StatusUnprocessableEntity, err)
+		case exceptions.ErrBoletoNotConfigured:
+			ctx.ErrorResponse(http.StatusServiceUnavailable, err)
+		default:
+			ctx.ErrorResponse(http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	defer os.Remove(file.Path)
+
+	ctx.AddHeader("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Name))
+	ctx.AddHeader("X-Skipped-Records", strconv.Itoa(file.Skipped))
+	ctx.AddHeader("X-Write-Off-Records", strconv.Itoa(file.WriteOffs))
+	ctx.ServeFile(file.Path)
+}
+
+// @Summary Download a previously exported CNAB remittance file
+// @Tags cnab
+// @Produce plain
+// @Success 200 {file} file
+// @Failure 404
+// @Failure 500
+// @Param name path string true "File name"
+// @Router /public/cnab/remittances/{name} [get]
+func (p *CnabController) DownloadRemittance(ctx restserver.WebContext) {
+	file, err := p.Usecase.GetRemittance(ctx.Context(), ctx.PathParam("name"))
+	if err != nil {
+		if err.Error() == exceptions.ErrCnabRemittanceNotFound {
+			ctx.ErrorResponse(http.StatusNotFound, err)
+			return
+		}
+
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	defer os.Remove(file.Path)
+
+	ctx.AddHeader("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Name))
+	ctx.ServeFile(file.Path)
+}
+
+// @Summary Import CNAB return file settling the paid invoices
+// @Tags cnab
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Success 200 {object} models.CnabImportReport
+// @Failure 400
+// @Failure 413
+// @Failure 422
+// @Failure 500
+// @Param file formData file true "CNAB 240 or 400 return file"
+// @Router /public/cnab/returns [post]
+func (p *CnabController) ImportReturn(ctx restserver.WebContext) {
+	file, _, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+	defer file.Close()
+
+	// One byte over the limit tells a larger file apart from one of exactly
+	// the limit, instead of importing it truncated.
+	data, err := io.ReadAll(io.LimitReader(file, maxReturnFileSize+1))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	if len(data) > maxReturnFileSize {
+		ctx.ErrorResponse(http.StatusRequestEntityTooLarge, errors.New(exceptions.ErrCnabReturnFileTooLarge))
+		return
+	}
+
+	report, err := p.Usecase.ImportReturn(ctx.Context(), data)
+	if err != nil {
+		if err.Error() == exceptions.ErrCnabInvalidReturnFile {
+			ctx.ErrorResponse(http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, report)
+}
@@ -0,0 +1,171 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+	"github.com/google/uuid"
+)
+
+type DeadLetterController struct {
+	Usecase usecases.DeadLetterUsecases
+}
+
+func NewDeadLetterController(consumers ...messaging.QueueConsumer) *DeadLetterController {
+	return &DeadLetterController{
+		Usecase: usecases.NewDeadLetterUsecase(consumers...),
+	}
+}
+
+func (p *DeadLetterController) Routes() []restserver.Route {
+	return []restserver.Route{
+		{
+			URI:      "dead-letters",
+			Method:   http.MethodGet,
+			Function: p.GetAll,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "dead-letters/{id}",
+			Method:   http.MethodGet,
+			Function: p.GetById,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "dead-letters/{id}/replay",
+			Method:   http.MethodPost,
+			Function: p.Replay,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "dead-letters/{id}",
+			Method:   http.MethodDelete,
+			Function: p.Discard,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Get dead-lettered messages
+// @Tags dead-letters
+// @Accept json
+// @Produce json
+// @Success 200 {array} deadletters.DeadLetter
+// @Failure 400
+// @Failure 500
+// @Param queue query string false "Queue name"
+// @Param status query string false "Status (PENDING, REPLAYED, DISCARDED)"
+// @Param limit query int false "Maximum number of messages" default(50)
+// @Router /public/dead-letters [get]
+func (p *DeadLetterController) GetAll(ctx restserver.WebContext) {
+	var filter deadletters.Filter
+	if err := ctx.DecodeQueryParams(&filter); err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	list, err := p.Usecase.GetAll(ctx.Context(), &filter)
+	if err != nil {
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, list)
+}
+
+// @Summary Get a dead-lettered message
+// @Tags dead-letters
+// @Accept json
+// @Produce json
+// @Success 200 {object} deadletters.DeadLetter
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Param id path string true "Dead letter ID"
+// @Router /public/dead-letters/{id} [get]
+func (p *DeadLetterController) GetById(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	model, err := p.Usecase.GetByID(ctx.Context(), paramId)
+	if err != nil {
+		deadLetterErrorResponse(ctx, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, model)
+}
+
+// @Summary Replay a dead-lettered message
+// @Tags dead-letters
+// @Accept json
+// @Produce json
+// @Success 200 {object} deadletters.DeadLetter
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Param id path string true "Dead letter ID"
+// @Router /public/dead-letters/{id}/replay [post]
+func (p *DeadLetterController) Replay(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	model, err := p.Usecase.Replay(ctx.Context(), paramId)
+	if err != nil {
+		deadLetterErrorResponse(ctx, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, model)
+}
+
+// @Summary Discard a dead-lettered message
+// @Tags dead-letters
+// @Accept json
+// @Produce json
+// @Success 204
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Param id path string true "Dead letter ID"
+// @Router /public/dead-letters/{id} [delete]
+func (p *DeadLetterController) Discard(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	if err := p.Usecase.Discard(ctx.Context(), paramId); err != nil {
+		deadLetterErrorResponse(ctx, err)
+		return
+	}
+
+	ctx.EmptyResponse(http.StatusNoContent)
+}
+
+func deadLetterErrorResponse(ctx restserver.WebContext, err error) {
+	switch err.Error() {
+	case exceptions.ErrDeadLetterNotFound:
+		ctx.ErrorResponse(http.StatusNotFound, err)
+	case exceptions.ErrDeadLetterAlreadyResolved:
+		ctx.ErrorResponse(http.StatusConflict, err)
+	case exceptions.ErrDeadLetterQueueNotFound, exceptions.ErrDeadLetterReplayFailed:
+		ctx.ErrorResponse(http.StatusUnprocessableEntity, err)
+	default:
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+	}
+}
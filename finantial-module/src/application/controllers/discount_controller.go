@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+	"github.com/google/uuid"
+)
+
+type DiscountController struct {
+	Usecase usecases.DiscountUsecases
+}
+
+func NewDiscountController() *DiscountController {
+	return &DiscountController{
+		Usecase: usecases.NewDiscountUsecase(),
+	}
+}
+
+func (p *DiscountController) Routes() []restserver.Route {
+	return []restserver.Route{
+		{
+			URI:      "students/{id}/discounts",
+			Method:   http.MethodGet,
+			Function: p.GetByStudent,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "discounts",
+			Method:   http.MethodPost,
+			Function: p.Create,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "discounts/{id}",
+			Method:   http.MethodDelete,
+			Function: p.Delete,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Get student discounts
+// @Description Scholarships and discounts applied to the invoices of the student accounts
+// @Tags discounts
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.Discount
+// @Failure 400
+// @Failure 500
+// @Param id path string true "Student ID"
+// @Router /public/students/{id}/discounts [get]
+func (p *DiscountController) GetByStudent(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	list, err := p.Usecase.GetByStudent(ctx.Context(), paramId)
+	if err != nil {
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, list)
+}
+
+// @Summary Grant discount
+// @Description Granted discounts apply to the accounts created afterwards
+// @Tags discounts
+// @Accept json
+// @Produce json
+// @Success 201 {object} models.Discount
+// @Failure 422
+// @Failure 500
+// @Param request body models.Discount true "request body"
+// @Router /public/discounts [post]
+func (p *DiscountController) Create(ctx restserver.WebContext) {
+	var body models.Discount
+	if err := ctx.DecodeBody(&body); err != nil {
+		ctx.ErrorResponse(http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err := p.Usecase.Create(ctx.Context(), &body); err != nil {
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusCreated, body)
+}
+
+// @Summary Remove discount
+// @Description Invoices already issued keep the discounts they were created with
+// @Tags discounts
+// @Accept json
+// @Produce json
+// @Success 204
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Param id path string true "Discount ID"
+// @Router /public/discounts/{id} [delete]
+func (p *DiscountController) Delete(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	if err := p.Usecase.Delete(ctx.Context(), paramId); err != nil {
+		if err.Error() == exceptions.ErrDiscountNotFound {
+			ctx.ErrorResponse(http.StatusNotFound, err)
+			return
+		}
+
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.EmptyResponse(http.StatusNoContent)
+}
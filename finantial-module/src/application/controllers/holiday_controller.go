@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+)
+
+type HolidayController struct {
+	Usecase usecases.HolidayUsecases
+}
+
+func NewHolidayController() *HolidayController {
+	return &HolidayController{
+		Usecase: usecases.NewHolidayUsecase(),
+	}
+}
+
+func (p *HolidayController) Routes() []restserver.Route {
+	return []restserver.Route{
+		{
+			URI:      "holidays",
+			Method:   http.MethodGet,
+			Function: p.GetAll,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "holidays",
+			Method:   http.MethodPost,
+			Function: p.Create,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "holidays/{date}",
+			Method:   http.MethodDelete,
+			Function: p.Delete,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Get holidays
+// @Description National and registered holidays, which move installment due dates to the next business day
+// @Tags holidays
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.Holiday
+// @Failure 400
+// @Failure 500
+// @Param year query int false "Year, defaults to the current one"
+// @Router /public/holidays [get]
+func (p *HolidayController) GetAll(ctx restserver.WebContext) {
+	var filter models.HolidayFilter
+	if err := ctx.DecodeQueryParams(&filter); err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	list, err := p.Usecase.GetAll(ctx.Context(), filter.Year)
+	if err != nil {
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, list)
+}
+
+// @Summary Register holiday
+// @Tags holidays
+// @Accept json
+// @Produce json
+// @Success 201 {object} models.Holiday
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Param request body models.Holiday true "request body"
+// @Router /public/holidays [post]
+func (p *HolidayController) Create(ctx restserver.WebContext) {
+	var body models.Holiday
+	if err := ctx.DecodeBody(&body); err != nil {
+		ctx.ErrorResponse(http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err := p.Usecase.Create(ctx.Context(), &body); err != nil {
+		if err.Error() == exceptions.ErrHolidayAlreadyExists {
+			ctx.ErrorResponse(http.StatusConflict, err)
+			return
+		}
+
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusCreated, body)
+}
+
+// @Summary Remove registered holiday
+// @Tags holidays
+// @Accept json
+// @Produce json
+// @Success 204
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Param date path string true "Holiday date (YYYY-MM-DD)"
+// @Router /public/holidays/{date} [delete]
+func (p *HolidayController) Delete(ctx restserver.WebContext) {
+	date, err := time.Parse(time.DateOnly, ctx.PathParam("date"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	if err := p.Usecase.Delete(ctx.Context(), date); err != nil {
+		if err.Error() == exceptions.ErrHolidayNotFound {
+			ctx.ErrorResponse(http.StatusNotFound, err)
+			return
+		}
+
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.EmptyResponse(http.StatusNoContent)
+}
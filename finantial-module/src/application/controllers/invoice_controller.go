@@ -0,0 +1,202 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+	"github.com/google/uuid"
+)
+
+type InvoiceController struct {
+	Usecase usecases.InvoiceUsecases
+}
+
+func NewInvoiceController() *InvoiceController {
+	return &InvoiceController{
+		Usecase: usecases.NewInvoiceUsecase(),
+	}
+}
+
+func (p *InvoiceController) Routes() []restserver.Route {
+	return []restserver.Route{
+		{
+			URI:      "invoices",
+			Method:   http.MethodGet,
+			Function: p.GetAll,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "invoices/overdue/process",
+			Method:   http.MethodPost,
+			Function: p.ProcessOverdue,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "invoices/{id}",
+			Method:   http.MethodGet,
+			Function: p.GetById,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "invoices/{id}/pix",
+			Method:   http.MethodGet,
+			Function: p.GetPix,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "invoices/{id}/boleto",
+			Method:   http.MethodGet,
+			Function: p.GetBoleto,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Get invoice list
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.InvoiceDetail
+// @Failure 500
+// @Router /public/invoices [get]
+func (p *InvoiceController) GetAll(ctx restserver.WebContext) {
+	list, err := p.Usecase.GetAll(ctx.Context())
+	if err != nil {
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, list)
+}
+
+// @Summary Get invoice with the amount due on a date
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.InvoiceDetail
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Param id path string true "Invoice ID"
+// @Param date query string false "Reference date (YYYY-MM-DD), defaults to today"
+// @Router /public/invoices/{id} [get]
+func (p *InvoiceController) GetById(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	date := time.Now()
+	if paramDate := ctx.QueryParam("date"); paramDate != "" {
+		if date, err = time.Parse(time.DateOnly, paramDate); err != nil {
+			ctx.ErrorResponse(http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	detail, err := p.Usecase.GetById(ctx.Context(), paramId, date)
+	if err != nil {
+		if err.Error() == exceptions.ErrInvoiceNotFound {
+			ctx.ErrorResponse(http.StatusNotFound, err)
+			return
+		}
+
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, detail)
+}
+
+// @Summary Get PIX BR Code and QR code for an invoice
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.PixCharge
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Param id path string true "Invoice ID"
+// @Router /public/invoices/{id}/pix [get]
+func (p *InvoiceController) GetPix(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	charge, err := p.Usecase.GeneratePix(ctx.Context(), paramId)
+	if err != nil {
+		switch err.Error() {
+		case exceptions.ErrInvoiceNotFound:
+			ctx.ErrorResponse(http.StatusNotFound, err)
+		case exceptions.ErrInvoiceAlreadyPaid, exceptions.ErrInvoiceCancelled:
+			ctx.ErrorResponse(http.StatusConflict, err)
+		default:
+			ctx.ErrorResponse(http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, charge)
+}
+
+// @Summary Get invoice boleto
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.Boleto
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Failure 503
+// @Param id path string true "Invoice ID"
+// @Router /public/invoices/{id}/boleto [get]
+func (p *InvoiceController) GetBoleto(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := p.Usecase.GetBoleto(ctx.Context(), paramId)
+	if err != nil {
+		switch err.Error() {
+		case exceptions.ErrInvoiceNotFound:
+			ctx.ErrorResponse(http.StatusNotFound, err)
+		case exceptions.ErrInvoiceAlreadyPaid, exceptions.ErrInvoiceCancelled:
+			ctx.ErrorResponse(http.StatusConflict, err)
+		case exceptions.ErrBoletoNotConfigured:
+			ctx.ErrorResponse(http.StatusServiceUnavailable, err)
+		default:
+			ctx.ErrorResponse(http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, result)
+}
+
+// @Summary Process overdue accounts
+// @Description Updates the status of every account from its overdue invoices and publishes the changes
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.OverdueReport
+// @Failure 500
+// @Router /public/invoices/overdue/process [post]
+func (p *InvoiceController) ProcessOverdue(ctx restserver.WebContext) {
+	report, err := p.Usecase.ProcessOverdueAccounts(ctx.Context())
+	if err != nil {
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, report)
+}
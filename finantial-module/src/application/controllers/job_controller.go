@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+)
+
+type JobController struct {
+	Usecase usecases.JobUsecases
+}
+
+func NewJobController() *JobController {
+	return &JobController{
+		Usecase: usecases.NewJobUsecase(),
+	}
+}
+
+func (p *JobController) Routes() []restserver.Route {
+	return []restserver.Route{
+		{
+			URI:      "jobs",
+			Method:   http.MethodGet,
+			Function: p.GetAll,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "jobs/runs",
+			Method:   http.MethodGet,
+			Function: p.GetRuns,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "jobs/{name}/trigger",
+			Method:   http.MethodPost,
+			Function: p.Trigger,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Get scheduled jobs
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.Job
+// @Router /public/jobs [get]
+func (p *JobController) GetAll(ctx restserver.WebContext) {
+	ctx.JsonResponse(http.StatusOK, p.Usecase.GetAll(ctx.Context()))
+}
+
+// @Summary Get job run history
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.JobRun
+// @Failure 400
+// @Failure 500
+// @Param job query string false "Job name"
+// @Param limit query int false "Maximum number of runs" default(50)
+// @Router /public/jobs/runs [get]
+func (p *JobController) GetRuns(ctx restserver.WebContext) {
+	var filter models.JobRunFilter
+	if err := ctx.DecodeQueryParams(&filter); err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	list, err := p.Usecase.GetRuns(ctx.Context(), &filter)
+	if err != nil {
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, list)
+}
+
+// @Summary Trigger a job run
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @Success 202 {object} models.JobRun
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Param name path string true "Job name"
+// @Router /public/jobs/{name}/trigger [post]
+func (p *JobController) Trigger(ctx restserver.WebContext) {
+	run, err := p.Usecase.Trigger(ctx.Context(), ctx.PathParam("name"))
+	if err != nil {
+		switch err.Error() {
+		case exceptions.ErrJobNotFound:
+			ctx.ErrorResponse(http.StatusNotFound, err)
+		case exceptions.ErrJobAlreadyRunning:
+			ctx.ErrorResponse(http.StatusConflict, err)
+		default:
+			ctx.ErrorResponse(http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JsonResponse(http.StatusAccepted, run)
+}
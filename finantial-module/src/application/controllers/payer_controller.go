@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+	"github.com/google/uuid"
+)
+
+type PayerController struct {
+	Usecase usecases.PayerUsecases
+}
+
+func NewPayerController() *PayerController {
+	return &PayerController{
+		Usecase: usecases.NewPayerUsecase(),
+	}
+}
+
+func (p *PayerController) Routes() []restserver.Route {
+	return []restserver.Route{
+		{
+			URI:      "students/{id}/payer",
+			Method:   http.MethodGet,
+			Function: p.GetByStudent,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "students/{id}/payer",
+			Method:   http.MethodPut,
+			Function: p.Save,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Get student payer
+// @Tags payers
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.Payer
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Param id path string true "Student ID"
+// @Router /public/students/{id}/payer [get]
+func (p *PayerController) GetByStudent(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	payer, err := p.Usecase.GetByStudent(ctx.Context(), paramId)
+	if err != nil {
+		if err.Error() == exceptions.ErrPayerNotFound {
+			ctx.ErrorResponse(http.StatusNotFound, err)
+			return
+		}
+
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, payer)
+}
+
+// @Summary Register student payer
+// @Description Name and CPF/CNPJ of who pays the student invoices, required to register their boletos with the bank
+// @Tags payers
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.Payer
+// @Failure 400
+// @Failure 422
+// @Failure 500
+// @Param id path string true "Student ID"
+// @Param request body models.Payer true "request body"
+// @Router /public/students/{id}/payer [put]
+func (p *PayerController) Save(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	var body models.Payer
+	if err := ctx.DecodeBody(&body); err != nil {
+		ctx.ErrorResponse(http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	body.StudentID = paramId
+	if err := body.Prepare(); err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	if err := p.Usecase.Save(ctx.Context(), &body); err != nil {
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, body)
+}
@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+	"github.com/google/uuid"
+)
+
+type PaymentController struct {
+	Usecase usecases.PaymentUsecases
+}
+
+func NewPaymentController() *PaymentController {
+	return &PaymentController{
+		Usecase: usecases.NewPaymentUsecase(),
+	}
+}
+
+func (p *PaymentController) Routes() []restserver.Route {
+	return []restserver.Route{
+		{
+			URI:      "invoices/{id}/payments",
+			Method:   http.MethodGet,
+			Function: p.GetAllByInvoice,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "invoices/{id}/payments",
+			Method:   http.MethodPost,
+			Function: p.Create,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Get invoice payment list
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.Payment
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Param id path string true "Invoice ID"
+// @Router /public/invoices/{id}/payments [get]
+func (p *PaymentController) GetAllByInvoice(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	list, err := p.Usecase.GetAllByInvoice(ctx.Context(), paramId)
+	if err != nil {
+		if err.Error() == exceptions.ErrInvoiceNotFound {
+			ctx.ErrorResponse(http.StatusNotFound, err)
+			return
+		}
+
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, list)
+}
+
+// @Summary Register invoice payment
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Success 201 {object} models.Payment
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Param id path string true "Invoice ID"
+// @Param request body models.Payment true "request body"
+// @Router /public/invoices/{id}/payments [post]
+func (p *PaymentController) Create(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	var body models.Payment
+	if err := ctx.DecodeBody(&body); err != nil {
+		ctx.ErrorResponse(http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err = p.Usecase.Create(ctx.Context(), paramId, &body); err != nil {
+		switch err.Error() {
+		case exceptions.ErrPaymentInvalid:
+			ctx.ErrorResponse(http.StatusBadRequest, err)
+		case exceptions.ErrInvoiceNotFound:
+			ctx.ErrorResponse(http.StatusNotFound, err)
+		case exceptions.ErrInvoiceAlreadyPaid, exceptions.ErrInvoiceCancelled, exceptions.ErrPaymentExceedsOpenValue:
+			ctx.ErrorResponse(http.StatusConflict, err)
+		default:
+			ctx.ErrorResponse(http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JsonResponse(http.StatusCreated, body)
+}
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+)
+
+type ReconciliationController struct {
+	Usecase usecases.ReconciliationUsecases
+}
+
+func NewReconciliationController() *ReconciliationController {
+	return &ReconciliationController{
+		Usecase: usecases.NewReconciliationUsecase(),
+	}
+}
+
+func (p *ReconciliationController) Routes() []restserver.Route {
+	return []restserver.Route{
+		{
+			URI:      "reconciliations",
+			Method:   http.MethodPost,
+			Function: p.Reconcile,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Reconcile enrollments with accounts
+// @Tags reconciliations
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.ReconciliationReport
+// @Failure 400
+// @Failure 500
+// @Param heal query bool false "Heal the discrepancies found instead of only reporting them" default(false)
+// @Router /public/reconciliations [post]
+func (p *ReconciliationController) Reconcile(ctx restserver.WebContext) {
+	var params models.ReconciliationParams
+	if err := ctx.DecodeQueryParams(&params); err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	report, err := p.Usecase.Reconcile(ctx.Context(), params.Heal)
+	if err != nil {
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, report)
+}
@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+	"github.com/google/uuid"
+)
+
+type RefundController struct {
+	Usecase usecases.RefundUsecases
+}
+
+func NewRefundController() *RefundController {
+	return &RefundController{
+		Usecase: usecases.NewRefundUsecase(),
+	}
+}
+
+func (p *RefundController) Routes() []restserver.Route {
+	return []restserver.Route{
+		{
+			URI:      "refunds",
+			Method:   http.MethodGet,
+			Function: p.GetAll,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      "refunds/{id}/pay",
+			Method:   http.MethodPost,
+			Function: p.Pay,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Get refunds
+// @Description What is owed to students after the cancellation of accounts paid in advance
+// @Tags refunds
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.Refund
+// @Failure 400
+// @Failure 500
+// @Param status query string false "PENDING or PAID"
+// @Router /public/refunds [get]
+func (p *RefundController) GetAll(ctx restserver.WebContext) {
+	status := enums.RefundStatus(ctx.QueryParam("status"))
+	if status != "" && !status.IsValid() {
+		ctx.ErrorResponse(http.StatusBadRequest, fmt.Errorf("invalid refund status: %q", status))
+		return
+	}
+
+	list, err := p.Usecase.GetAll(ctx.Context(), status)
+	if err != nil {
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, list)
+}
+
+// @Summary Pay refund
+// @Description Marks the refund as paid back to the student
+// @Tags refunds
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.Refund
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Param id path string true "Refund ID"
+// @Router /public/refunds/{id}/pay [post]
+func (p *RefundController) Pay(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	refund, err := p.Usecase.Pay(ctx.Context(), paramId)
+	if err != nil {
+		switch err.Error() {
+		case exceptions.ErrRefundNotFound:
+			ctx.ErrorResponse(http.StatusNotFound, err)
+		case exceptions.ErrRefundAlreadyPaid:
+			ctx.ErrorResponse(http.StatusConflict, err)
+		default:
+			ctx.ErrorResponse(http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JsonResponse(http.StatusOK, refund)
+}
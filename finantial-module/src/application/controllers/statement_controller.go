@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+	"github.com/google/uuid"
+)
+
+type StatementController struct {
+	Usecase usecases.StatementUsecases
+}
+
+func NewStatementController() *StatementController {
+	return &StatementController{
+		Usecase: usecases.NewStatementUsecase(),
+	}
+}
+
+func (p *StatementController) Routes() []restserver.Route {
+	return []restserver.Route{
+		{
+			URI:      "students/{id}/statement",
+			Method:   http.MethodGet,
+			Function: p.GetByStudent,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Get student financial statement
+// @Description Accounts, invoices, discounts, late charges, payments and cancellations of the student in chronological order, with the running balance and the invoice totals per status
+// @Tags statements
+// @Accept json
+// @Produce json,text/csv
+// @Success 200 {object} models.Statement
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Param id path string true "Student ID"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param format query string false "json (default) or csv"
+// @Router /public/students/{id}/statement [get]
+func (p *StatementController) GetByStudent(ctx restserver.WebContext) {
+	paramId, err := uuid.Parse(ctx.PathParam("id"))
+	if err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	format := ctx.QueryParam("format")
+	if format != "" && format != "json" && format != "csv" {
+		ctx.ErrorResponse(http.StatusBadRequest, fmt.Errorf("unsupported statement format: %q", format))
+		return
+	}
+
+	var period models.StatementPeriod
+	if period.From, err = parseDateParam(ctx.QueryParam("from")); err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	if period.To, err = parseDateParam(ctx.QueryParam("to")); err != nil {
+		ctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	statement, err := p.Usecase.GetByStudent(ctx.Context(), paramId, period)
+	if err != nil {
+		switch err.Error() {
+		case exceptions.ErrStatementStudentNotFound:
+			ctx.ErrorResponse(http.StatusNotFound, err)
+		case exceptions.ErrStatementInvalidPeriod:
+			ctx.ErrorResponse(http.StatusBadRequest, err)
+		default:
+			ctx.ErrorResponse(http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	if format != "csv" {
+		ctx.JsonResponse(http.StatusOK, statement)
+		return
+	}
+
+	// The response is served from a temporary file, which is removed as soon
+	// as it has been sent.
+	file, err := os.CreateTemp("", "statement-*.csv")
+	if err != nil {
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+	defer os.Remove(file.Name())
+
+	if err := statement.WriteCSV(file); err != nil {
+		file.Close()
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := file.Close(); err != nil {
+		ctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.AddHeader("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "statement-"+paramId.String()+".csv"))
+	ctx.ServeFile(file.Name())
+}
+
+func parseDateParam(value string) (types.NullDateTime, error) {
+	if value == "" {
+		return types.NullDateTime{}, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return types.NullDateTime{}, err
+	}
+
+	return types.NullDateTime{Time: date, Valid: true}, nil
+}
@@ -0,0 +1,104 @@
+package boleto
+
+import (
+	"fmt"
+)
+
+// BancoDoBrasil implements the layout for 7 digit agreements ("convênio"),
+// where the "nosso número" is the agreement followed by a 10 digit sequence.
+type BancoDoBrasil struct {
+	Agreement string
+	Wallet    string
+}
+
+func NewBancoDoBrasil(agreement, wallet string) (*BancoDoBrasil, error) {
+	if len(agreement) != 7 || !isDigits(agreement) {
+		return nil, fmt.Errorf("invalid Banco do Brasil agreement: %q", agreement)
+	}
+
+	if len(wallet) != 2 || !isDigits(wallet) {
+		return nil, fmt.Errorf("invalid Banco do Brasil wallet: %q", wallet)
+	}
+
+	return &BancoDoBrasil{Agreement: agreement, Wallet: wallet}, nil
+}
+
+func (b *BancoDoBrasil) BankCode() string {
+	return "001"
+}
+
+func (b *BancoDoBrasil) OurNumber(sequence int64) (string, error) {
+	if sequence <= 0 || sequence > 9_999_999_999 {
+		return "", fmt.Errorf("our number sequence out of range: %d", sequence)
+	}
+
+	return fmt.Sprintf("%s%010d", b.Agreement, sequence), nil
+}
+
+func (b *BancoDoBrasil) FreeField(ourNumber string) (string, error) {
+	if len(ourNumber) != 17 || !isDigits(ourNumber) {
+		return "", fmt.Errorf("invalid Banco do Brasil our number: %q", ourNumber)
+	}
+
+	return "000000" + ourNumber + b.Wallet, nil
+}
+
+// Itau implements the layout for non-registered wallets such as 109, where
+// the "nosso número" has 8 digits.
+type Itau struct {
+	Agency  string
+	Account string
+	Wallet  string
+}
+
+func NewItau(agency, account, wallet string) (*Itau, error) {
+	if len(agency) != 4 || !isDigits(agency) {
+		return nil, fmt.Errorf("invalid Itaú agency: %q", agency)
+	}
+
+	if len(account) != 5 || !isDigits(account) {
+		return nil, fmt.Errorf("invalid Itaú account: %q", account)
+	}
+
+	if len(wallet) != 3 || !isDigits(wallet) {
+		return nil, fmt.Errorf("invalid Itaú wallet: %q", wallet)
+	}
+
+	return &Itau{Agency: agency, Account: account, Wallet: wallet}, nil
+}
+
+func (b *Itau) BankCode() string {
+	return "341"
+}
+
+func (b *Itau) OurNumber(sequence int64) (string, error) {
+	if sequence <= 0 || sequence > 99_999_999 {
+		return "", fmt.Errorf("our number sequence out of range: %d", sequence)
+	}
+
+	return fmt.Sprintf("%08d", sequence), nil
+}
+
+func (b *Itau) FreeField(ourNumber string) (string, error) {
+	if len(ourNumber) != 8 || !isDigits(ourNumber) {
+		return "", fmt.Errorf("invalid Itaú our number: %q", ourNumber)
+	}
+
+	ourNumberDigit := modulo10(b.Agency + b.Account + b.Wallet + ourNumber)
+	accountDigit := modulo10(b.Agency + b.Account)
+
+	return b.Wallet + ourNumber + ourNumberDigit + b.Agency + b.Account + accountDigit + "000", nil
+}
+
+// NewBankLayout returns the layout for the given bank code using the bank
+// specific settings.
+func NewBankLayout(bankCode string, settings map[string]string) (BankLayout, error) {
+	switch bankCode {
+	case "001":
+		return NewBancoDoBrasil(settings["agreement"], settings["wallet"])
+	case "341":
+		return NewItau(settings["agency"], settings["account"], settings["wallet"])
+	default:
+		return nil, fmt.Errorf("unsupported boleto bank: %q", bankCode)
+	}
+}
@@ -0,0 +1,138 @@
+package boleto
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+)
+
+const (
+	currencyCode  = "9"
+	maxValueCents = 9_999_999_999
+)
+
+var (
+	// The due date factor counts days from 1997-10-07. It reached 9999 on
+	// 2025-02-21 and restarted at 1000 on the following day.
+	factorBaseDate = time.Date(1997, time.October, 7, 0, 0, 0, 0, time.UTC)
+
+	ErrDueDateOutOfRange = errors.New("boleto due date out of range")
+	ErrValueOutOfRange   = errors.New("boleto value out of range")
+)
+
+// BankLayout builds the bank specific parts of a FEBRABAN boleto.
+type BankLayout interface {
+	BankCode() string
+	// OurNumber formats the "nosso número" for the given sequence.
+	OurNumber(sequence int64) (string, error)
+	// FreeField returns the 25 digit "campo livre" for the given "nosso número".
+	FreeField(ourNumber string) (string, error)
+}
+
+// Generate builds the barcode and "linha digitável" of a boleto. The same
+// "nosso número" may be reused to regenerate it after a due date change.
+func Generate(layout BankLayout, ourNumber string, dueDate time.Time, value models.Money) (models.Boleto, error) {
+	factor, err := dueDateFactor(dueDate)
+	if err != nil {
+		return models.Boleto{}, err
+	}
+
+	if value <= 0 || value.Cents() > maxValueCents {
+		return models.Boleto{}, ErrValueOutOfRange
+	}
+
+	freeField, err := layout.FreeField(ourNumber)
+	if err != nil {
+		return models.Boleto{}, err
+	}
+
+	if len(freeField) != 25 || !isDigits(freeField) {
+		return models.Boleto{}, fmt.Errorf("invalid free field for bank %s: %q", layout.BankCode(), freeField)
+	}
+
+	prefix := layout.BankCode() + currencyCode
+	suffix := fmt.Sprintf("%04d%010d", factor, value.Cents())
+	checkDigit := modulo11(prefix + suffix + freeField)
+	barcode := prefix + checkDigit + suffix + freeField
+
+	return models.Boleto{
+		BankCode:      layout.BankCode(),
+		OurNumber:     ourNumber,
+		Barcode:       barcode,
+		DigitableLine: digitableLine(barcode),
+	}, nil
+}
+
+// digitableLine rearranges the barcode into the five fields of the
+// "linha digitável", adding a modulo 10 check digit to the first three.
+func digitableLine(barcode string) string {
+	field1 := barcode[0:4] + barcode[19:24]
+	field2 := barcode[24:34]
+	field3 := barcode[34:44]
+
+	return field1 + modulo10(field1) +
+		field2 + modulo10(field2) +
+		field3 + modulo10(field3) +
+		barcode[4:5] +
+		barcode[5:19]
+}
+
+func dueDateFactor(dueDate time.Time) (int, error) {
+	date := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(date.Sub(factorBaseDate).Hours() / 24)
+	if days < 1000 {
+		return 0, ErrDueDateOutOfRange
+	}
+
+	if days > 9999 {
+		days = (days-10000)%9000 + 1000
+	}
+
+	return days, nil
+}
+
+// modulo10 computes the check digit with weights 2 and 1 alternating from the
+// right, summing the digits of each product.
+func modulo10(digits string) string {
+	sum := 0
+	weight := 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		product := int(digits[i]-'0') * weight
+		sum += product/10 + product%10
+		weight = 3 - weight
+	}
+
+	return fmt.Sprint((10 - sum%10) % 10)
+}
+
+// modulo11 computes the general barcode check digit with weights 2 to 9 from
+// the right. Results 0, 10 and 11 are replaced by 1.
+func modulo11(digits string) string {
+	sum := 0
+	weight := 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		if weight++; weight > 9 {
+			weight = 2
+		}
+	}
+
+	digit := 11 - sum%11
+	if digit == 0 || digit == 10 || digit == 11 {
+		digit = 1
+	}
+
+	return fmt.Sprint(digit)
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,77 @@
+package calendar
+
+import (
+	"time"
+)
+
+// Holiday is a day without banking business.
+type Holiday struct {
+	Date time.Time
+	Name string
+}
+
+// Calendar tells business days apart from weekends and holidays, taking the
+// national holidays and the given custom ones into account. Dates are
+// evaluated in the calendar location and represented as midnight UTC of the
+// local day, the way DATE columns are read back.
+type Calendar struct {
+	location *time.Location
+	custom   map[time.Time]string
+}
+
+func New(location *time.Location, custom []Holiday) *Calendar {
+	holidays := make(map[time.Time]string, len(custom))
+	for _, holiday := range custom {
+		holidays[Date(holiday.Date)] = holiday.Name
+	}
+
+	return &Calendar{location: location, custom: holidays}
+}
+
+// Date returns the day of the instant t as midnight UTC, keeping the year,
+// month and day t has in its own location.
+func Date(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Day returns the day of the instant t in the calendar location.
+func (c *Calendar) Day(t time.Time) time.Time {
+	return Date(t.In(c.location))
+}
+
+// Holiday returns the name of the holiday on the given day, if any.
+func (c *Calendar) Holiday(date time.Time) (string, bool) {
+	date = Date(date)
+	if name, ok := c.custom[date]; ok {
+		return name, true
+	}
+
+	for _, holiday := range NationalHolidays(date.Year()) {
+		if holiday.Date.Equal(date) {
+			return holiday.Name, true
+		}
+	}
+
+	return "", false
+}
+
+func (c *Calendar) IsBusinessDay(date time.Time) bool {
+	switch date.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+
+	_, holiday := c.Holiday(date)
+	return !holiday
+}
+
+// NextBusinessDay returns the given day when it is a business day, or the
+// first business day after it.
+func (c *Calendar) NextBusinessDay(date time.Time) time.Time {
+	date = Date(date)
+	for !c.IsBusinessDay(date) {
+		date = date.AddDate(0, 0, 1)
+	}
+
+	return date
+}
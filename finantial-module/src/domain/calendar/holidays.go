@@ -0,0 +1,65 @@
+package calendar
+
+import (
+	"slices"
+	"time"
+)
+
+// consciousnessDaySince is the first year Zumbi and Black Consciousness Day
+// is a national holiday (Lei 14.759/2023).
+const consciousnessDaySince = 2024
+
+// NationalHolidays returns the Brazilian days without banking business in the
+// year, ordered by date: the national holidays plus Carnival and Corpus
+// Christi, on which banks do not open. Good Friday falls after Tiradentes
+// in years with a late Easter, so the list is sorted once built.
+func NationalHolidays(year int) []Holiday {
+	easter := Easter(year)
+
+	holidays := []Holiday{
+		{fixed(year, time.January, 1), "Confraternização Universal"},
+		{easter.AddDate(0, 0, -48), "Carnaval"},
+		{easter.AddDate(0, 0, -47), "Carnaval"},
+		{easter.AddDate(0, 0, -2), "Sexta-feira da Paixão"},
+		{fixed(year, time.April, 21), "Tiradentes"},
+		{fixed(year, time.May, 1), "Dia do Trabalho"},
+		{easter.AddDate(0, 0, 60), "Corpus Christi"},
+		{fixed(year, time.September, 7), "Independência do Brasil"},
+		{fixed(year, time.October, 12), "Nossa Senhora Aparecida"},
+		{fixed(year, time.November, 2), "Finados"},
+		{fixed(year, time.November, 15), "Proclamação da República"},
+	}
+
+	if year >= consciousnessDaySince {
+		holidays = append(holidays, Holiday{fixed(year, time.November, 20), "Dia Nacional de Zumbi e da Consciência Negra"})
+	}
+
+	holidays = append(holidays, Holiday{fixed(year, time.December, 25), "Natal"})
+	slices.SortStableFunc(holidays, func(a, b Holiday) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	return holidays
+}
+
+// Easter returns the Easter Sunday of the year in the Gregorian calendar,
+// using the anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+func Easter(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return fixed(year, time.Month(month), day)
+}
+
+func fixed(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
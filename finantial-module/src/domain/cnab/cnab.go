@@ -0,0 +1,126 @@
+package cnab
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+)
+
+// Format is the CNAB layout, named after its line length.
+type Format int
+
+const (
+	Format240 Format = 240
+	Format400 Format = 400
+)
+
+func ParseFormat(value string) (Format, error) {
+	switch value {
+	case "240":
+		return Format240, nil
+	case "400", "":
+		return Format400, nil
+	default:
+		return 0, fmt.Errorf("unsupported CNAB format: %q", value)
+	}
+}
+
+func (f Format) IsValid() bool {
+	return f == Format240 || f == Format400
+}
+
+// Company identifies the beneficiary ("cedente") of the remittance.
+type Company struct {
+	Name      string
+	Document  string
+	BankCode  string
+	BankName  string
+	Agency    string
+	Account   string
+	Agreement string
+	Wallet    string
+}
+
+// Remittance instruction codes ("código de movimento") used by both layouts.
+const (
+	InstructionEntry    = "01"
+	InstructionWriteOff = "02"
+)
+
+// Title is a boleto registered with the bank through a remittance file.
+// Reference is echoed back by the bank in the return file. Instruction is
+// what the bank is asked to do with the title, registering it when empty.
+type Title struct {
+	Instruction   string
+	OurNumber     string
+	Reference     string
+	DueDate       time.Time
+	IssuedAt      time.Time
+	Value         models.Money
+	PayerDocument string
+	PayerName     string
+}
+
+func (t Title) instruction() string {
+	if t.Instruction == "" {
+		return InstructionEntry
+	}
+
+	return t.Instruction
+}
+
+type Remittance struct {
+	Format    Format
+	Sequence  int64
+	CreatedAt time.Time
+	Company   Company
+	Titles    []Title
+}
+
+// Return file occurrence codes used by both layouts.
+const (
+	OccurrenceEntryConfirmed   = "02"
+	OccurrenceEntryRejected    = "03"
+	OccurrenceSettled          = "06"
+	OccurrenceSettledAtNotary  = "08"
+	OccurrenceSettledAfterDrop = "17"
+)
+
+// ReturnRecord is a title movement reported by the bank.
+type ReturnRecord struct {
+	Line       int
+	OurNumber  string
+	Reference  string
+	Occurrence string
+	Value      models.Money
+	PaidValue  models.Money
+	Charges    models.Money
+	OccurredAt time.Time
+}
+
+func (r ReturnRecord) IsSettlement() bool {
+	switch r.Occurrence {
+	case OccurrenceSettled, OccurrenceSettledAtNotary, OccurrenceSettledAfterDrop:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r ReturnRecord) IsRejection() bool {
+	return r.Occurrence == OccurrenceEntryRejected
+}
+
+// LineError reports a return file line that could not be parsed.
+type LineError struct {
+	Line    int
+	Message string
+}
+
+type ReturnFile struct {
+	Format   Format
+	BankCode string
+	Records  []ReturnRecord
+	Errors   []LineError
+}
@@ -0,0 +1,115 @@
+package cnab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"golang.org/x/text/unicode/norm"
+)
+
+// record is a fixed width line. Positions follow the bank manuals: they are
+// one based and both ends are inclusive.
+type record []byte
+
+func newRecord(size int) record {
+	return record(strings.Repeat(" ", size))
+}
+
+// alpha writes a left aligned, blank padded, upper case ASCII value.
+func (r record) alpha(start, end int, value string) {
+	width := end - start + 1
+	value = asciiUpper(value)
+	if len(value) > width {
+		value = value[:width]
+	}
+
+	copy(r[start-1:end], value+strings.Repeat(" ", width-len(value)))
+}
+
+// num writes a right aligned, zero padded number.
+func (r record) num(start, end int, value int64) {
+	r.digits(start, end, strconv.FormatInt(value, 10))
+}
+
+// digits writes a right aligned, zero padded digit string, keeping the
+// rightmost digits when it does not fit.
+func (r record) digits(start, end int, value string) {
+	width := end - start + 1
+	value = onlyDigits(value)
+	if len(value) > width {
+		value = value[len(value)-width:]
+	}
+
+	copy(r[start-1:end], strings.Repeat("0", width-len(value))+value)
+}
+
+func (r record) date6(start int, value time.Time) {
+	copy(r[start-1:start+5], value.Format("020106"))
+}
+
+func (r record) date8(start int, value time.Time) {
+	copy(r[start-1:start+7], value.Format("02012006"))
+}
+
+func field(line string, start, end int) string {
+	return line[start-1 : end]
+}
+
+func moneyField(line string, start, end int) (models.Money, error) {
+	raw := field(line, start, end)
+	cents, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value at positions %d-%d: %q", start, end, raw)
+	}
+
+	return models.NewMoneyFromCents(cents), nil
+}
+
+// dateField parses DDMMYY or DDMMYYYY dates. Blank or zeroed dates are
+// returned as the zero time.
+func dateField(line string, start, end int) (time.Time, error) {
+	raw := field(line, start, end)
+	if strings.Trim(raw, "0 ") == "" {
+		return time.Time{}, nil
+	}
+
+	layout := "020106"
+	if len(raw) == 8 {
+		layout = "02012006"
+	}
+
+	value, err := time.Parse(layout, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date at positions %d-%d: %q", start, end, raw)
+	}
+
+	return value, nil
+}
+
+func asciiUpper(value string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(value) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			r = ' '
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+
+	return b.String()
+}
+
+func onlyDigits(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r < '0' || r > '9' {
+			return -1
+		}
+		return r
+	}, value)
+}
@@ -0,0 +1,217 @@
+package cnab
+
+import (
+	"bytes"
+	"fmt"
+)
+
+const lineBreak = "\r\n"
+
+// WriteRemittance renders a remittance file registering the titles with the
+// bank, or writing them off. CNAB 240 follows the FEBRABAN segments P and Q; CNAB 400 follows the
+// common layout shared by most banks, with the "nosso número" at 63-82.
+func WriteRemittance(remittance Remittance) ([]byte, error) {
+	switch remittance.Format {
+	case Format240:
+		return write240(remittance), nil
+	case Format400:
+		return write400(remittance), nil
+	default:
+		return nil, fmt.Errorf("unsupported CNAB format: %d", remittance.Format)
+	}
+}
+
+func write400(remittance Remittance) []byte {
+	company := remittance.Company
+	var buf bytes.Buffer
+	sequence := int64(1)
+
+	header := newRecord(400)
+	header.alpha(1, 1, "0")
+	header.alpha(2, 2, "1")
+	header.alpha(3, 9, "REMESSA")
+	header.alpha(10, 11, "01")
+	header.alpha(12, 26, "COBRANCA")
+	header.digits(27, 30, company.Agency)
+	header.alpha(31, 32, "00")
+	header.digits(33, 38, company.Account)
+	header.alpha(47, 76, company.Name)
+	header.digits(77, 79, company.BankCode)
+	header.alpha(80, 94, company.BankName)
+	header.date6(95, remittance.CreatedAt)
+	header.num(101, 107, remittance.Sequence)
+	header.num(395, 400, sequence)
+	writeLine(&buf, header)
+
+	for _, title := range remittance.Titles {
+		sequence++
+
+		detail := newRecord(400)
+		detail.alpha(1, 1, "1")
+		detail.alpha(2, 3, "02")
+		detail.digits(4, 17, company.Document)
+		detail.digits(18, 21, company.Agency)
+		detail.alpha(22, 23, "00")
+		detail.digits(24, 29, company.Account)
+		detail.alpha(34, 37, "0000")
+		detail.alpha(38, 62, title.Reference)
+		detail.alpha(63, 82, title.OurNumber)
+		detail.digits(106, 108, company.Wallet)
+		detail.alpha(109, 110, title.instruction())
+		detail.digits(111, 120, title.OurNumber)
+		detail.date6(121, title.DueDate)
+		detail.num(127, 139, title.Value.Cents())
+		detail.digits(140, 142, company.BankCode)
+		detail.num(143, 147, 0)
+		detail.alpha(148, 149, "04")
+		detail.alpha(150, 150, "N")
+		detail.date6(151, title.IssuedAt)
+		detail.num(157, 160, 0)
+		detail.num(161, 173, 0)
+		detail.num(174, 179, 0)
+		detail.num(180, 218, 0)
+		detail.num(219, 220, payerDocumentType(title.PayerDocument))
+		detail.digits(221, 234, title.PayerDocument)
+		detail.alpha(235, 264, title.PayerName)
+		detail.num(327, 334, 0)
+		detail.num(386, 393, 0)
+		detail.num(395, 400, sequence)
+		writeLine(&buf, detail)
+	}
+
+	trailer := newRecord(400)
+	trailer.alpha(1, 1, "9")
+	trailer.num(395, 400, sequence+1)
+	writeLine(&buf, trailer)
+
+	return buf.Bytes()
+}
+
+func write240(remittance Remittance) []byte {
+	company := remittance.Company
+	var buf bytes.Buffer
+
+	header := newRecord(240)
+	header.digits(1, 3, company.BankCode)
+	header.num(4, 7, 0)
+	header.alpha(8, 8, "0")
+	header.alpha(18, 18, "2")
+	header.digits(19, 32, company.Document)
+	header.alpha(33, 52, company.Agreement)
+	header.digits(53, 57, company.Agency)
+	header.digits(59, 70, company.Account)
+	header.alpha(73, 102, company.Name)
+	header.alpha(103, 132, company.BankName)
+	header.alpha(143, 143, "1")
+	header.date8(144, remittance.CreatedAt)
+	copy(header[151:157], remittance.CreatedAt.Format("150405"))
+	header.num(158, 163, remittance.Sequence)
+	header.alpha(164, 166, "107")
+	header.num(167, 171, 0)
+	writeLine(&buf, header)
+
+	lotHeader := newRecord(240)
+	lotHeader.digits(1, 3, company.BankCode)
+	lotHeader.num(4, 7, 1)
+	lotHeader.alpha(8, 8, "1")
+	lotHeader.alpha(9, 9, "R")
+	lotHeader.alpha(10, 11, "01")
+	lotHeader.alpha(14, 16, "045")
+	lotHeader.alpha(18, 18, "2")
+	lotHeader.digits(19, 33, company.Document)
+	lotHeader.alpha(34, 53, company.Agreement)
+	lotHeader.digits(54, 58, company.Agency)
+	lotHeader.digits(60, 71, company.Account)
+	lotHeader.alpha(74, 103, company.Name)
+	lotHeader.num(184, 191, remittance.Sequence)
+	lotHeader.date8(192, remittance.CreatedAt)
+	lotHeader.num(200, 207, 0)
+	writeLine(&buf, lotHeader)
+
+	lotRecords := int64(0)
+	for _, title := range remittance.Titles {
+		lotRecords++
+		segmentP := newRecord(240)
+		segmentP.digits(1, 3, company.BankCode)
+		segmentP.num(4, 7, 1)
+		segmentP.alpha(8, 8, "3")
+		segmentP.num(9, 13, lotRecords)
+		segmentP.alpha(14, 14, "P")
+		segmentP.alpha(16, 17, title.instruction())
+		segmentP.digits(18, 22, company.Agency)
+		segmentP.digits(24, 35, company.Account)
+		segmentP.alpha(38, 57, title.OurNumber)
+		segmentP.alpha(58, 58, "1")
+		segmentP.alpha(59, 59, "1")
+		segmentP.alpha(60, 60, "2")
+		segmentP.alpha(61, 62, "22")
+		segmentP.alpha(63, 77, title.OurNumber)
+		segmentP.date8(78, title.DueDate)
+		segmentP.num(86, 100, title.Value.Cents())
+		segmentP.num(101, 106, 0)
+		segmentP.alpha(107, 108, "04")
+		segmentP.alpha(109, 109, "N")
+		segmentP.date8(110, title.IssuedAt)
+		segmentP.alpha(118, 118, "3")
+		segmentP.num(119, 141, 0)
+		segmentP.alpha(142, 142, "0")
+		segmentP.num(143, 195, 0)
+		segmentP.alpha(196, 220, title.Reference)
+		segmentP.alpha(221, 221, "3")
+		segmentP.num(222, 223, 0)
+		segmentP.alpha(224, 224, "0")
+		segmentP.num(225, 227, 0)
+		segmentP.alpha(228, 229, "09")
+		segmentP.num(230, 239, 0)
+		writeLine(&buf, segmentP)
+
+		lotRecords++
+		segmentQ := newRecord(240)
+		segmentQ.digits(1, 3, company.BankCode)
+		segmentQ.num(4, 7, 1)
+		segmentQ.alpha(8, 8, "3")
+		segmentQ.num(9, 13, lotRecords)
+		segmentQ.alpha(14, 14, "Q")
+		segmentQ.alpha(16, 17, title.instruction())
+		segmentQ.num(18, 18, payerDocumentType(title.PayerDocument))
+		segmentQ.digits(19, 33, title.PayerDocument)
+		segmentQ.alpha(34, 73, title.PayerName)
+		segmentQ.num(129, 136, 0)
+		segmentQ.alpha(154, 154, "0")
+		segmentQ.num(155, 169, 0)
+		segmentQ.num(210, 212, 0)
+		writeLine(&buf, segmentQ)
+	}
+
+	lotTrailer := newRecord(240)
+	lotTrailer.digits(1, 3, company.BankCode)
+	lotTrailer.num(4, 7, 1)
+	lotTrailer.alpha(8, 8, "5")
+	lotTrailer.num(18, 23, lotRecords+2)
+	writeLine(&buf, lotTrailer)
+
+	trailer := newRecord(240)
+	trailer.digits(1, 3, company.BankCode)
+	trailer.num(4, 7, 9999)
+	trailer.alpha(8, 8, "9")
+	trailer.num(18, 23, 1)
+	trailer.num(24, 29, lotRecords+4)
+	writeLine(&buf, trailer)
+
+	return buf.Bytes()
+}
+
+// payerDocumentType is the payer registration type code shared by both
+// layouts: 1 for a CPF and 2 for a CNPJ.
+func payerDocumentType(document string) int64 {
+	if len(onlyDigits(document)) > 11 {
+		return 2
+	}
+
+	return 1
+}
+
+func writeLine(buf *bytes.Buffer, line record) {
+	buf.Write(line)
+	buf.WriteString(lineBreak)
+}
@@ -0,0 +1,146 @@
+package cnab
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrEmptyReturnFile = errors.New("empty CNAB return file")
+
+// ParseReturn reads a CNAB 240 or 400 return file, detecting the layout from
+// the line length. Lines that cannot be read are reported in Errors and do
+// not stop the remaining lines from being parsed.
+func ParseReturn(data []byte) (*ReturnFile, error) {
+	lines := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimRight(scanner.Text(), "\r"))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(lines) == 0 || lines[0] == "" {
+		return nil, ErrEmptyReturnFile
+	}
+
+	file := &ReturnFile{Format: Format(len(lines[0]))}
+	if !file.Format.IsValid() {
+		return nil, fmt.Errorf("unsupported CNAB line length: %d", len(lines[0]))
+	}
+
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		if len(line) != int(file.Format) {
+			file.addError(i+1, fmt.Sprintf("expected %d characters, got %d", file.Format, len(line)))
+			continue
+		}
+
+		if file.Format == Format240 {
+			file.parse240(i+1, line)
+		} else {
+			file.parse400(i+1, line)
+		}
+	}
+
+	return file, nil
+}
+
+func (f *ReturnFile) addError(line int, message string) {
+	f.Errors = append(f.Errors, LineError{Line: line, Message: message})
+}
+
+func (f *ReturnFile) parse400(number int, line string) {
+	switch line[0] {
+	case '0':
+		f.BankCode = field(line, 77, 79)
+	case '1':
+		record := ReturnRecord{
+			Line:       number,
+			Reference:  strings.TrimSpace(field(line, 38, 62)),
+			OurNumber:  strings.TrimSpace(field(line, 63, 82)),
+			Occurrence: field(line, 109, 110),
+		}
+
+		var err error
+		if record.OccurredAt, err = dateField(line, 111, 116); err != nil {
+			f.addError(number, err.Error())
+			return
+		}
+		if record.Value, err = moneyField(line, 153, 165); err != nil {
+			f.addError(number, err.Error())
+			return
+		}
+		if record.PaidValue, err = moneyField(line, 254, 266); err != nil {
+			f.addError(number, err.Error())
+			return
+		}
+		if record.Charges, err = moneyField(line, 267, 279); err != nil {
+			f.addError(number, err.Error())
+			return
+		}
+
+		f.Records = append(f.Records, record)
+	case '9':
+	default:
+		f.addError(number, fmt.Sprintf("unknown record type %q", line[0]))
+	}
+}
+
+// parse240 reads segment T, which identifies the title, followed by segment
+// U, which carries the amounts and dates of the same movement.
+func (f *ReturnFile) parse240(number int, line string) {
+	switch line[7] {
+	case '0':
+		f.BankCode = field(line, 1, 3)
+	case '3':
+		switch line[13] {
+		case 'T':
+			record := ReturnRecord{
+				Line:       number,
+				Occurrence: field(line, 16, 17),
+				OurNumber:  strings.TrimSpace(field(line, 38, 57)),
+				Reference:  strings.TrimSpace(field(line, 106, 130)),
+			}
+
+			value, err := moneyField(line, 82, 96)
+			if err != nil {
+				f.addError(number, err.Error())
+				return
+			}
+
+			record.Value = value
+			f.Records = append(f.Records, record)
+		case 'U':
+			if len(f.Records) == 0 || f.Records[len(f.Records)-1].Line != number-1 {
+				f.addError(number, "segment U without preceding segment T")
+				return
+			}
+
+			record := &f.Records[len(f.Records)-1]
+			var err error
+			if record.Charges, err = moneyField(line, 18, 32); err == nil {
+				if record.PaidValue, err = moneyField(line, 78, 92); err == nil {
+					record.OccurredAt, err = dateField(line, 138, 145)
+				}
+			}
+
+			if err != nil {
+				f.Records = f.Records[:len(f.Records)-1]
+				f.addError(number, err.Error())
+			}
+		default:
+			f.addError(number, fmt.Sprintf("unsupported segment %q", line[13]))
+		}
+	case '1', '5', '9':
+	default:
+		f.addError(number, fmt.Sprintf("unknown record type %q", line[7]))
+	}
+}
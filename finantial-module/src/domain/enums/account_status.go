@@ -5,4 +5,7 @@ type AccountStatus string
 const (
 	ADIMPLENTE   AccountStatus = "ADIMPLENTE"
 	INADIMPLENTE AccountStatus = "INADIMPLENTE"
+	// CANCELADO accounts belong to cancelled enrollments and are kept only
+	// as financial history.
+	CANCELADO AccountStatus = "CANCELADO"
 )
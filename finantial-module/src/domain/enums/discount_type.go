@@ -0,0 +1,21 @@
+package enums
+
+import "slices"
+
+type DiscountType string
+
+const (
+	// PERCENTAGE discounts a percentage of the installment value.
+	PERCENTAGE DiscountType = "PERCENTAGE"
+	// FIXED discounts the same amount from every installment.
+	FIXED DiscountType = "FIXED"
+)
+
+var discountTypeValues = []DiscountType{
+	PERCENTAGE,
+	FIXED,
+}
+
+func (obj DiscountType) IsValid() bool {
+	return slices.Contains(discountTypeValues, obj)
+}
@@ -0,0 +1,17 @@
+package enums
+
+type DiscrepancyType string
+
+const (
+	// MISSING_ACCOUNT is an active enrollment without account.
+	MISSING_ACCOUNT DiscrepancyType = "MISSING_ACCOUNT"
+	// ORPHAN_ACCOUNT is an account whose enrollment was deleted or cancelled.
+	ORPHAN_ACCOUNT DiscrepancyType = "ORPHAN_ACCOUNT"
+	// DUPLICATE_ACCOUNT is an extra account of the same enrollment.
+	DUPLICATE_ACCOUNT DiscrepancyType = "DUPLICATE_ACCOUNT"
+	// STATUS_MISMATCH is an enrollment whose status differs from its account.
+	STATUS_MISMATCH DiscrepancyType = "STATUS_MISMATCH"
+	// UNCONFIRMED_ENROLLMENT is an enrollment still pending of an account
+	// that was already opened.
+	UNCONFIRMED_ENROLLMENT DiscrepancyType = "UNCONFIRMED_ENROLLMENT"
+)
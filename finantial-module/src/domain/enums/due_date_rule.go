@@ -0,0 +1,22 @@
+package enums
+
+import "slices"
+
+type DueDateRule string
+
+const (
+	// FIXED_DAY sets the installments on a fixed day of the following months.
+	FIXED_DAY DueDateRule = "FIXED_DAY"
+	// DAYS_AFTER_ENROLLMENT sets the first installment a number of days after
+	// the enrollment and the next ones on the same day of the following months.
+	DAYS_AFTER_ENROLLMENT DueDateRule = "DAYS_AFTER_ENROLLMENT"
+)
+
+var dueDateRuleValues = []DueDateRule{
+	FIXED_DAY,
+	DAYS_AFTER_ENROLLMENT,
+}
+
+func (obj DueDateRule) IsValid() bool {
+	return slices.Contains(dueDateRuleValues, obj)
+}
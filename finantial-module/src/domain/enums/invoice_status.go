@@ -0,0 +1,18 @@
+package enums
+
+type InvoiceStatus string
+
+const (
+	INVOICE_OPEN      InvoiceStatus = "OPEN"
+	INVOICE_OVERDUE   InvoiceStatus = "OVERDUE"
+	INVOICE_PAID      InvoiceStatus = "PAID"
+	INVOICE_CANCELLED InvoiceStatus = "CANCELLED"
+)
+
+// InvoiceStatusValues lists the statuses in the order they are reported.
+var InvoiceStatusValues = []InvoiceStatus{
+	INVOICE_OPEN,
+	INVOICE_OVERDUE,
+	INVOICE_PAID,
+	INVOICE_CANCELLED,
+}
@@ -0,0 +1,9 @@
+package enums
+
+type JobRunStatus string
+
+const (
+	RUNNING   JobRunStatus = "RUNNING"
+	SUCCEEDED JobRunStatus = "SUCCEEDED"
+	FAILED    JobRunStatus = "FAILED"
+)
@@ -0,0 +1,8 @@
+package enums
+
+type JobTrigger string
+
+const (
+	SCHEDULED JobTrigger = "SCHEDULED"
+	MANUAL    JobTrigger = "MANUAL"
+)
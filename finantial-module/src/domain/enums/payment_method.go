@@ -0,0 +1,27 @@
+package enums
+
+import "slices"
+
+type PaymentMethod string
+
+const (
+	PIX            PaymentMethod = "PIX"
+	BOLETO         PaymentMethod = "BOLETO"
+	CARTAO_CREDITO PaymentMethod = "CARTAO_CREDITO"
+	CARTAO_DEBITO  PaymentMethod = "CARTAO_DEBITO"
+	TRANSFERENCIA  PaymentMethod = "TRANSFERENCIA"
+	DINHEIRO       PaymentMethod = "DINHEIRO"
+)
+
+var paymentMethodValues = []PaymentMethod{
+	PIX,
+	BOLETO,
+	CARTAO_CREDITO,
+	CARTAO_DEBITO,
+	TRANSFERENCIA,
+	DINHEIRO,
+}
+
+func (obj PaymentMethod) IsValid() bool {
+	return slices.Contains(paymentMethodValues, obj)
+}
@@ -0,0 +1,11 @@
+package enums
+
+type ReconciliationAction string
+
+const (
+	NO_ACTION                   ReconciliationAction = "NO_ACTION"
+	CREATE_ACCOUNT              ReconciliationAction = "CREATE_ACCOUNT"
+	CANCEL_ACCOUNT              ReconciliationAction = "CANCEL_ACCOUNT"
+	EMIT_ACCOUNT_CREATED        ReconciliationAction = "EMIT_ACCOUNT_CREATED"
+	EMIT_ACCOUNT_STATUS_UPDATED ReconciliationAction = "EMIT_ACCOUNT_STATUS_UPDATED"
+)
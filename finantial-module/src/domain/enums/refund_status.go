@@ -0,0 +1,21 @@
+package enums
+
+import "slices"
+
+// RefundStatus tells whether the refund of a cancelled account is still owed
+// to the student.
+type RefundStatus string
+
+const (
+	REFUND_PENDING RefundStatus = "PENDING"
+	REFUND_PAID    RefundStatus = "PAID"
+)
+
+var refundStatusValues = []RefundStatus{
+	REFUND_PENDING,
+	REFUND_PAID,
+}
+
+func (obj RefundStatus) IsValid() bool {
+	return slices.Contains(refundStatusValues, obj)
+}
@@ -0,0 +1,16 @@
+package enums
+
+// StatementEntryType is the kind of movement of a student statement. Charges,
+// late fees and late interest raise the balance; discounts, payments,
+// cancellations and refunds lower it.
+type StatementEntryType string
+
+const (
+	STATEMENT_CHARGE        StatementEntryType = "CHARGE"
+	STATEMENT_DISCOUNT      StatementEntryType = "DISCOUNT"
+	STATEMENT_LATE_FEE      StatementEntryType = "LATE_FEE"
+	STATEMENT_LATE_INTEREST StatementEntryType = "LATE_INTEREST"
+	STATEMENT_PAYMENT       StatementEntryType = "PAYMENT"
+	STATEMENT_CANCELLATION  StatementEntryType = "CANCELLATION"
+	STATEMENT_REFUND        StatementEntryType = "REFUND"
+)
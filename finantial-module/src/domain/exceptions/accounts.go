@@ -0,0 +1,8 @@
+package exceptions
+
+const (
+	// Business exceptions
+	ErrAccountAlreadyExists        string = "errAccountAlreadyExists"
+	ErrAccountNotFound             string = "errAccountNotFound"
+	ErrAccountInstallmentsExceeded string = "errAccountInstallmentsExceeded"
+)
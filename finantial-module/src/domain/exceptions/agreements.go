@@ -0,0 +1,7 @@
+package exceptions
+
+const (
+	// Business exceptions
+	ErrAgreementInvoiceNotOverdue  string = "errAgreementInvoiceNotOverdue"
+	ErrAgreementDownPaymentTooHigh string = "errAgreementDownPaymentTooHigh"
+)
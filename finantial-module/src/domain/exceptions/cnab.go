@@ -0,0 +1,12 @@
+package exceptions
+
+const (
+	// Business exceptions
+	ErrCnabNothingToRemit       string = "errCnabNothingToRemit"
+	ErrCnabRemittanceNotFound   string = "errCnabRemittanceNotFound"
+	ErrCnabInvalidReturnFile    string = "errCnabInvalidReturnFile"
+	ErrCnabReturnInvoiceMissing string = "errCnabReturnInvoiceMissing"
+	ErrCnabEntryRejected        string = "errCnabEntryRejected"
+	ErrCnabPayerMissing         string = "errCnabPayerMissing"
+	ErrCnabReturnFileTooLarge   string = "errCnabReturnFileTooLarge"
+)
@@ -0,0 +1,9 @@
+package exceptions
+
+const (
+	// Business exceptions
+	ErrDeadLetterNotFound        string = "errDeadLetterNotFound"
+	ErrDeadLetterAlreadyResolved string = "errDeadLetterAlreadyResolved"
+	ErrDeadLetterQueueNotFound   string = "errDeadLetterQueueNotFound"
+	ErrDeadLetterReplayFailed    string = "errDeadLetterReplayFailed"
+)
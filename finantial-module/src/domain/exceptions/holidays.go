@@ -0,0 +1,7 @@
+package exceptions
+
+const (
+	// Business exceptions
+	ErrHolidayAlreadyExists string = "errHolidayAlreadyExists"
+	ErrHolidayNotFound      string = "errHolidayNotFound"
+)
@@ -0,0 +1,9 @@
+package exceptions
+
+const (
+	// Business exceptions
+	ErrInvoiceNotFound     string = "errInvoiceNotFound"
+	ErrInvoiceAlreadyPaid  string = "errInvoiceAlreadyPaid"
+	ErrInvoiceCancelled    string = "errInvoiceCancelled"
+	ErrBoletoNotConfigured string = "errBoletoNotConfigured"
+)
@@ -0,0 +1,7 @@
+package exceptions
+
+const (
+	// Business exceptions
+	ErrJobNotFound       string = "errJobNotFound"
+	ErrJobAlreadyRunning string = "errJobAlreadyRunning"
+)
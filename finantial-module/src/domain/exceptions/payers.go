@@ -0,0 +1,6 @@
+package exceptions
+
+const (
+	// Business exceptions
+	ErrPayerNotFound string = "errPayerNotFound"
+)
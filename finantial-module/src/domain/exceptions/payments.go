@@ -0,0 +1,7 @@
+package exceptions
+
+const (
+	// Business exceptions
+	ErrPaymentInvalid          string = "errPaymentInvalid"
+	ErrPaymentExceedsOpenValue string = "errPaymentExceedsOpenValue"
+)
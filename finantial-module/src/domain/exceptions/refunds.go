@@ -0,0 +1,7 @@
+package exceptions
+
+const (
+	// Business exceptions
+	ErrRefundNotFound    string = "errRefundNotFound"
+	ErrRefundAlreadyPaid string = "errRefundAlreadyPaid"
+)
@@ -0,0 +1,7 @@
+package exceptions
+
+const (
+	// Business exceptions
+	ErrStatementStudentNotFound string = "errStatementStudentNotFound"
+	ErrStatementInvalidPeriod   string = "errStatementInvalidPeriod"
+)
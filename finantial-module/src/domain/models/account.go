@@ -1,18 +1,23 @@
 package models
 
 import (
+	"math"
 	"time"
 
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
 	"github.com/google/uuid"
 )
 
+// MaxAccountInstallments leaves a number after the last installment for the
+// final charge of a cancellation.
+const MaxAccountInstallments uint8 = math.MaxUint8 - 1
+
 type Account struct {
 	ID           uuid.UUID           `json:"id"`
 	StudentID    uuid.UUID           `json:"studentId"`
 	CourseID     uuid.UUID           `json:"courseId"`
 	Installments uint8               `json:"installments"`
-	Value        float64             `json:"value"`
+	Value        Money               `json:"value"`
 	Status       enums.AccountStatus `json:"status"`
 	CreatedAt    time.Time           `json:"createdAt"`
 }
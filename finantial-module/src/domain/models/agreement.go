@@ -0,0 +1,122 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxAgreementInstallments caps the installments of a renegotiation plan.
+const maxAgreementInstallments = 60
+
+// Agreement renegotiates overdue invoices of an account into a new plan. The
+// renegotiated invoices are cancelled and their balance on the agreement
+// date, late charges included, is split into a down payment, when given, and
+// the installments.
+type Agreement struct {
+	ID           uuid.UUID `json:"id"`
+	AccountID    uuid.UUID `json:"accountId"`
+	Principal    Money     `json:"principal"`
+	LateCharges  Money     `json:"lateCharges"`
+	Value        Money     `json:"value"`
+	DownPayment  Money     `json:"downPayment"`
+	Installments uint8     `json:"installments"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type AgreementRequest struct {
+	InvoiceIDs   []uuid.UUID `json:"invoiceIds"`
+	Installments uint8       `json:"installments"`
+	DownPayment  Money       `json:"downPayment"`
+}
+
+// AgreementDetail is the agreement with the invoices it cancelled and the
+// ones it issued.
+type AgreementDetail struct {
+	Agreement
+	Renegotiated []Invoice `json:"renegotiated"`
+	Invoices     []Invoice `json:"invoices"`
+}
+
+func (r *AgreementRequest) Prepare() error {
+	if len(r.InvoiceIDs) == 0 {
+		return fmt.Errorf("campo %s é requerido", "Parcelas renegociadas")
+	}
+
+	if r.Installments == 0 {
+		return fmt.Errorf("campo %s é requerido", "Parcelas")
+	}
+
+	if r.Installments > maxAgreementInstallments {
+		return fmt.Errorf("campo Parcelas deve ser no máximo %d", maxAgreementInstallments)
+	}
+
+	if r.DownPayment < 0 {
+		return fmt.Errorf("campo %s é inválido", "Entrada")
+	}
+
+	slices.SortFunc(r.InvoiceIDs, func(a, b uuid.UUID) int {
+		return slices.Compare(a[:], b[:])
+	})
+	r.InvoiceIDs = slices.Compact(r.InvoiceIDs)
+	return nil
+}
+
+// NewAgreement totals the balance of the invoices on the given date.
+func NewAgreement(account *Account, invoices []Invoice, request *AgreementRequest, policy LateChargePolicy, date time.Time) *Agreement {
+	agreement := &Agreement{
+		ID:           uuid.New(),
+		AccountID:    account.ID,
+		DownPayment:  request.DownPayment,
+		Installments: request.Installments,
+		CreatedAt:    date,
+	}
+
+	for i := range invoices {
+		charge := policy.Calculate(&invoices[i], date)
+		agreement.Principal += invoices[i].OpenPrincipal()
+		agreement.LateCharges += invoices[i].OpenCharges(charge)
+		agreement.Value += invoices[i].AmountDue(charge)
+	}
+
+	return agreement
+}
+
+// IsDownPaymentTooHigh tells whether the down payment leaves nothing to be
+// split into the installments.
+func (a *Agreement) IsDownPaymentTooHigh() bool {
+	return a.DownPayment >= a.Value
+}
+
+// Plan issues the invoices of the agreement: the down payment, due on the
+// given day, followed by the installments on the given due dates. They are
+// numbered within the agreement, apart from the installments of the account:
+// the down payment is installment 0 and the installments go from 1 to
+// Installments.
+func (a *Agreement) Plan(account *Account, downPaymentDate time.Time, dueDates []time.Time) []Invoice {
+	invoices := []Invoice{}
+	add := func(installment uint8, dueDate time.Time, value Money) {
+		invoices = append(invoices, Invoice{
+			ID:          uuid.New(),
+			Account:     *account,
+			Installment: installment,
+			DueDate:     dueDate,
+			Value:       value,
+			GrossValue:  value,
+			CreatedAt:   a.CreatedAt,
+			AgreementID: uuid.NullUUID{UUID: a.ID, Valid: true},
+		})
+	}
+
+	if a.DownPayment > 0 {
+		add(0, downPaymentDate, a.DownPayment)
+	}
+
+	for i, value := range (a.Value - a.DownPayment).Split(int(a.Installments)) {
+		add(uint8(i+1), dueDates[i], value)
+	}
+
+	return invoices
+}
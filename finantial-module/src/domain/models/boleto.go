@@ -0,0 +1,13 @@
+package models
+
+// Boleto holds the FEBRABAN payment slip data generated for an invoice.
+type Boleto struct {
+	BankCode      string `json:"bankCode"`
+	OurNumber     string `json:"ourNumber"`
+	Barcode       string `json:"barcode"`
+	DigitableLine string `json:"digitableLine"`
+}
+
+func (b Boleto) IsEmpty() bool {
+	return b.Barcode == ""
+}
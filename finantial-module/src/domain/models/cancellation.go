@@ -0,0 +1,138 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/google/uuid"
+)
+
+// Reasons of the cancellations not requested by the enrollment saga, which
+// sends its own.
+const (
+	CancellationEnrollmentDeleted = "ENROLLMENT_DELETED"
+	CancellationCourseDeleted     = "COURSE_DELETED"
+	CancellationStudentDeleted    = "STUDENT_DELETED"
+	CancellationReconciliation    = "RECONCILIATION"
+)
+
+// Cancellation closes the account of a cancelled enrollment. Each installment
+// pays for the period that ends on its due date, starting on the previous due
+// date or, for the first one, on the account opening. Periods already ended
+// stay owed as they are; the period in progress is charged pro rata for the
+// days consumed, and the periods ahead are not charged. What was paid in
+// advance is set against the prorated value, leaving a final charge or a
+// refund. The final charge is issued as an adjustment numbered after the last
+// installment of the account, and the refund is recorded as owed to the
+// student. Invoices issued by renegotiation agreements stay owed.
+type Cancellation struct {
+	ID             uuid.UUID     `json:"id"`
+	AccountID      uuid.UUID     `json:"accountId"`
+	Reason         string        `json:"reason"`
+	ProratedValue  Money         `json:"proratedValue"`
+	FinalCharge    Money         `json:"finalCharge"`
+	Refund         Money         `json:"refund"`
+	FinalInvoiceID uuid.NullUUID `json:"finalInvoiceId"`
+	CreatedAt      time.Time     `json:"createdAt"`
+}
+
+// CancellationPlan is a cancellation with the open invoices it cancels and
+// either the invoice of its final charge or its refund, if any.
+type CancellationPlan struct {
+	Cancellation Cancellation
+	Cancelled    []uuid.UUID
+	FinalInvoice *Invoice
+	Refund       *Refund
+}
+
+// NewCancellation plans the cancellation of the account on the given day,
+// with the account opened on openedOn. Days are calendar dates as returned by
+// calendar.Date. The final charge is numbered after the last installment,
+// which fails for accounts already at the last installment number.
+func NewCancellation(account *Account, invoices []Invoice, reason string, openedOn, day, at time.Time) (*CancellationPlan, error) {
+	plan := &CancellationPlan{
+		Cancellation: Cancellation{
+			ID:        uuid.New(),
+			AccountID: account.ID,
+			Reason:    reason,
+			CreatedAt: at,
+		},
+		Cancelled: []uuid.UUID{},
+	}
+
+	installments := []Invoice{}
+	for _, invoice := range invoices {
+		if !invoice.AgreementID.Valid {
+			installments = append(installments, invoice)
+		}
+	}
+	slices.SortFunc(installments, func(a, b Invoice) int {
+		return int(a.Installment) - int(b.Installment)
+	})
+
+	var current *Invoice
+	var charge, credit Money
+	start := openedOn
+	for i := range installments {
+		invoice := &installments[i]
+		dueDate := calendar.Date(invoice.DueDate)
+		periodStart := start
+		start = dueDate
+
+		if invoice.IsCancelled() || !dueDate.After(day) {
+			continue
+		}
+
+		paid := invoice.PaidValue - invoice.PaidCharges
+		if invoice.IsOpen() {
+			plan.Cancelled = append(plan.Cancelled, invoice.ID)
+		}
+
+		if !periodStart.Before(day) {
+			credit += paid
+			continue
+		}
+
+		current = invoice
+		plan.Cancellation.ProratedValue = invoice.Value.MulRatio(
+			int64(daysBetween(periodStart, day)), int64(daysBetween(periodStart, dueDate)))
+		charge += plan.Cancellation.ProratedValue
+		credit += paid
+	}
+
+	switch {
+	case charge > credit:
+		last := installments[len(installments)-1].Installment
+		if last == math.MaxUint8 {
+			return nil, fmt.Errorf("no installment number left for the final charge of account %s", account.ID)
+		}
+
+		plan.Cancellation.FinalCharge = charge - credit
+		plan.FinalInvoice = &Invoice{
+			ID:          uuid.New(),
+			Account:     *account,
+			Installment: last + 1,
+			DueDate:     current.DueDate,
+			Value:       plan.Cancellation.FinalCharge,
+			GrossValue:  plan.Cancellation.FinalCharge,
+			CreatedAt:   at,
+		}
+		plan.Cancellation.FinalInvoiceID = uuid.NullUUID{UUID: plan.FinalInvoice.ID, Valid: true}
+	case credit > charge:
+		plan.Cancellation.Refund = credit - charge
+		plan.Refund = &Refund{
+			ID:             uuid.New(),
+			AccountID:      account.ID,
+			CancellationID: plan.Cancellation.ID,
+			Value:          plan.Cancellation.Refund,
+			Status:         enums.REFUND_PENDING,
+			CreatedAt:      at,
+		}
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CnabFile is a remittance file written to the CNAB files directory.
+// Skipped counts the pending invoices left out for lack of payer data.
+type CnabFile struct {
+	Name      string `json:"name"`
+	Path      string `json:"-"`
+	Records   int    `json:"records"`
+	Skipped   int    `json:"skipped"`
+	WriteOffs int    `json:"writeOffs"`
+}
+
+// CnabImportReport summarizes the import of a CNAB return file. Duplicated
+// counts the settlements skipped because an earlier import applied them.
+type CnabImportReport struct {
+	Format       int             `json:"format"`
+	TotalRecords int             `json:"totalRecords"`
+	Settled      int             `json:"settled"`
+	Duplicated   int             `json:"duplicated"`
+	Ignored      int             `json:"ignored"`
+	Errors       []CnabLineError `json:"errors"`
+}
+
+// CnabSettlement is a settlement of a return file applied to an invoice. A
+// boleto is settled once per occurrence date and amount, so the same line
+// imported again is recognized by them.
+type CnabSettlement struct {
+	BankCode   string
+	OurNumber  string
+	OccurredAt time.Time
+	PaidValue  Money
+	InvoiceID  uuid.UUID
+}
+
+type CnabLineError struct {
+	Line      int    `json:"line"`
+	OurNumber string `json:"ourNumber,omitempty"`
+	Message   string `json:"message"`
+}
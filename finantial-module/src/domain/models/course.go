@@ -1,10 +1,15 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
+// Course is the local snapshot of a school course, kept from its events.
 type Course struct {
-	ID    uuid.UUID `json:"id"`
-	Value float64   `json:"value"`
+	ID        uuid.UUID `json:"id" validate:"required"`
+	Name      string    `json:"name"`
+	Value     Money     `json:"value"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
@@ -0,0 +1,190 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+)
+
+// Discount is a scholarship or discount granted to a student, on a single
+// course or, without one, on all of them. PERCENTAGE discounts take
+// Percentage from each installment (50.00 for a half scholarship) and FIXED
+// ones take Value. Discounts apply to the installments due from ValidFrom to
+// ValidUntil, both included.
+type Discount struct {
+	ID         uuid.UUID          `json:"id"`
+	StudentID  uuid.UUID          `json:"studentId"`
+	CourseID   uuid.NullUUID      `json:"courseId"`
+	Name       string             `json:"name"`
+	Type       enums.DiscountType `json:"type"`
+	Value      Money              `json:"value"`
+	Percentage Percentage         `json:"percentage"`
+	Stackable  bool               `json:"stackable"`
+	ValidFrom  time.Time          `json:"validFrom"`
+	ValidUntil types.NullDateTime `json:"validUntil"`
+	CreatedAt  time.Time          `json:"createdAt"`
+}
+
+// InvoiceDiscount is a discount as it was applied to an invoice, kept apart
+// from the discount so the breakdown survives later changes to it.
+type InvoiceDiscount struct {
+	InvoiceID  uuid.UUID          `json:"-"`
+	Position   uint8              `json:"-"`
+	DiscountID uuid.NullUUID      `json:"discountId"`
+	Name       string             `json:"name"`
+	Type       enums.DiscountType `json:"type"`
+	Value      Money              `json:"value"`
+	Percentage Percentage         `json:"percentage"`
+	Amount     Money              `json:"amount"`
+}
+
+func (d *Discount) Prepare() error {
+	if err := d.validate(); err != nil {
+		return err
+	}
+
+	d.format()
+	return nil
+}
+
+func (d *Discount) validate() error {
+	if d.StudentID == uuid.Nil {
+		return fmt.Errorf("campo %s é requerido", "Estudante")
+	}
+
+	if d.Name == "" {
+		return fmt.Errorf("campo %s é requerido", "Nome")
+	}
+
+	if len(d.Name) > 100 {
+		return errors.New("campo Nome deve ter no máximo 100 caracteres")
+	}
+
+	if !d.Type.IsValid() {
+		return fmt.Errorf("campo %s é inválido", "Tipo")
+	}
+
+	if d.Type == enums.PERCENTAGE {
+		if d.Percentage <= 0 {
+			return fmt.Errorf("campo %s é requerido", "Percentual")
+		}
+
+		if d.Percentage > 100_00 {
+			return errors.New("campo Percentual deve ser no máximo 100")
+		}
+	} else if d.Value <= 0 {
+		return fmt.Errorf("campo %s é requerido", "Valor")
+	}
+
+	if d.ValidFrom.IsZero() {
+		return fmt.Errorf("campo %s é requerido", "Início da vigência")
+	}
+
+	if d.ValidUntil.Valid && calendar.Date(d.ValidUntil.Time).Before(calendar.Date(d.ValidFrom)) {
+		return errors.New("campo Fim da vigência deve ser posterior ao início")
+	}
+
+	return nil
+}
+
+func (d *Discount) format() {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+
+	if d.Type == enums.PERCENTAGE {
+		d.Value = 0
+	} else {
+		d.Percentage = 0
+	}
+
+	d.ValidFrom = calendar.Date(d.ValidFrom)
+	if d.ValidUntil.Valid {
+		d.ValidUntil.Time = calendar.Date(d.ValidUntil.Time)
+	}
+
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = time.Now()
+	}
+}
+
+// IsValidOn tells whether the discount applies to an installment due on the
+// given day.
+func (d *Discount) IsValidOn(date time.Time) bool {
+	date = calendar.Date(date)
+	if date.Before(calendar.Date(d.ValidFrom)) {
+		return false
+	}
+
+	return !d.ValidUntil.Valid || !date.After(calendar.Date(d.ValidUntil.Time))
+}
+
+// amount is what the discount takes from the given value.
+func (d *Discount) amount(value Money) Money {
+	if d.Type == enums.PERCENTAGE {
+		return value.Percent(d.Percentage)
+	}
+
+	return min(d.Value, value)
+}
+
+func (d *Discount) line(amount Money) InvoiceDiscount {
+	return InvoiceDiscount{
+		DiscountID: uuid.NullUUID{UUID: d.ID, Valid: true},
+		Name:       d.Name,
+		Type:       d.Type,
+		Value:      d.Value,
+		Percentage: d.Percentage,
+		Amount:     amount,
+	}
+}
+
+// ApplyDiscounts applies to an installment the discounts valid on its due
+// date and returns the breakdown along with the net value, which never goes
+// below zero.
+//
+// Stackable discounts are combined: percentages first, each one over what the
+// previous ones left, then fixed amounts. A non-stackable discount is never
+// combined with another one; the largest of them replaces the stack when it
+// gives the student a bigger discount.
+func ApplyDiscounts(value Money, dueDate time.Time, discounts []Discount) ([]InvoiceDiscount, Money) {
+	var percentages, fixed []*Discount
+	var exclusive *Discount
+	var exclusiveAmount Money
+	for i := range discounts {
+		discount := &discounts[i]
+		if !discount.IsValidOn(dueDate) {
+			continue
+		}
+
+		switch {
+		case !discount.Stackable:
+			if amount := discount.amount(value); exclusive == nil || amount > exclusiveAmount {
+				exclusive, exclusiveAmount = discount, amount
+			}
+		case discount.Type == enums.PERCENTAGE:
+			percentages = append(percentages, discount)
+		default:
+			fixed = append(fixed, discount)
+		}
+	}
+
+	lines := []InvoiceDiscount{}
+	net := value
+	for _, discount := range append(percentages, fixed...) {
+		amount := discount.amount(net)
+		lines = append(lines, discount.line(amount))
+		net -= amount
+	}
+
+	if exclusive != nil && exclusiveAmount > value-net {
+		return []InvoiceDiscount{exclusive.line(exclusiveAmount)}, value - exclusiveAmount
+	}
+
+	return lines, net
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+)
+
+// DueDatePolicy sets the due dates of the installments of an account. The
+// installments are one month apart and each one is moved to the next business
+// day when it falls on a weekend or holiday; the following ones keep the
+// original schedule.
+type DueDatePolicy struct {
+	Rule enums.DueDateRule
+	// Day is the day of the month used by FIXED_DAY, moved back to the last
+	// day of shorter months.
+	Day int
+	// Days is the number of days between the enrollment and the first
+	// installment used by DAYS_AFTER_ENROLLMENT.
+	Days int
+}
+
+// DueDates returns the due dates of the installments of an account opened at
+// the given instant, evaluated in the calendar location.
+func (p DueDatePolicy) DueDates(openedAt time.Time, installments uint8, cal *calendar.Calendar) []time.Time {
+	first, day := p.first(cal.Day(openedAt))
+
+	dates := make([]time.Time, 0, installments)
+	for installment := range int(installments) {
+		dates = append(dates, cal.NextBusinessDay(addMonths(first, installment, day)))
+	}
+
+	return dates
+}
+
+// first returns the date of the first installment and the day of the month
+// of the next ones.
+func (p DueDatePolicy) first(openedOn time.Time) (time.Time, int) {
+	if p.Rule == enums.FIXED_DAY {
+		return addMonths(openedOn, 1, p.Day), p.Day
+	}
+
+	first := openedOn.AddDate(0, 0, p.Days)
+	return first, first.Day()
+}
+
+// addMonths moves the date the given months ahead, on the given day or on the
+// last day of the month when it is shorter.
+func addMonths(date time.Time, months, day int) time.Time {
+	month := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := month.AddDate(0, 1, -1).Day()
+
+	return month.AddDate(0, 0, min(day, lastDay)-1)
+}
@@ -1,9 +1,12 @@
 package models
 
+import "time"
+
 type Enrollment struct {
-	Student      Student `json:"student"`
-	Course       Course  `json:"course"`
-	Installments uint8   `json:"installments"`
+	Student      Student   `json:"student"`
+	Course       Course    `json:"course"`
+	Installments uint8     `json:"installments"`
+	CreatedAt    time.Time `json:"createdAt"`
 }
 
 func (e *Enrollment) ToAccount() *Account {
@@ -12,5 +15,6 @@ func (e *Enrollment) ToAccount() *Account {
 		CourseID:     e.Course.ID,
 		Installments: e.Installments,
 		Value:        e.Course.Value,
+		CreatedAt:    e.CreatedAt,
 	}
 }
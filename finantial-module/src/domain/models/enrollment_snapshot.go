@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EnrollmentPendingFinancial = "PENDING_FINANCIAL"
+	EnrollmentCancelled        = "CANCELADO"
+)
+
+// EnrollmentSnapshot is an enrollment as currently stored by school-module.
+type EnrollmentSnapshot struct {
+	StudentID    uuid.UUID `json:"studentId"`
+	CourseID     uuid.UUID `json:"courseId"`
+	CourseValue  Money     `json:"courseValue"`
+	Installments uint8     `json:"installments"`
+	Status       string    `json:"status"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+func (e *EnrollmentSnapshot) ToAccount() *Account {
+	return &Account{
+		StudentID:    e.StudentID,
+		CourseID:     e.CourseID,
+		Installments: e.Installments,
+		Value:        e.CourseValue,
+		CreatedAt:    e.CreatedAt,
+	}
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+)
+
+// Holiday is a day without banking business. National holidays are computed,
+// the others are registered through the API.
+type Holiday struct {
+	Date     types.IsoDate `json:"date"`
+	Name     string        `json:"name"`
+	National bool          `json:"national"`
+}
+
+type HolidayFilter struct {
+	Year int `form:"year"`
+}
+
+func (h *Holiday) Prepare() error {
+	if h.Date.IsZero() {
+		return fmt.Errorf("campo %s é requerido", "Data")
+	}
+
+	if h.Name == "" {
+		return fmt.Errorf("campo %s é requerido", "Nome")
+	}
+
+	if len(h.Name) > 100 {
+		return errors.New("campo Nome deve ter no máximo 100 caracteres")
+	}
+
+	h.National = false
+	return nil
+}
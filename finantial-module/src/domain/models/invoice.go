@@ -4,18 +4,150 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
 	"github.com/google/uuid"
 )
 
 type Invoice struct {
-	ID          uuid.UUID         `json:"id"`
-	Account     Account           `json:"account"`
-	Installment uint8             `json:"installment"`
-	DueDate     time.Time         `json:"dueDate"`
-	Value       float64           `json:"value"`
-	CreatedAt   time.Time         `json:"createdAt"`
-	PaidAt      types.NullIsoTime `json:"paidAt"`
+	ID           uuid.UUID          `json:"id"`
+	Account      Account            `json:"account"`
+	Installment  uint8              `json:"installment"`
+	DueDate      time.Time          `json:"dueDate"`
+	Value        Money              `json:"value"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	PaidAt       types.NullDateTime `json:"paidAt"`
+	PaidValue    Money              `json:"paidValue"`
+	LateFee      Money              `json:"lateFee"`
+	LateInterest Money              `json:"lateInterest"`
+	// LateChargedAt is when the late charges were last recorded, on the
+	// latest payment made after the due date, and PaidCharges the part of
+	// PaidValue that paid them.
+	LateChargedAt types.NullDateTime `json:"lateChargedAt"`
+	PaidCharges   Money              `json:"paidCharges"`
+	Boleto        Boleto             `json:"boleto"`
+	// GrossValue is the installment value before discounts, and Discount the
+	// total taken from it to reach Value.
+	GrossValue Money `json:"grossValue"`
+	Discount   Money `json:"discount"`
+	// AgreementID is the renegotiation agreement that issued the invoice,
+	// whose installments are numbered apart from the account ones, and
+	// ReplacedBy the one that cancelled it.
+	CancelledAt types.NullDateTime `json:"cancelledAt"`
+	AgreementID uuid.NullUUID      `json:"agreementId"`
+	ReplacedBy  uuid.NullUUID      `json:"replacedBy"`
+	Pix         PixRegistration    `json:"pix"`
+}
+
+// InvoiceDetail is the invoice as seen on a reference date, including the
+// late charges accrued so far and the amount still needed to settle it.
+type InvoiceDetail struct {
+	Invoice
+	LateCharge LateCharge        `json:"lateCharge"`
+	AmountDue  Money             `json:"amountDue"`
+	Discounts  []InvoiceDiscount `json:"discounts"`
+}
+
+// NewInvoiceDetail evaluates the invoice on the given date. Settled invoices
+// report the charges recorded when they were paid instead of recalculating
+// them with the current policy, and cancelled ones owe nothing.
+func NewInvoiceDetail(invoice Invoice, policy LateChargePolicy, date time.Time) InvoiceDetail {
+	if invoice.IsCancelled() {
+		return InvoiceDetail{Invoice: invoice}
+	}
+
+	if invoice.IsPaid() {
+		return InvoiceDetail{
+			Invoice: invoice,
+			LateCharge: LateCharge{
+				DaysLate: max(daysBetween(invoice.DueDate, invoice.PaidAt.Time), 0),
+				Fee:      invoice.LateFee,
+				Interest: invoice.LateInterest,
+			},
+		}
+	}
+
+	charge := policy.Calculate(&invoice, date)
+	return InvoiceDetail{
+		Invoice:    invoice,
+		LateCharge: charge,
+		AmountDue:  invoice.AmountDue(charge),
+	}
+}
+
+func (i *Invoice) IsPaid() bool {
+	return i.PaidAt.Valid
+}
+
+func (i *Invoice) IsCancelled() bool {
+	return i.CancelledAt.Valid
+}
+
+// IsOpen tells whether the invoice is still to be paid.
+func (i *Invoice) IsOpen() bool {
+	return !i.IsPaid() && !i.IsCancelled()
+}
+
+// Status returns the status of the invoice on the given day, an open invoice
+// being overdue from the day after its due date.
+func (i *Invoice) Status(day time.Time) enums.InvoiceStatus {
+	switch {
+	case i.IsCancelled():
+		return enums.INVOICE_CANCELLED
+	case i.IsPaid():
+		return enums.INVOICE_PAID
+	case calendar.Date(i.DueDate).Before(day):
+		return enums.INVOICE_OVERDUE
+	default:
+		return enums.INVOICE_OPEN
+	}
+}
+
+// OpenPrincipal is the part of the principal not covered by payments yet.
+// Payments made after the due date pay the late charges before the
+// principal.
+func (i *Invoice) OpenPrincipal() Money {
+	return max(i.Value-(i.PaidValue-i.PaidCharges), 0)
+}
+
+// RecordedCharge is the late charge recorded by the latest late payment.
+func (i *Invoice) RecordedCharge() LateCharge {
+	return LateCharge{Fee: i.LateFee, Interest: i.LateInterest}
+}
+
+// OpenCharges is the part of the given late charges not paid yet.
+func (i *Invoice) OpenCharges(charge LateCharge) Money {
+	return max(charge.Total()-i.PaidCharges, 0)
+}
+
+// Pay applies a payment of the given value with the late charges accrued
+// until it was made. Late charges are recorded on every late payment and
+// paid first, the rest amortizing the principal, and the invoice is settled
+// once nothing is left due.
+func (i *Invoice) Pay(value Money, charge LateCharge, paidAt time.Time) {
+	if charge.DaysLate > 0 {
+		i.LateFee, i.LateInterest = charge.Fee, charge.Interest
+		i.LateChargedAt = types.NullDateTime{Time: paidAt, Valid: true}
+	}
+
+	i.PaidCharges += min(value, i.OpenCharges(charge))
+	i.PaidValue += value
+	if i.AmountDue(charge) <= 0 {
+		i.PaidAt = types.NullDateTime{Time: paidAt, Valid: true}
+	}
+}
+
+// AmountDue is the principal plus the given late charges minus what has
+// already been paid.
+func (i *Invoice) AmountDue(charge LateCharge) Money {
+	return i.Value + charge.Total() - i.PaidValue
+}
+
+// IsAdjustment tells whether the invoice was issued after the installments of
+// the account, as the final charge of its cancellation.
+func (i *Invoice) IsAdjustment() bool {
+	return !i.AgreementID.Valid && i.Installment > i.Account.Installments
 }
 
 func (i *Invoice) Prepare() error {
@@ -40,7 +172,7 @@ func (i *Invoice) validate() error {
 		return fmt.Errorf("campo %s é requerido", "Data de vencimento")
 	}
 
-	if i.Value == 0.0 {
+	if i.Value.IsZero() {
 		return fmt.Errorf("campo %s é requerido", "Valor")
 	}
 
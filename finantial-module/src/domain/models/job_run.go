@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+)
+
+type JobRun struct {
+	ID           uuid.UUID          `json:"id"`
+	JobName      string             `json:"jobName"`
+	Trigger      enums.JobTrigger   `json:"trigger"`
+	Status       enums.JobRunStatus `json:"status"`
+	ScheduledFor types.NullDateTime `json:"scheduledFor"`
+	StartedAt    time.Time          `json:"startedAt"`
+	FinishedAt   types.NullDateTime `json:"finishedAt"`
+	Error        string             `json:"error,omitempty"`
+	Instance     string             `json:"instance"`
+}
+
+// Job describes a job registered in the scheduler.
+type Job struct {
+	Name    string             `json:"name"`
+	Spec    string             `json:"spec"`
+	NextRun types.NullDateTime `json:"nextRun"`
+}
+
+type JobRunFilter struct {
+	JobName string `form:"job"`
+	Limit   int    `form:"limit"`
+}
@@ -0,0 +1,64 @@
+package models
+
+import "time"
+
+// interestDaysPerMonth is the commercial month used to pro-rate the monthly
+// interest rate into a daily one.
+const interestDaysPerMonth = 30
+
+// LateChargePolicy holds the penalties applied to invoices paid after the due
+// date: a one-off fee (multa) and a monthly interest rate (juros de mora)
+// charged pro-rata per day late.
+type LateChargePolicy struct {
+	FeePercentage             Percentage
+	MonthlyInterestPercentage Percentage
+}
+
+type LateCharge struct {
+	DaysLate int   `json:"daysLate"`
+	Fee      Money `json:"fee"`
+	Interest Money `json:"interest"`
+}
+
+func (c LateCharge) Total() Money {
+	return c.Fee + c.Interest
+}
+
+// Calculate returns the late charges accrued on the invoice until the given
+// date. The fee is charged once, on the principal open at the first late
+// payment, and the interest accrues pro rata on the principal open on each
+// day, so the charges recorded by earlier payments are carried over and only
+// the days since the last of them are added. Invoices within the due date owe
+// nothing.
+func (p LateChargePolicy) Calculate(invoice *Invoice, date time.Time) LateCharge {
+	days := daysBetween(invoice.DueDate, date)
+	if days <= 0 {
+		return LateCharge{}
+	}
+
+	principal := invoice.OpenPrincipal()
+	if invoice.LateChargedAt.Valid {
+		return LateCharge{
+			DaysLate: days,
+			Fee:      invoice.LateFee,
+			Interest: invoice.LateInterest + p.interest(principal, daysBetween(invoice.LateChargedAt.Time, date)),
+		}
+	}
+
+	return LateCharge{
+		DaysLate: days,
+		Fee:      principal.Percent(p.FeePercentage),
+		Interest: p.interest(principal, days),
+	}
+}
+
+func (p LateChargePolicy) interest(principal Money, days int) Money {
+	return principal.MulRatio(int64(p.MonthlyInterestPercentage)*int64(max(days, 0)), 10000*interestDaysPerMonth)
+}
+
+func daysBetween(from, to time.Time) int {
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(end.Sub(start).Hours() / 24)
+}
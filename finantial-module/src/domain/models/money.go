@@ -0,0 +1,147 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Money is an exact monetary amount stored in cents. It maps to DECIMAL(19,2)
+// columns and is serialized to JSON as a number with two decimal places.
+type Money int64
+
+func NewMoneyFromCents(cents int64) Money {
+	return Money(cents)
+}
+
+var moneyPattern = regexp.MustCompile(`^-?\d+(\.\d{1,2})?$`)
+
+// ParseMoney converts a decimal string with at most two decimal places
+// (e.g. "1000.5") into Money. Signs other than a leading "-", exponents and
+// amounts that do not fit in int64 cents are rejected.
+func ParseMoney(raw string) (Money, error) {
+	value := strings.TrimSpace(raw)
+	if !moneyPattern.MatchString(value) {
+		return 0, fmt.Errorf("invalid monetary value: %q", raw)
+	}
+
+	negative := strings.HasPrefix(value, "-")
+	integerPart, fractionPart, _ := strings.Cut(strings.TrimPrefix(value, "-"), ".")
+
+	units, err := strconv.ParseInt(integerPart, 10, 64)
+	if err != nil || units > math.MaxInt64/100 {
+		return 0, fmt.Errorf("monetary value out of range: %q", raw)
+	}
+
+	cents, _ := strconv.ParseInt((fractionPart + "00")[:2], 10, 64)
+	if units*100 > math.MaxInt64-cents {
+		return 0, fmt.Errorf("monetary value out of range: %q", raw)
+	}
+
+	result := units*100 + cents
+	if negative {
+		result = -result
+	}
+
+	return Money(result), nil
+}
+
+func (m Money) Cents() int64 {
+	return int64(m)
+}
+
+func (m Money) IsZero() bool {
+	return m == 0
+}
+
+func (m Money) String() string {
+	cents := int64(m)
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// Split divides the amount into n parts whose sum is always equal to the
+// original amount, spreading the remaining cents over the first parts.
+func (m Money) Split(n int) []Money {
+	if n <= 0 {
+		return nil
+	}
+
+	base := int64(m) / int64(n)
+	remainder := int64(m) % int64(n)
+
+	parts := make([]Money, n)
+	for i := range parts {
+		parts[i] = Money(base)
+		if int64(i) < remainder {
+			parts[i]++
+		} else if int64(i) < -remainder {
+			parts[i]--
+		}
+	}
+
+	return parts
+}
+
+func (m *Money) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*m = 0
+	case int64:
+		*m = Money(v * 100)
+	case float64:
+		parsed, err := ParseMoney(strconv.FormatFloat(v, 'f', 2, 64))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case []byte:
+		parsed, err := ParseMoney(string(v))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case string:
+		parsed, err := ParseMoney(v)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	default:
+		return fmt.Errorf("cannot scan %T into Money", value)
+	}
+
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+	if value == "null" || value == "" {
+		*m = 0
+		return nil
+	}
+
+	parsed, err := ParseMoney(value)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
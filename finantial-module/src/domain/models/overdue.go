@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+)
+
+// OverduePolicy decides when an account becomes delinquent: an open invoice
+// only counts as overdue once GraceDays have passed after its due date.
+type OverduePolicy struct {
+	GraceDays int
+}
+
+// OverdueReport summarizes a run of the overdue processing: how many
+// accounts were scanned and how many changed status in each direction. The
+// batches that could not be updated are listed in FailedBatches and left for
+// the next run.
+type OverdueReport struct {
+	Cutoff        time.Time             `json:"cutoff"`
+	Batches       int                   `json:"batches"`
+	Scanned       int                   `json:"scanned"`
+	Delinquent    int                   `json:"delinquent"`
+	Regularized   int                   `json:"regularized"`
+	Errors        int                   `json:"errors"`
+	FailedBatches []OverdueBatchFailure `json:"failedBatches"`
+}
+
+// OverdueBatchFailure is a batch of the overdue processing that was rolled
+// back: the accounts whose ids follow After up to LastID.
+type OverdueBatchFailure struct {
+	After  uuid.UUID `json:"after"`
+	LastID uuid.UUID `json:"lastId"`
+	Error  string    `json:"error"`
+}
+
+// AccountPage is the last account id and the size of a page of accounts.
+type AccountPage struct {
+	LastID uuid.UUID
+	Total  int
+}
+
+// Cutoff returns the first due date that is not overdue on the given date.
+// Open invoices due before it are overdue.
+func (p OverduePolicy) Cutoff(date time.Time) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -p.GraceDays)
+}
+
+func (p OverduePolicy) IsOverdue(dueDate, date time.Time) bool {
+	return daysBetween(dueDate, date) > p.GraceDays
+}
+
+// Evaluate returns the status the account should have on the given date,
+// given the due date of its oldest open invoice.
+func (p OverduePolicy) Evaluate(oldestOpenDueDate types.NullDateTime, date time.Time) enums.AccountStatus {
+	if oldestOpenDueDate.Valid && p.IsOverdue(oldestOpenDueDate.Time, date) {
+		return enums.INADIMPLENTE
+	}
+
+	return enums.ADIMPLENTE
+}
@@ -0,0 +1,94 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Payer is the person or company responsible for the invoices of a student,
+// whose name and CPF/CNPJ are required to register boletos with the bank.
+type Payer struct {
+	StudentID uuid.UUID `json:"studentId"`
+	Name      string    `json:"name"`
+	Document  string    `json:"document"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (p *Payer) Prepare() error {
+	p.Name = strings.TrimSpace(p.Name)
+	p.Document = strings.Map(func(r rune) rune {
+		if r == '.' || r == '-' || r == '/' || r == ' ' {
+			return -1
+		}
+		return r
+	}, p.Document)
+
+	if err := p.validate(); err != nil {
+		return err
+	}
+
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+func (p *Payer) validate() error {
+	if p.StudentID == uuid.Nil {
+		return fmt.Errorf("campo %s é requerido", "Estudante")
+	}
+
+	if p.Name == "" {
+		return fmt.Errorf("campo %s é requerido", "Nome")
+	}
+
+	if len(p.Name) > 100 {
+		return errors.New("campo Nome deve ter no máximo 100 caracteres")
+	}
+
+	if !IsValidDocument(p.Document) {
+		return fmt.Errorf("campo %s é inválido", "CPF/CNPJ")
+	}
+
+	return nil
+}
+
+// IsValidDocument tells whether the value is a CPF (11 digits) or a CNPJ (14
+// digits) with valid check digits.
+func IsValidDocument(document string) bool {
+	for _, r := range document {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	switch len(document) {
+	case 11:
+		return strings.Count(document, document[:1]) != 11 &&
+			documentCheckDigit(document[:9], []int{10, 9, 8, 7, 6, 5, 4, 3, 2}) == document[9] &&
+			documentCheckDigit(document[:10], []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}) == document[10]
+	case 14:
+		return strings.Count(document, document[:1]) != 14 &&
+			documentCheckDigit(document[:12], []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == document[12] &&
+			documentCheckDigit(document[:13], []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == document[13]
+	default:
+		return false
+	}
+}
+
+// documentCheckDigit computes the modulo 11 check digit shared by CPF and
+// CNPJ.
+func documentCheckDigit(digits string, weights []int) byte {
+	sum := 0
+	for i, weight := range weights {
+		sum += int(digits[i]-'0') * weight
+	}
+
+	if remainder := sum % 11; remainder >= 2 {
+		return byte('0' + 11 - remainder)
+	}
+
+	return '0'
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/google/uuid"
+)
+
+type Payment struct {
+	ID        uuid.UUID `json:"id"`
+	InvoiceID uuid.UUID `json:"invoiceId"`
+	Value     Money     `json:"value"`
+	// Overpaid is the part of Value above what was due, which only payments
+	// collected by the bank may have.
+	Overpaid  Money               `json:"overpaid"`
+	Method    enums.PaymentMethod `json:"method"`
+	PaidAt    time.Time           `json:"paidAt"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+func (p *Payment) Prepare() error {
+	if err := p.validate(); err != nil {
+		return err
+	}
+
+	p.format()
+	return nil
+}
+
+func (p *Payment) validate() error {
+	if p.InvoiceID == uuid.Nil {
+		return fmt.Errorf("campo %s é requerido", "Parcela")
+	}
+
+	if p.Value <= 0 {
+		return fmt.Errorf("campo %s é requerido", "Valor")
+	}
+
+	if !p.Method.IsValid() {
+		return fmt.Errorf("campo %s é inválido", "Forma de pagamento")
+	}
+
+	return nil
+}
+
+func (p *Payment) format() {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+
+	if p.PaidAt.IsZero() {
+		p.PaidAt = time.Now()
+	}
+
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = time.Now()
+	}
+}
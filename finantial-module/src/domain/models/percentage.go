@@ -0,0 +1,71 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+)
+
+// Percentage is an exact rate stored in basis points (hundredths of a
+// percent), so "2.5" is kept as 250.
+type Percentage int64
+
+// ParsePercentage converts a decimal string with at most two decimal places
+// (e.g. "2.5") into Percentage. Finer rates such as "0.033" cannot be held in
+// basis points and are rejected instead of rounded.
+func ParsePercentage(raw string) (Percentage, error) {
+	value, err := ParseMoney(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid percentage: %q", raw)
+	}
+
+	return Percentage(value), nil
+}
+
+func (p Percentage) String() string {
+	return Money(p).String()
+}
+
+// Scan reads a percentage from a DECIMAL column, where 2.5% is stored as 2.50.
+func (p *Percentage) Scan(value any) error {
+	return (*Money)(p).Scan(value)
+}
+
+func (p Percentage) Value() (driver.Value, error) {
+	return Money(p).Value()
+}
+
+func (p Percentage) MarshalJSON() ([]byte, error) {
+	return Money(p).MarshalJSON()
+}
+
+func (p *Percentage) UnmarshalJSON(data []byte) error {
+	return (*Money)(p).UnmarshalJSON(data)
+}
+
+// Percent returns p percent of the amount, rounded half away from zero.
+func (m Money) Percent(p Percentage) Money {
+	return m.MulRatio(int64(p), 10000)
+}
+
+// MulRatio returns m * numerator / denominator, rounded half away from zero.
+func (m Money) MulRatio(numerator, denominator int64) Money {
+	if denominator == 0 {
+		return 0
+	}
+
+	product := new(big.Int).Mul(big.NewInt(int64(m)), big.NewInt(numerator))
+	den := big.NewInt(denominator)
+
+	quotient, remainder := new(big.Int).QuoRem(product, den, new(big.Int))
+	remainder.Abs(remainder).Mul(remainder, big.NewInt(2))
+	if remainder.Cmp(new(big.Int).Abs(den)) >= 0 {
+		if product.Sign()*den.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	return Money(quotient.Int64())
+}
@@ -0,0 +1,168 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	pixGUI           = "br.gov.bcb.pix"
+	pixTxIDMaxLength = 25
+	pixNameMaxLength = 25
+	pixCityMaxLength = 15
+
+	// PixChargeExpiration is how long a charge stays payable at the PSP after
+	// it is created or renewed.
+	PixChargeExpiration = 24 * time.Hour
+	// pixChargeRenewalMargin renews a charge this long before it expires, so
+	// the BR Code handed out still leaves time to pay it.
+	pixChargeRenewalMargin = time.Hour
+)
+
+// PixMerchant identifies the receiver of PIX payments.
+type PixMerchant struct {
+	Key  string
+	Name string
+	City string
+}
+
+// PixCharge is a payable PIX artifact for an invoice: the BR Code "copia e
+// cola" payload and its QR code rendered as PNG.
+type PixCharge struct {
+	InvoiceID uuid.UUID `json:"invoiceId"`
+	TxID      string    `json:"txid"`
+	Amount    Money     `json:"amount"`
+	Payload   string    `json:"payload"`
+	QRCode    []byte    `json:"qrCode" swaggertype:"string" format:"base64"`
+}
+
+// PixRegistration is the immediate charge registered at the PSP for an
+// invoice, kept so the charge is created once and only amended when the
+// amount due changes or it is about to expire.
+type PixRegistration struct {
+	TxID      string             `json:"txid"`
+	Location  string             `json:"location"`
+	Amount    Money              `json:"amount"`
+	CreatedAt types.NullDateTime `json:"createdAt"`
+	ExpiresAt types.NullDateTime `json:"expiresAt"`
+}
+
+func (r PixRegistration) IsEmpty() bool {
+	return r.Location == ""
+}
+
+// IsExpired tells whether the charge is no longer payable at the given time,
+// or will not be for long enough to hand it out.
+func (r PixRegistration) IsExpired(now time.Time) bool {
+	return !r.ExpiresAt.Valid || !now.Before(r.ExpiresAt.Time.Add(-pixChargeRenewalMargin))
+}
+
+// Expiration is how long after its creation the charge expires, as the PSP
+// counts it.
+func (r PixRegistration) Expiration() time.Duration {
+	return r.ExpiresAt.Time.Sub(r.CreatedAt.Time)
+}
+
+func (m PixMerchant) Validate() error {
+	if m.Key == "" {
+		return fmt.Errorf("campo %s é requerido", "Chave PIX")
+	}
+
+	if m.Name == "" {
+		return fmt.Errorf("campo %s é requerido", "Nome do recebedor")
+	}
+
+	if m.City == "" {
+		return fmt.Errorf("campo %s é requerido", "Cidade do recebedor")
+	}
+
+	return nil
+}
+
+// PixTxID derives the transaction id used to reconcile PIX payments with the
+// invoice: the first 25 hex digits of its ID, the most a static payload takes.
+func PixTxID(invoiceID uuid.UUID) string {
+	return PixChargeTxID(invoiceID)[:pixTxIDMaxLength]
+}
+
+// PixChargeTxID derives the txid of the charge registered at the PSP for the
+// invoice, which must have from 26 to 35 characters: all the hex digits of
+// its ID.
+func PixChargeTxID(invoiceID uuid.UUID) string {
+	return strings.ReplaceAll(invoiceID.String(), "-", "")
+}
+
+// Payload builds the BR Code following the EMV-MPM layout defined by the
+// Central Bank, including the trailing CRC16 checksum. With the location of a
+// charge registered at the PSP the payload is dynamic and single use, the
+// PSP resolving amount and txid from it; otherwise it is a static payload
+// bound to Key.
+func (m PixMerchant) Payload(amount Money, txid, location string) string {
+	var b strings.Builder
+	b.WriteString(emvField("00", "01"))
+	if location != "" {
+		b.WriteString(emvField("01", "12"))
+		b.WriteString(emvField("26", emvField("00", pixGUI)+emvField("25", location)))
+		txid = "***"
+	} else {
+		b.WriteString(emvField("26", emvField("00", pixGUI)+emvField("01", m.Key)))
+	}
+	b.WriteString(emvField("52", "0000"))
+	b.WriteString(emvField("53", "986"))
+	if amount > 0 {
+		b.WriteString(emvField("54", amount.String()))
+	}
+	b.WriteString(emvField("58", "BR"))
+	b.WriteString(emvField("59", emvText(m.Name, pixNameMaxLength)))
+	b.WriteString(emvField("60", emvText(m.City, pixCityMaxLength)))
+	b.WriteString(emvField("62", emvField("05", txid)))
+	b.WriteString("6304")
+
+	return b.String() + fmt.Sprintf("%04X", crc16CCITT(b.String()))
+}
+
+func emvField(id, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+// emvText strips accents and truncates the value, since BR Code readers only
+// accept the ASCII subset in merchant fields.
+func emvText(value string, limit int) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(value) {
+		if unicode.Is(unicode.Mn, r) || r > unicode.MaxASCII {
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	result := strings.TrimSpace(b.String())
+	if len(result) > limit {
+		result = strings.TrimSpace(result[:limit])
+	}
+
+	return result
+}
+
+// crc16CCITT computes CRC-16/CCITT-FALSE (polynomial 0x1021, initial 0xFFFF).
+func crc16CCITT(value string) uint16 {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(value); i++ {
+		crc ^= uint16(value[i]) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/google/uuid"
+)
+
+// ReconciliationReport lists the differences found between the enrollments
+// of school-module and the accounts. Discrepancies are only healed when the
+// reconciliation is not a dry run.
+type ReconciliationReport struct {
+	DryRun        bool          `json:"dryRun"`
+	StartedAt     time.Time     `json:"startedAt"`
+	FinishedAt    time.Time     `json:"finishedAt"`
+	Enrollments   int           `json:"enrollments"`
+	Accounts      int           `json:"accounts"`
+	Skipped       int           `json:"skipped"`
+	Healed        int           `json:"healed"`
+	Discrepancies []Discrepancy `json:"discrepancies"`
+}
+
+type Discrepancy struct {
+	Type             enums.DiscrepancyType      `json:"type"`
+	StudentID        uuid.UUID                  `json:"studentId"`
+	CourseID         uuid.UUID                  `json:"courseId"`
+	AccountID        uuid.NullUUID              `json:"accountId"`
+	EnrollmentStatus string                     `json:"enrollmentStatus,omitempty"`
+	AccountStatus    enums.AccountStatus        `json:"accountStatus,omitempty"`
+	Action           enums.ReconciliationAction `json:"action"`
+	Healed           bool                       `json:"healed"`
+	Error            string                     `json:"error,omitempty"`
+}
+
+type ReconciliationParams struct {
+	Heal bool `form:"heal"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+)
+
+// Refund is what the school owes the student after the cancellation of an
+// account paid in advance. It stays PENDING until it is paid back.
+type Refund struct {
+	ID             uuid.UUID          `json:"id"`
+	AccountID      uuid.UUID          `json:"accountId"`
+	CancellationID uuid.UUID          `json:"cancellationId"`
+	Value          Money              `json:"value"`
+	Status         enums.RefundStatus `json:"status"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	PaidAt         types.NullDateTime `json:"paidAt"`
+}
@@ -0,0 +1,259 @@
+package models
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+)
+
+// Statement is the financial history of a student over all of their
+// accounts, cancelled ones included. Entries are in chronological order and
+// Balance is what the student owes after each of them: charges are posted on
+// their due dates, discounts along with them, late charges on the latest
+// payment made after the due date, each payment on the day it was made and
+// cancellations on the day they happened, the refund of a cancelled account
+// being credited to the student. When the statement starts on a date,
+// OpeningBalance carries what was owed before it.
+type Statement struct {
+	StudentID      uuid.UUID          `json:"studentId"`
+	From           types.NullDateTime `json:"from"`
+	To             types.NullDateTime `json:"to"`
+	Accounts       []Account          `json:"accounts"`
+	Agreements     []Agreement        `json:"agreements"`
+	Cancellations  []Cancellation     `json:"cancellations"`
+	OpeningBalance Money              `json:"openingBalance"`
+	ClosingBalance Money              `json:"closingBalance"`
+	Entries        []StatementEntry   `json:"entries"`
+	Totals         []StatementTotal   `json:"totals"`
+}
+
+type StatementEntry struct {
+	Date        time.Time                `json:"date"`
+	Type        enums.StatementEntryType `json:"type"`
+	AccountID   uuid.UUID                `json:"accountId"`
+	CourseID    uuid.UUID                `json:"courseId"`
+	InvoiceID   uuid.NullUUID            `json:"invoiceId"`
+	Installment uint8                    `json:"installment,omitempty"`
+	Description string                   `json:"description"`
+	Amount      Money                    `json:"amount"`
+	Balance     Money                    `json:"balance"`
+}
+
+// StatementTotal sums the invoices due in the statement period that are in
+// a status on the statement date.
+type StatementTotal struct {
+	Status    enums.InvoiceStatus `json:"status"`
+	Invoices  int                 `json:"invoices"`
+	Value     Money               `json:"value"`
+	PaidValue Money               `json:"paidValue"`
+}
+
+// StatementPeriod limits a statement to the entries from From to To, both
+// included, either of them being optional.
+type StatementPeriod struct {
+	From types.NullDateTime
+	To   types.NullDateTime
+}
+
+// NewStatement lays out the movements of the given accounts within the
+// period, payments being those made to the given invoices. day maps instants
+// such as payment times to the calendar date they happened on, and today is
+// the statement date.
+func NewStatement(studentID uuid.UUID, accounts []Account, invoices []Invoice, payments []Payment, agreements []Agreement,
+	cancellations []Cancellation, period StatementPeriod, day func(time.Time) time.Time, today time.Time) *Statement {
+	statement := &Statement{
+		StudentID:     studentID,
+		From:          period.From,
+		To:            period.To,
+		Accounts:      accounts,
+		Agreements:    agreements,
+		Cancellations: cancellations,
+		Entries:       []StatementEntry{},
+	}
+
+	paymentsByInvoice := map[uuid.UUID][]Payment{}
+	for _, payment := range payments {
+		paymentsByInvoice[payment.InvoiceID] = append(paymentsByInvoice[payment.InvoiceID], payment)
+	}
+
+	entries := []StatementEntry{}
+	for i := range invoices {
+		entries = append(entries, invoiceEntries(&invoices[i], paymentsByInvoice[invoices[i].ID], day)...)
+	}
+
+	for _, cancellation := range cancellations {
+		if cancellation.Refund.IsZero() {
+			continue
+		}
+
+		entries = append(entries, StatementEntry{
+			Date:        day(cancellation.CreatedAt),
+			Type:        enums.STATEMENT_REFUND,
+			AccountID:   cancellation.AccountID,
+			CourseID:    courseOf(accounts, cancellation.AccountID),
+			Description: fmt.Sprintf("Crédito do cancelamento (%s)", cancellation.Reason),
+			Amount:      -cancellation.Refund,
+		})
+	}
+
+	// Entries of the same day keep the order they were laid out in, which
+	// posts the charge of an invoice before what was paid or discounted.
+	slices.SortStableFunc(entries, func(a, b StatementEntry) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	for _, entry := range entries {
+		if period.From.Valid && entry.Date.Before(period.From.Time) {
+			statement.OpeningBalance += entry.Amount
+			continue
+		}
+
+		if period.To.Valid && entry.Date.After(period.To.Time) {
+			break
+		}
+
+		statement.Entries = append(statement.Entries, entry)
+	}
+
+	statement.ClosingBalance = statement.OpeningBalance
+	for i := range statement.Entries {
+		statement.ClosingBalance += statement.Entries[i].Amount
+		statement.Entries[i].Balance = statement.ClosingBalance
+	}
+
+	statement.Totals = statementTotals(invoices, period, today)
+	return statement
+}
+
+func invoiceEntries(invoice *Invoice, payments []Payment, day func(time.Time) time.Time) []StatementEntry {
+	entry := func(date time.Time, entryType enums.StatementEntryType, description string, amount Money) StatementEntry {
+		return StatementEntry{
+			Date:        date,
+			Type:        entryType,
+			AccountID:   invoice.Account.ID,
+			CourseID:    invoice.Account.CourseID,
+			InvoiceID:   uuid.NullUUID{UUID: invoice.ID, Valid: true},
+			Installment: invoice.Installment,
+			Description: description,
+			Amount:      amount,
+		}
+	}
+
+	name := fmt.Sprintf("Parcela %d/%d", invoice.Installment, invoice.Account.Installments)
+	switch {
+	case invoice.IsAdjustment():
+		name = "Cobrança final do cancelamento"
+	case invoice.AgreementID.Valid && invoice.Installment == 0:
+		name = "Entrada do acordo"
+	case invoice.AgreementID.Valid:
+		name = fmt.Sprintf("Parcela %d do acordo", invoice.Installment)
+	}
+
+	// Invoices cancelled ahead of their due date are charged on the day they
+	// were cancelled, so the cancellation never precedes the charge.
+	chargedOn := calendar.Date(invoice.DueDate)
+	if cancelledOn := day(invoice.CancelledAt.Time); invoice.IsCancelled() && cancelledOn.Before(chargedOn) {
+		chargedOn = cancelledOn
+	}
+
+	entries := []StatementEntry{entry(chargedOn, enums.STATEMENT_CHARGE, name, invoice.GrossValue)}
+	if !invoice.Discount.IsZero() {
+		entries = append(entries, entry(chargedOn, enums.STATEMENT_DISCOUNT, "Desconto da "+name, -invoice.Discount))
+	}
+
+	// Late charges are recorded on each payment made after the due date, so
+	// they are posted on the latest of them ahead of the payment itself.
+	if invoice.LateChargedAt.Valid {
+		chargedOn := day(invoice.LateChargedAt.Time)
+		if !invoice.LateFee.IsZero() {
+			entries = append(entries, entry(chargedOn, enums.STATEMENT_LATE_FEE, "Multa da "+name, invoice.LateFee))
+		}
+
+		if !invoice.LateInterest.IsZero() {
+			entries = append(entries, entry(chargedOn, enums.STATEMENT_LATE_INTEREST, "Juros da "+name, invoice.LateInterest))
+		}
+	}
+
+	for _, payment := range payments {
+		entries = append(entries, entry(day(payment.PaidAt), enums.STATEMENT_PAYMENT, "Pagamento da "+name, -payment.Value))
+	}
+
+	// Cancelling the invoice writes off only what is still due on it.
+	if invoice.IsCancelled() {
+		description := "Cancelamento da " + name
+		if invoice.ReplacedBy.Valid {
+			description = "Renegociação da " + name
+		}
+
+		entries = append(entries, entry(day(invoice.CancelledAt.Time), enums.STATEMENT_CANCELLATION, description, -invoice.AmountDue(invoice.RecordedCharge())))
+	}
+
+	return entries
+}
+
+func statementTotals(invoices []Invoice, period StatementPeriod, today time.Time) []StatementTotal {
+	totals := make([]StatementTotal, len(enums.InvoiceStatusValues))
+	for i, status := range enums.InvoiceStatusValues {
+		totals[i].Status = status
+	}
+
+	for i := range invoices {
+		dueDate := calendar.Date(invoices[i].DueDate)
+		if (period.From.Valid && dueDate.Before(period.From.Time)) || (period.To.Valid && dueDate.After(period.To.Time)) {
+			continue
+		}
+
+		total := &totals[slices.Index(enums.InvoiceStatusValues, invoices[i].Status(today))]
+		total.Invoices++
+		total.Value += invoices[i].Value
+		total.PaidValue += invoices[i].PaidValue
+	}
+
+	return totals
+}
+
+func courseOf(accounts []Account, accountID uuid.UUID) uuid.UUID {
+	for _, account := range accounts {
+		if account.ID == accountID {
+			return account.CourseID
+		}
+	}
+
+	return uuid.Nil
+}
+
+// WriteCSV writes the entries of the statement, one per line after a header.
+func (s *Statement) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{
+		"date", "type", "account_id", "course_id", "invoice_id", "installment", "description", "amount", "balance",
+	}); err != nil {
+		return err
+	}
+
+	for _, entry := range s.Entries {
+		invoiceID, installment := "", ""
+		if entry.InvoiceID.Valid {
+			invoiceID = entry.InvoiceID.UUID.String()
+			installment = strconv.Itoa(int(entry.Installment))
+		}
+
+		if err := writer.Write([]string{
+			entry.Date.Format(time.DateOnly), string(entry.Type), entry.AccountID.String(), entry.CourseID.String(),
+			invoiceID, installment, entry.Description, entry.Amount.String(), entry.Balance.String(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -5,5 +5,5 @@ import (
 )
 
 type Student struct {
-	ID uuid.UUID `json:"id"`
+	ID uuid.UUID `json:"id" validate:"required"`
 }
@@ -3,10 +3,16 @@ package usecases
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/boleto"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/producers"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/monitoring"
@@ -16,20 +22,40 @@ import (
 type AccountUsecases interface {
 	GetAll(ctx context.Context) ([]models.Account, error)
 	Create(ctx context.Context, model *models.Account) error
-	DeleteByStudentAndCourse(ctx context.Context, studentId, courseId uuid.UUID) error
-	DeleteByCourse(ctx context.Context, courseId uuid.UUID) error
-	DeleteByStudent(ctx context.Context, studentId uuid.UUID) error
+	// CancelByStudentAndCourse cancels the account of an enrollment, keeping
+	// its history. Enrollments without an active account are ignored.
+	CancelByStudentAndCourse(ctx context.Context, studentId, courseId uuid.UUID, reason string) error
+	CancelByCourse(ctx context.Context, courseId uuid.UUID, reason string) error
+	CancelByStudent(ctx context.Context, studentId uuid.UUID, reason string) error
 }
 
 type AccountUsecase struct {
-	InvoiceUsecases InvoiceUsecases
-	Repository      repositories.AccountRepository
+	InvoiceUsecases        InvoiceUsecases
+	HolidayUsecases        HolidayUsecases
+	Repository             repositories.AccountRepository
+	InvoiceRepository      repositories.InvoiceRepository
+	CancellationRepository repositories.CancellationRepository
+	RefundRepository       repositories.RefundRepository
+	CourseRepository       repositories.CourseRepository
+	AccountProducer        producers.AccountProducer
+	BankLayout             boleto.BankLayout
+	UnitOfWork             transactions.UnitOfWork
+	Clock                  func() time.Time
 }
 
 func NewAccountUsecase() *AccountUsecase {
 	return &AccountUsecase{
-		InvoiceUsecases: NewInvoiceUsecase(),
-		Repository:      repositories.NewAccountDBRepository(),
+		InvoiceUsecases:        NewInvoiceUsecase(),
+		HolidayUsecases:        NewHolidayUsecase(),
+		Repository:             repositories.NewAccountDBRepository(),
+		InvoiceRepository:      repositories.NewInvoiceDBRepository(),
+		CancellationRepository: repositories.NewCancellationDBRepository(),
+		RefundRepository:       repositories.NewRefundDBRepository(),
+		CourseRepository:       repositories.NewCourseDBRepository(),
+		AccountProducer:        producers.NewAccountProducer(),
+		BankLayout:             newBankLayout(),
+		UnitOfWork:             transactions.NewSQLUnitOfWork(),
+		Clock:                  time.Now,
 	}
 }
 
@@ -38,32 +64,217 @@ func (u *AccountUsecase) GetAll(ctx context.Context) ([]models.Account, error) {
 	return u.Repository.FindAll(ctx)
 }
 
+// Create opens the account of an enrollment and replies to the enrollment
+// saga of school-module: AccountCreated once the account and its invoices are
+// stored, or AccountRejected when the enrollment cannot be billed.
+//
+// The account opens when the student enrolled, even when the enrollment is
+// billed later, so its due dates are counted from the enrollment.
+//
+// The account is priced from the course catalog, capturing the price of the
+// course at enrollment time. The value received with the enrollment is used
+// for courses not in the catalog yet, and for those repriced after the
+// student enrolled, as it is the price the enrollment was taken at.
 func (u *AccountUsecase) Create(ctx context.Context, model *models.Account) error {
 	model.ID = uuid.New()
 	model.Status = enums.ADIMPLENTE
-	model.CreatedAt = time.Now()
+	if model.CreatedAt.IsZero() {
+		model.CreatedAt = u.Clock()
+	}
+
+	return u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		if err := u.validate(ctx, model); err != nil {
+			return u.reject(ctx, model, err)
+		}
+
+		if err := u.Repository.Insert(ctx, model); err != nil {
+			return err
+		}
+
+		if err := u.InvoiceUsecases.Create(ctx, model); err != nil {
+			return err
+		}
 
-	if err := u.Repository.Insert(ctx, model); err != nil {
+		return u.AccountProducer.Created(ctx, model)
+	})
+}
+
+func (u *AccountUsecase) validate(ctx context.Context, model *models.Account) error {
+	exists, err := u.Repository.ExistsByStudentAndCourse(ctx, model.StudentID, model.CourseID)
+	if err != nil {
 		return err
 	}
 
-	if err := u.InvoiceUsecases.Create(ctx, model); err != nil {
+	if exists {
+		return errors.New(exceptions.ErrAccountAlreadyExists)
+	}
+
+	if model.Installments > models.MaxAccountInstallments {
+		return errors.New(exceptions.ErrAccountInstallmentsExceeded)
+	}
+
+	return u.price(ctx, model)
+}
+
+// reject replies AccountRejected for business errors. Other errors are
+// returned, so the message is retried.
+func (u *AccountUsecase) reject(ctx context.Context, model *models.Account, err error) error {
+	switch err.Error() {
+	case exceptions.ErrAccountAlreadyExists, exceptions.ErrAccountInstallmentsExceeded, exceptions.ErrCoursePriceNotFound:
+	default:
 		return err
 	}
 
+	logging.Warn(ctx).
+		AddParam("studentID", model.StudentID).
+		AddParam("courseID", model.CourseID).
+		AddParam("reason", err.Error()).
+		Msg("Account rejected")
+
+	return u.AccountProducer.Rejected(ctx, model, err.Error())
+}
+
+func (u *AccountUsecase) price(ctx context.Context, model *models.Account) error {
+	course, err := u.CourseRepository.FindByID(ctx, model.CourseID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case course != nil && (model.Value.IsZero() || !course.UpdatedAt.After(model.CreatedAt)):
+		model.Value = course.Value
+	case course != nil:
+		logging.Info(ctx).
+			AddParam("courseID", model.CourseID).
+			AddParam("value", model.Value).
+			Msg("Course repriced after the enrollment, account priced from the enrollment")
+	case model.Value.IsZero():
+		return errors.New(exceptions.ErrCoursePriceNotFound)
+	default:
+		logging.Warn(ctx).
+			AddParam("courseID", model.CourseID).
+			AddParam("value", model.Value).
+			Msg("Course not in catalog, account priced from the enrollment")
+	}
+
 	return nil
 }
 
-func (u *AccountUsecase) DeleteByStudentAndCourse(ctx context.Context, studentId, courseId uuid.UUID) error {
-	return u.Repository.DeleteByStudentAndCourse(ctx, studentId, courseId)
+func (u *AccountUsecase) CancelByStudentAndCourse(ctx context.Context, studentId, courseId uuid.UUID, reason string) error {
+	return u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		account, err := u.Repository.FindActiveByStudentAndCourse(ctx, studentId, courseId)
+		if err != nil || account == nil {
+			return err
+		}
+
+		return u.cancel(ctx, account, reason)
+	})
 }
 
-func (u *AccountUsecase) DeleteByCourse(ctx context.Context, courseId uuid.UUID) error {
-	seg := monitoring.StartTransactionSegment(ctx, "usecase.DeleteByCourse", nil)
+func (u *AccountUsecase) CancelByCourse(ctx context.Context, courseId uuid.UUID, reason string) error {
+	seg := monitoring.StartTransactionSegment(ctx, "usecase.CancelByCourse", nil)
 	defer monitoring.EndTransactionSegment(seg)
-	return u.Repository.DeleteByCourse(ctx, courseId)
+
+	return u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		accounts, err := u.Repository.FindAllActiveByCourse(ctx, courseId)
+		if err != nil {
+			return err
+		}
+
+		return u.cancelAll(ctx, accounts, reason)
+	})
+}
+
+func (u *AccountUsecase) CancelByStudent(ctx context.Context, studentId uuid.UUID, reason string) error {
+	return u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		accounts, err := u.Repository.FindAllActiveByStudent(ctx, studentId)
+		if err != nil {
+			return err
+		}
+
+		return u.cancelAll(ctx, accounts, reason)
+	})
+}
+
+func (u *AccountUsecase) cancelAll(ctx context.Context, accounts []models.Account, reason string) error {
+	for i := range accounts {
+		if err := u.cancel(ctx, &accounts[i], reason); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (u *AccountUsecase) DeleteByStudent(ctx context.Context, studentId uuid.UUID) error {
-	return u.Repository.DeleteByStudent(ctx, studentId)
+// cancel moves the account to CANCELADO, cancelling the open invoices of the
+// periods not consumed and issuing the final charge of the period in
+// progress, or recording the refund of what was paid in advance. Paid and
+// past invoices are kept. The new status is published like any other, so
+// school-module cancels the enrollment too.
+func (u *AccountUsecase) cancel(ctx context.Context, account *models.Account, reason string) error {
+	now := u.Clock()
+	cal, err := u.HolidayUsecases.Calendar(ctx, now, now)
+	if err != nil {
+		return err
+	}
+
+	invoices, err := u.InvoiceRepository.FindAllByAccount(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	plan, err := models.NewCancellation(account, invoices, reason, cal.Day(account.CreatedAt), cal.Day(now), now)
+	if err != nil {
+		return err
+	}
+
+	cancelled, err := u.InvoiceRepository.Cancel(ctx, plan.Cancelled, uuid.NullUUID{}, now)
+	if err != nil {
+		return err
+	}
+
+	if cancelled != len(plan.Cancelled) {
+		return fmt.Errorf("invoices of account %s changed during its cancellation", account.ID)
+	}
+
+	if plan.FinalInvoice != nil {
+		final := []models.Invoice{*plan.FinalInvoice}
+		if err := generateBoletos(ctx, u.InvoiceRepository, u.BankLayout, final); err != nil {
+			return err
+		}
+
+		if err := u.InvoiceRepository.BulkInsert(ctx, final); err != nil {
+			return err
+		}
+	}
+
+	if err := u.CancellationRepository.Insert(ctx, &plan.Cancellation); err != nil {
+		return err
+	}
+
+	if plan.Refund != nil {
+		if err := u.RefundRepository.Insert(ctx, plan.Refund); err != nil {
+			return err
+		}
+	}
+
+	account.Status = enums.CANCELADO
+	if err := u.Repository.UpdateStatus(ctx, account); err != nil {
+		return err
+	}
+
+	if err := u.AccountProducer.StatusUpdated(ctx, account); err != nil {
+		return err
+	}
+
+	logging.Info(ctx).
+		AddParam("accountID", account.ID).
+		AddParam("reason", reason).
+		AddParam("cancelledInvoices", cancelled).
+		AddParam("proratedValue", plan.Cancellation.ProratedValue).
+		AddParam("finalCharge", plan.Cancellation.FinalCharge).
+		AddParam("refund", plan.Cancellation.Refund).
+		Msg("Account cancelled")
+
+	return nil
 }
@@ -0,0 +1,235 @@
+//go:generate mockgen -source agreement_usecases.go -destination mock/agreement_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/boleto"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/producers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+)
+
+// AgreementUsecases renegotiates overdue invoices of an account.
+type AgreementUsecases interface {
+	GetAllByAccount(ctx context.Context, accountID uuid.UUID) ([]models.AgreementDetail, error)
+	// Create cancels the selected overdue invoices and issues a new plan for
+	// their balance, re-evaluating the account status.
+	Create(ctx context.Context, accountID uuid.UUID, request *models.AgreementRequest) (*models.AgreementDetail, error)
+}
+
+type AgreementUsecase struct {
+	Repository        repositories.AgreementRepository
+	AccountRepository repositories.AccountRepository
+	InvoiceRepository repositories.InvoiceRepository
+	HolidayUsecases   HolidayUsecases
+	AccountProducer   producers.AccountProducer
+	LateChargePolicy  models.LateChargePolicy
+	DueDatePolicy     models.DueDatePolicy
+	OverduePolicy     models.OverduePolicy
+	BankLayout        boleto.BankLayout
+	UnitOfWork        transactions.UnitOfWork
+	Clock             func() time.Time
+}
+
+func NewAgreementUsecase() *AgreementUsecase {
+	return &AgreementUsecase{
+		Repository:        repositories.NewAgreementDBRepository(),
+		AccountRepository: repositories.NewAccountDBRepository(),
+		InvoiceRepository: repositories.NewInvoiceDBRepository(),
+		HolidayUsecases:   NewHolidayUsecase(),
+		AccountProducer:   producers.NewAccountProducer(),
+		LateChargePolicy:  newLateChargePolicy(),
+		DueDatePolicy:     newDueDatePolicy(),
+		OverduePolicy:     newOverduePolicy(),
+		BankLayout:        newBankLayout(),
+		UnitOfWork:        transactions.NewSQLUnitOfWork(),
+		Clock:             time.Now,
+	}
+}
+
+func (u *AgreementUsecase) GetAllByAccount(ctx context.Context, accountID uuid.UUID) ([]models.AgreementDetail, error) {
+	account, err := u.AccountRepository.FindById(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, errors.New(exceptions.ErrAccountNotFound)
+	}
+
+	agreements, err := u.Repository.FindAllByAccount(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices, err := u.InvoiceRepository.FindAllByAccount(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]models.AgreementDetail, 0, len(agreements))
+	for _, agreement := range agreements {
+		detail := models.AgreementDetail{Agreement: agreement, Renegotiated: []models.Invoice{}, Invoices: []models.Invoice{}}
+		for _, invoice := range invoices {
+			if invoice.ReplacedBy.Valid && invoice.ReplacedBy.UUID == agreement.ID {
+				detail.Renegotiated = append(detail.Renegotiated, invoice)
+			}
+
+			if invoice.AgreementID.Valid && invoice.AgreementID.UUID == agreement.ID {
+				detail.Invoices = append(detail.Invoices, invoice)
+			}
+		}
+		list = append(list, detail)
+	}
+
+	return list, nil
+}
+
+func (u *AgreementUsecase) Create(ctx context.Context, accountID uuid.UUID, request *models.AgreementRequest) (*models.AgreementDetail, error) {
+	if err := request.Prepare(); err != nil {
+		return nil, err
+	}
+
+	var detail *models.AgreementDetail
+	err := u.UnitOfWork.Execute(ctx, func(ctx context.Context) (err error) {
+		detail, err = u.create(ctx, accountID, request)
+		return err
+	})
+
+	return detail, err
+}
+
+func (u *AgreementUsecase) create(ctx context.Context, accountID uuid.UUID, request *models.AgreementRequest) (*models.AgreementDetail, error) {
+	account, err := u.AccountRepository.FindById(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, errors.New(exceptions.ErrAccountNotFound)
+	}
+
+	now := u.Clock()
+	cal, err := planCalendar(ctx, u.HolidayUsecases, u.DueDatePolicy, now, request.Installments)
+	if err != nil {
+		return nil, err
+	}
+
+	renegotiated, err := u.overdueInvoices(ctx, account, request.InvoiceIDs, cal.Day(now))
+	if err != nil {
+		return nil, err
+	}
+
+	agreement := models.NewAgreement(account, renegotiated, request, u.LateChargePolicy, now)
+	if agreement.IsDownPaymentTooHigh() {
+		return nil, errors.New(exceptions.ErrAgreementDownPaymentTooHigh)
+	}
+
+	invoices := agreement.Plan(account, cal.NextBusinessDay(cal.Day(now)), u.DueDatePolicy.DueDates(now, agreement.Installments, cal))
+	if err := generateBoletos(ctx, u.InvoiceRepository, u.BankLayout, invoices); err != nil {
+		return nil, err
+	}
+
+	if err := u.Repository.Insert(ctx, agreement); err != nil {
+		return nil, err
+	}
+
+	// Invoices paid since they were read are not cancelled, which rolls the
+	// agreement back instead of renegotiating a settled balance.
+	cancelled, err := u.InvoiceRepository.Cancel(ctx, request.InvoiceIDs, uuid.NullUUID{UUID: agreement.ID, Valid: true}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if cancelled != len(renegotiated) {
+		return nil, errors.New(exceptions.ErrAgreementInvoiceNotOverdue)
+	}
+
+	if err := u.InvoiceRepository.BulkInsert(ctx, invoices); err != nil {
+		return nil, err
+	}
+
+	// The debt left by a cancelled enrollment may be renegotiated, but the
+	// account stays cancelled and school is not notified.
+	if account.Status != enums.CANCELADO {
+		if err := u.refreshStatus(ctx, account, now); err != nil {
+			return nil, err
+		}
+
+		if err := u.AccountProducer.Renegotiated(ctx, account, agreement); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range renegotiated {
+		renegotiated[i].CancelledAt = types.NullDateTime{Time: now, Valid: true}
+		renegotiated[i].ReplacedBy = uuid.NullUUID{UUID: agreement.ID, Valid: true}
+	}
+
+	logging.Info(ctx).
+		AddParam("accountID", account.ID).
+		AddParam("agreementID", agreement.ID).
+		AddParam("renegotiated", len(renegotiated)).
+		AddParam("value", agreement.Value).
+		AddParam("status", account.Status).
+		Msg("Overdue invoices renegotiated")
+
+	return &models.AgreementDetail{Agreement: *agreement, Renegotiated: renegotiated, Invoices: invoices}, nil
+}
+
+// overdueInvoices returns the invoices of the account with the given ids,
+// all of them open and due before today.
+func (u *AgreementUsecase) overdueInvoices(ctx context.Context, account *models.Account, ids []uuid.UUID, today time.Time) ([]models.Invoice, error) {
+	invoices, err := u.InvoiceRepository.FindAllByAccount(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]models.Invoice, len(invoices))
+	for _, invoice := range invoices {
+		byID[invoice.ID] = invoice
+	}
+
+	overdue := make([]models.Invoice, 0, len(ids))
+	for _, id := range ids {
+		invoice, ok := byID[id]
+		if !ok || !invoice.IsOpen() || !calendar.Date(invoice.DueDate).Before(today) {
+			return nil, errors.New(exceptions.ErrAgreementInvoiceNotOverdue)
+		}
+		overdue = append(overdue, invoice)
+	}
+
+	return overdue, nil
+}
+
+// refreshStatus evaluates the account with the invoices left open by the
+// agreement, which keeps it INADIMPLENTE when other overdue invoices were not
+// renegotiated.
+func (u *AgreementUsecase) refreshStatus(ctx context.Context, account *models.Account, now time.Time) error {
+	oldestOpenDueDate, err := u.InvoiceRepository.FindOldestOpenDueDateByAccount(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	if oldestOpenDueDate == nil {
+		oldestOpenDueDate = &types.NullDateTime{}
+	}
+
+	status := u.OverduePolicy.Evaluate(*oldestOpenDueDate, now)
+	if account.Status == status {
+		return nil
+	}
+
+	account.Status = status
+	return u.AccountRepository.UpdateStatus(ctx, account)
+}
@@ -0,0 +1,34 @@
+package usecases
+
+import (
+	"context"
+	"os"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/boleto"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+)
+
+// newBankLayout returns the boleto layout configured for the environment, or
+// nil when boletos are disabled (BOLETO_BANK_CODE unset) or misconfigured.
+func newBankLayout() boleto.BankLayout {
+	bankCode := os.Getenv("BOLETO_BANK_CODE")
+	if bankCode == "" {
+		return nil
+	}
+
+	layout, err := boleto.NewBankLayout(bankCode, map[string]string{
+		"agreement": os.Getenv("BOLETO_AGREEMENT"),
+		"agency":    os.Getenv("BOLETO_AGENCY"),
+		"account":   os.Getenv("BOLETO_ACCOUNT"),
+		"wallet":    os.Getenv("BOLETO_WALLET"),
+	})
+	if err != nil {
+		logging.Error(context.Background()).
+			Err(err).
+			AddParam("bankCode", bankCode).
+			Msg("Invalid boleto configuration, boletos disabled")
+		return nil
+	}
+
+	return layout
+}
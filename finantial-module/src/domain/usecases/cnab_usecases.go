@@ -0,0 +1,403 @@
+//go:generate mockgen -source cnab_usecases.go -destination mock/cnab_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/boleto"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/cnab"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/files"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/google/uuid"
+)
+
+const (
+	remittanceExtension = ".REM"
+	remittancesFolder   = "cnab/remittances"
+)
+
+type CnabUsecases interface {
+	ExportRemittance(ctx context.Context, format cnab.Format) (*models.CnabFile, error)
+	GetRemittance(ctx context.Context, name string) (*models.CnabFile, error)
+	ImportReturn(ctx context.Context, data []byte) (*models.CnabImportReport, error)
+}
+
+type CnabUsecase struct {
+	InvoiceRepository    repositories.InvoiceRepository
+	PayerRepository      repositories.PayerRepository
+	SettlementRepository repositories.CnabSettlementRepository
+	PaymentUsecases      PaymentUsecases
+	BankLayout           boleto.BankLayout
+	Company              cnab.Company
+	FileStorage          files.FileStorage
+	UnitOfWork           transactions.UnitOfWork
+}
+
+func NewCnabUsecase() *CnabUsecase {
+	return &CnabUsecase{
+		InvoiceRepository:    repositories.NewInvoiceDBRepository(),
+		PayerRepository:      repositories.NewPayerDBRepository(),
+		SettlementRepository: repositories.NewCnabSettlementDBRepository(),
+		PaymentUsecases:      NewPaymentUsecase(),
+		BankLayout:           newBankLayout(),
+		Company: cnab.Company{
+			Name:      os.Getenv("CNAB_COMPANY_NAME"),
+			Document:  os.Getenv("CNAB_COMPANY_DOCUMENT"),
+			BankName:  os.Getenv("CNAB_BANK_NAME"),
+			Agency:    os.Getenv("BOLETO_AGENCY"),
+			Account:   os.Getenv("BOLETO_ACCOUNT"),
+			Agreement: os.Getenv("BOLETO_AGREEMENT"),
+			Wallet:    os.Getenv("BOLETO_WALLET"),
+		},
+		FileStorage: files.NewCloudFileStorage(os.Getenv("CNAB_BUCKET")),
+		UnitOfWork:  transactions.NewSQLUnitOfWork(),
+	}
+}
+
+// ExportRemittance writes a remittance file with every open invoice whose
+// boleto was not yet sent to the bank, and marks those invoices as remitted.
+// Banks reject boletos without the payer's name and CPF/CNPJ, so invoices of
+// students with no payer registered are left pending for a later export. The
+// file also writes off the boletos of the invoices cancelled after they were
+// remitted.
+// The invoices stay locked until the file is stored, so concurrent exports
+// never remit the same boleto twice. The file returned is a temporary copy
+// the caller must remove.
+func (u *CnabUsecase) ExportRemittance(ctx context.Context, format cnab.Format) (*models.CnabFile, error) {
+	if u.BankLayout == nil {
+		return nil, errors.New(exceptions.ErrBoletoNotConfigured)
+	}
+
+	var file *models.CnabFile
+	var content []byte
+	err := u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		file, content, err = u.exportRemittance(ctx, format)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if file.Path, err = writeTempFile(content); err != nil {
+		return nil, err
+	}
+
+	logging.Info(ctx).
+		AddParam("file", file.Name).
+		AddParam("format", int(format)).
+		AddParam("records", file.Records).
+		AddParam("skipped", file.Skipped).
+		AddParam("writeOffs", file.WriteOffs).
+		Msg("CNAB remittance exported")
+
+	return file, nil
+}
+
+func (u *CnabUsecase) exportRemittance(ctx context.Context, format cnab.Format) (*models.CnabFile, []byte, error) {
+	invoices, err := u.InvoiceRepository.FindAllPendingRemittanceForUpdate(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	writeOffs, err := u.InvoiceRepository.FindAllPendingWriteOffForUpdate(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(invoices) == 0 && len(writeOffs) == 0 {
+		return nil, nil, errors.New(exceptions.ErrCnabNothingToRemit)
+	}
+
+	titles, ids, err := u.entryTitles(ctx, invoices)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(titles) == 0 && len(writeOffs) == 0 {
+		return nil, nil, errors.New(exceptions.ErrCnabPayerMissing)
+	}
+
+	// Boletos of invoices cancelled after they were sent to the bank are
+	// written off, so the bank stops collecting them.
+	writeOffIDs := make([]uuid.UUID, 0, len(writeOffs))
+	for _, invoice := range writeOffs {
+		title := newTitle(invoice)
+		title.Instruction = cnab.InstructionWriteOff
+		titles = append(titles, title)
+		writeOffIDs = append(writeOffIDs, invoice.ID)
+	}
+
+	sequence, err := u.InvoiceRepository.NextRemittanceSequence(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	company := u.Company
+	company.BankCode = u.BankLayout.BankCode()
+
+	remittance := cnab.Remittance{
+		Format:    format,
+		Sequence:  *sequence,
+		CreatedAt: time.Now(),
+		Company:   company,
+		Titles:    titles,
+	}
+
+	content, err := cnab.WriteRemittance(remittance)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file := &models.CnabFile{
+		Name:      fmt.Sprintf("CB%s%06d%s", remittance.CreatedAt.Format("0201"), remittance.Sequence, remittanceExtension),
+		Records:   len(remittance.Titles),
+		Skipped:   len(invoices) - len(ids),
+		WriteOffs: len(writeOffIDs),
+	}
+
+	if len(ids) > 0 {
+		if err := u.InvoiceRepository.MarkAsRemitted(ctx, ids); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(writeOffIDs) > 0 {
+		if err := u.InvoiceRepository.MarkAsWrittenOff(ctx, writeOffIDs); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Storing the file is the last step, so a failure rolls the invoices back
+	// to pending remittance.
+	if err := u.FileStorage.Upload(ctx, path.Join(remittancesFolder, file.Name), content); err != nil {
+		return nil, nil, err
+	}
+
+	return file, content, nil
+}
+
+// entryTitles registers the boletos not yet sent to the bank, returning their
+// titles and the invoices they belong to. Banks reject boletos without the
+// payer's name and CPF/CNPJ, so invoices of students with no payer
+// registered are left out.
+func (u *CnabUsecase) entryTitles(ctx context.Context, invoices []models.Invoice) ([]cnab.Title, []uuid.UUID, error) {
+	titles := make([]cnab.Title, 0, len(invoices))
+	ids := make([]uuid.UUID, 0, len(invoices))
+	if len(invoices) == 0 {
+		return titles, ids, nil
+	}
+
+	payers, err := u.findPayers(ctx, invoices)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, invoice := range invoices {
+		payer, ok := payers[invoice.Account.StudentID]
+		if !ok {
+			logging.Warn(ctx).
+				AddParam("invoiceID", invoice.ID).
+				AddParam("studentID", invoice.Account.StudentID).
+				Msg("Invoice left out of the CNAB remittance: student has no payer registered")
+			continue
+		}
+
+		title := newTitle(invoice)
+		title.PayerDocument = payer.Document
+		title.PayerName = payer.Name
+		titles = append(titles, title)
+		ids = append(ids, invoice.ID)
+	}
+
+	return titles, ids, nil
+}
+
+func newTitle(invoice models.Invoice) cnab.Title {
+	return cnab.Title{
+		OurNumber: invoice.Boleto.OurNumber,
+		Reference: strings.ReplaceAll(invoice.ID.String(), "-", "")[:25],
+		DueDate:   invoice.DueDate,
+		IssuedAt:  invoice.CreatedAt,
+		Value:     invoice.Value,
+	}
+}
+
+// findPayers returns the payers of the invoices, by student.
+func (u *CnabUsecase) findPayers(ctx context.Context, invoices []models.Invoice) (map[uuid.UUID]models.Payer, error) {
+	studentIDs := make([]uuid.UUID, 0, len(invoices))
+	for _, invoice := range invoices {
+		studentIDs = append(studentIDs, invoice.Account.StudentID)
+	}
+
+	list, err := u.PayerRepository.FindAllByStudents(ctx, studentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	payers := make(map[uuid.UUID]models.Payer, len(list))
+	for _, payer := range list {
+		payers[payer.StudentID] = payer
+	}
+
+	return payers, nil
+}
+
+// GetRemittance copies a previously exported remittance file to a temporary
+// file the caller must remove.
+func (u *CnabUsecase) GetRemittance(ctx context.Context, name string) (*models.CnabFile, error) {
+	if name != path.Base(name) || !strings.HasSuffix(name, remittanceExtension) {
+		return nil, errors.New(exceptions.ErrCnabRemittanceNotFound)
+	}
+
+	file, err := u.FileStorage.Download(ctx, path.Join(remittancesFolder, name))
+	if err != nil {
+		return nil, err
+	}
+
+	if file == nil {
+		return nil, errors.New(exceptions.ErrCnabRemittanceNotFound)
+	}
+
+	return &models.CnabFile{Name: name, Path: file.Name()}, nil
+}
+
+// ImportReturn settles the invoices paid according to the return file. Every
+// record that cannot be applied is listed in the report instead of aborting
+// the import, and the settlements applied by an earlier import of the same
+// lines are skipped, so a file can be imported again safely.
+func (u *CnabUsecase) ImportReturn(ctx context.Context, data []byte) (*models.CnabImportReport, error) {
+	file, err := cnab.ParseReturn(data)
+	if err != nil {
+		logging.Error(ctx).Err(err).Msg("Invalid CNAB return file")
+		return nil, errors.New(exceptions.ErrCnabInvalidReturnFile)
+	}
+
+	bankCode := file.BankCode
+	if bankCode == "" && u.BankLayout != nil {
+		bankCode = u.BankLayout.BankCode()
+	}
+
+	report := &models.CnabImportReport{
+		Format:       int(file.Format),
+		TotalRecords: len(file.Records),
+		Errors:       []models.CnabLineError{},
+	}
+
+	for _, lineErr := range file.Errors {
+		report.Errors = append(report.Errors, models.CnabLineError{Line: lineErr.Line, Message: lineErr.Message})
+	}
+
+	for _, record := range file.Records {
+		applied, err := u.applyReturnRecord(ctx, bankCode, record)
+		if err != nil {
+			report.Errors = append(report.Errors, models.CnabLineError{
+				Line:      record.Line,
+				OurNumber: record.OurNumber,
+				Message:   err.Error(),
+			})
+			continue
+		}
+
+		switch {
+		case !record.IsSettlement():
+			report.Ignored++
+		case !applied:
+			report.Duplicated++
+		default:
+			report.Settled++
+		}
+	}
+
+	logging.Info(ctx).
+		AddParam("format", report.Format).
+		AddParam("records", report.TotalRecords).
+		AddParam("settled", report.Settled).
+		AddParam("duplicated", report.Duplicated).
+		AddParam("errors", len(report.Errors)).
+		Msg("CNAB return imported")
+
+	return report, nil
+}
+
+// applyReturnRecord reports whether the settlement was applied, false
+// meaning an earlier import already applied it.
+func (u *CnabUsecase) applyReturnRecord(ctx context.Context, bankCode string, record cnab.ReturnRecord) (bool, error) {
+	if record.IsRejection() {
+		return false, errors.New(exceptions.ErrCnabEntryRejected)
+	}
+
+	if !record.IsSettlement() {
+		return false, nil
+	}
+
+	invoice, err := u.InvoiceRepository.FindByOurNumber(ctx, bankCode, record.OurNumber)
+	if err != nil {
+		return false, err
+	}
+
+	if invoice == nil {
+		return false, errors.New(exceptions.ErrCnabReturnInvoiceMissing)
+	}
+
+	settlement := &models.CnabSettlement{
+		BankCode:   bankCode,
+		OurNumber:  record.OurNumber,
+		OccurredAt: record.OccurredAt,
+		PaidValue:  record.PaidValue,
+		InvoiceID:  invoice.ID,
+	}
+
+	applied := false
+	err = u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		// The settlement is recorded in the payment transaction, so a line is
+		// marked as applied only when its payment is committed.
+		inserted, err := u.SettlementRepository.Insert(ctx, settlement)
+		if err != nil || !inserted {
+			return err
+		}
+
+		payment := &models.Payment{
+			Value:  record.PaidValue,
+			Method: enums.BOLETO,
+			PaidAt: record.OccurredAt,
+		}
+
+		// The bank has already collected the money, so the payment is
+		// registered even when it exceeds what is due.
+		if err := u.PaymentUsecases.Collect(ctx, invoice.ID, payment); err != nil {
+			return err
+		}
+
+		applied = true
+		return nil
+	})
+
+	return applied, err
+}
+
+func writeTempFile(content []byte) (string, error) {
+	file, err := os.CreateTemp("", "cnab-*"+remittanceExtension)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if _, err := file.Write(content); err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+
+	return file.Name(), nil
+}
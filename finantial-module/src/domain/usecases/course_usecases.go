@@ -0,0 +1,48 @@
+//go:generate mockgen -source course_usecases.go -destination mock/course_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/google/uuid"
+)
+
+// CourseUsecases maintains the course catalog from the school course events.
+// Deleting a course also deletes its accounts.
+type CourseUsecases interface {
+	Save(ctx context.Context, model *models.Course) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type CourseUsecase struct {
+	AccountUsecases AccountUsecases
+	Repository      repositories.CourseRepository
+}
+
+func NewCourseUsecase() *CourseUsecase {
+	return &CourseUsecase{
+		AccountUsecases: NewAccountUsecase(),
+		Repository:      repositories.NewCourseDBRepository(),
+	}
+}
+
+func (u *CourseUsecase) Save(ctx context.Context, model *models.Course) error {
+	logging.Info(ctx).
+		AddParam("courseID", model.ID).
+		AddParam("value", model.Value).
+		Msg("Saving course price")
+
+	return u.Repository.Upsert(ctx, model)
+}
+
+func (u *CourseUsecase) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := u.Repository.Delete(ctx, id, time.Now()); err != nil {
+		return err
+	}
+
+	return u.AccountUsecases.CancelByCourse(ctx, id, models.CancellationCourseDeleted)
+}
@@ -0,0 +1,134 @@
+//go:generate mockgen -source dead_letter_usecases.go -destination mock/dead_letter_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultDeadLettersLimit = 50
+	maxDeadLettersLimit     = 500
+)
+
+type DeadLetterUsecases interface {
+	GetAll(ctx context.Context, filter *deadletters.Filter) ([]deadletters.DeadLetter, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*deadletters.DeadLetter, error)
+	Replay(ctx context.Context, id uuid.UUID) (*deadletters.DeadLetter, error)
+	Discard(ctx context.Context, id uuid.UUID) error
+}
+
+type DeadLetterUsecase struct {
+	Repository deadletters.Repository
+	Consumers  map[string]messaging.QueueConsumer
+}
+
+// NewDeadLetterUsecase receives the consumers used to replay the messages,
+// found by the queue each dead letter came from.
+func NewDeadLetterUsecase(consumers ...messaging.QueueConsumer) *DeadLetterUsecase {
+	byQueue := make(map[string]messaging.QueueConsumer, len(consumers))
+	for _, consumer := range consumers {
+		byQueue[consumer.QueueName()] = consumer
+	}
+
+	return &DeadLetterUsecase{
+		Repository: deadletters.NewDBRepository(),
+		Consumers:  byQueue,
+	}
+}
+
+func (u *DeadLetterUsecase) GetAll(ctx context.Context, filter *deadletters.Filter) ([]deadletters.DeadLetter, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultDeadLettersLimit
+	}
+	filter.Limit = min(filter.Limit, maxDeadLettersLimit)
+
+	return u.Repository.FindAll(ctx, filter)
+}
+
+func (u *DeadLetterUsecase) GetByID(ctx context.Context, id uuid.UUID) (*deadletters.DeadLetter, error) {
+	model, err := u.Repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if model == nil {
+		return nil, errors.New(exceptions.ErrDeadLetterNotFound)
+	}
+
+	return model, nil
+}
+
+// Replay consumes the message once more. When it fails again the dead letter
+// stays pending with the new error.
+func (u *DeadLetterUsecase) Replay(ctx context.Context, id uuid.UUID) (*deadletters.DeadLetter, error) {
+	model, err := u.getPending(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	consumer, ok := u.Consumers[model.Queue]
+	if !ok {
+		return nil, errors.New(exceptions.ErrDeadLetterQueueNotFound)
+	}
+
+	replayCtx := ctx
+	if model.CorrelationID != "" {
+		replayCtx = context.WithValue(ctx, logging.CorrelationIDParam, model.CorrelationID)
+	}
+
+	model.Attempts++
+	if err := consumer.Consume(replayCtx, model.ToProviderMessage()); err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("id", model.ID).
+			AddParam("queue", model.Queue).
+			Msg("Could not replay dead letter")
+
+		model.Error = err.Error()
+		if err := u.Repository.UpdateStatus(ctx, model); err != nil {
+			return nil, err
+		}
+
+		return model, errors.New(exceptions.ErrDeadLetterReplayFailed)
+	}
+
+	return model, u.resolve(ctx, model, deadletters.REPLAYED)
+}
+
+func (u *DeadLetterUsecase) Discard(ctx context.Context, id uuid.UUID) error {
+	model, err := u.getPending(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return u.resolve(ctx, model, deadletters.DISCARDED)
+}
+
+func (u *DeadLetterUsecase) getPending(ctx context.Context, id uuid.UUID) (*deadletters.DeadLetter, error) {
+	model, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if model.Status != deadletters.PENDING {
+		return nil, errors.New(exceptions.ErrDeadLetterAlreadyResolved)
+	}
+
+	return model, nil
+}
+
+func (u *DeadLetterUsecase) resolve(ctx context.Context, model *deadletters.DeadLetter, status deadletters.Status) error {
+	model.Status = status
+	model.ResolvedAt = types.NullDateTime{Time: time.Now(), Valid: true}
+
+	return u.Repository.UpdateStatus(ctx, model)
+}
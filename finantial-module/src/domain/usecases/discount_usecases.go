@@ -0,0 +1,56 @@
+//go:generate mockgen -source discount_usecases.go -destination mock/discount_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/google/uuid"
+)
+
+// DiscountUsecases manages the discounts granted to students. Discounts are
+// applied when the invoices of an account are created, so granting or
+// removing one does not change invoices already issued.
+type DiscountUsecases interface {
+	GetByStudent(ctx context.Context, studentID uuid.UUID) ([]models.Discount, error)
+	Create(ctx context.Context, model *models.Discount) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type DiscountUsecase struct {
+	Repository repositories.DiscountRepository
+}
+
+func NewDiscountUsecase() *DiscountUsecase {
+	return &DiscountUsecase{
+		Repository: repositories.NewDiscountDBRepository(),
+	}
+}
+
+func (u *DiscountUsecase) GetByStudent(ctx context.Context, studentID uuid.UUID) ([]models.Discount, error) {
+	return u.Repository.FindByStudent(ctx, studentID)
+}
+
+func (u *DiscountUsecase) Create(ctx context.Context, model *models.Discount) error {
+	if err := model.Prepare(); err != nil {
+		return err
+	}
+
+	return u.Repository.Insert(ctx, model)
+}
+
+func (u *DiscountUsecase) Delete(ctx context.Context, id uuid.UUID) error {
+	deleted, err := u.Repository.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return errors.New(exceptions.ErrDiscountNotFound)
+	}
+
+	return nil
+}
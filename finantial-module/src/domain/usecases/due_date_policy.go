@@ -0,0 +1,38 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+)
+
+const (
+	dueDateRuleEnv = "DUE_DATE_RULE"
+	dueDateDayEnv  = "DUE_DATE_DAY"
+	dueDateDaysEnv = "DUE_DATE_DAYS_AFTER_ENROLLMENT"
+
+	defaultDueDateDay  = 10
+	defaultDueDateDays = 30
+	maxDueDateDay      = 31
+)
+
+// newDueDatePolicy reads the due date policy from the environment, falling
+// back to the first installment 30 days after the enrollment.
+func newDueDatePolicy() models.DueDatePolicy {
+	rule := enums.DueDateRule(envOrDefault(dueDateRuleEnv, string(enums.DAYS_AFTER_ENROLLMENT)))
+	if !rule.IsValid() {
+		logging.Warn(context.Background()).
+			AddParam("env", dueDateRuleEnv).
+			AddParam("value", rule).
+			Msg("Invalid due date rule, using default")
+		rule = enums.DAYS_AFTER_ENROLLMENT
+	}
+
+	return models.DueDatePolicy{
+		Rule: rule,
+		Day:  min(positiveIntFromEnv(dueDateDayEnv, defaultDueDateDay), maxDueDateDay),
+		Days: positiveIntFromEnv(dueDateDaysEnv, defaultDueDateDays),
+	}
+}
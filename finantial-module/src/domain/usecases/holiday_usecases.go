@@ -0,0 +1,122 @@
+//go:generate mockgen -source holiday_usecases.go -destination mock/holiday_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+)
+
+const defaultCalendarTimezone = "America/Sao_Paulo"
+
+type HolidayUsecases interface {
+	// GetAll returns the national and registered holidays of the year.
+	GetAll(ctx context.Context, year int) ([]models.Holiday, error)
+	Create(ctx context.Context, model *models.Holiday) error
+	Delete(ctx context.Context, date time.Time) error
+	// Calendar returns the business day calendar with the holidays registered
+	// from one day to the other.
+	Calendar(ctx context.Context, from, to time.Time) (*calendar.Calendar, error)
+}
+
+type HolidayUsecase struct {
+	Repository repositories.HolidayRepository
+	Location   *time.Location
+	Clock      func() time.Time
+}
+
+func NewHolidayUsecase() *HolidayUsecase {
+	location, err := time.LoadLocation(envOrDefault("CALENDAR_TIMEZONE", defaultCalendarTimezone))
+	if err != nil {
+		logging.Error(context.Background()).Err(err).Msg("Invalid calendar timezone, using UTC")
+		location = time.UTC
+	}
+
+	return &HolidayUsecase{
+		Repository: repositories.NewHolidayDBRepository(),
+		Location:   location,
+		Clock:      time.Now,
+	}
+}
+
+func (u *HolidayUsecase) GetAll(ctx context.Context, year int) ([]models.Holiday, error) {
+	if year <= 0 {
+		year = u.Clock().In(u.Location).Year()
+	}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	registered, err := u.Repository.FindBetween(ctx, from, from.AddDate(1, 0, -1))
+	if err != nil {
+		return nil, err
+	}
+
+	list := []models.Holiday{}
+	for _, holiday := range calendar.NationalHolidays(year) {
+		list = append(list, models.Holiday{Date: types.IsoDate(holiday.Date), Name: holiday.Name, National: true})
+	}
+	for _, holiday := range registered {
+		list = append(list, models.Holiday{Date: types.IsoDate(calendar.Date(holiday.Date)), Name: holiday.Name})
+	}
+
+	slices.SortStableFunc(list, func(a, b models.Holiday) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	return list, nil
+}
+
+func (u *HolidayUsecase) Create(ctx context.Context, model *models.Holiday) error {
+	if err := model.Prepare(); err != nil {
+		return err
+	}
+
+	inserted, err := u.Repository.Insert(ctx, model)
+	if err != nil {
+		return err
+	}
+
+	if !inserted {
+		return errors.New(exceptions.ErrHolidayAlreadyExists)
+	}
+
+	return nil
+}
+
+func (u *HolidayUsecase) Delete(ctx context.Context, date time.Time) error {
+	deleted, err := u.Repository.Delete(ctx, date)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return errors.New(exceptions.ErrHolidayNotFound)
+	}
+
+	return nil
+}
+
+func (u *HolidayUsecase) Calendar(ctx context.Context, from, to time.Time) (*calendar.Calendar, error) {
+	registered, err := u.Repository.FindBetween(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return calendar.New(u.Location, registered), nil
+}
+
+func envOrDefault(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+
+	return fallback
+}
@@ -0,0 +1,47 @@
+//go:generate mockgen -source inbox_usecases.go -destination mock/inbox_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/inbox"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+)
+
+const defaultInboxRetentionDays = 30
+
+type InboxUsecases interface {
+	Purge(ctx context.Context) error
+}
+
+type InboxUsecase struct {
+	Repository    inbox.Repository
+	RetentionDays int
+}
+
+func NewInboxUsecase() *InboxUsecase {
+	retentionDays, err := strconv.Atoi(os.Getenv("INBOX_RETENTION_DAYS"))
+	if err != nil || retentionDays <= 0 {
+		retentionDays = defaultInboxRetentionDays
+	}
+
+	return &InboxUsecase{
+		Repository:    inbox.NewDBRepository(),
+		RetentionDays: retentionDays,
+	}
+}
+
+// Purge removes the inbox entries older than the retention period. Messages
+// redelivered after that are no longer recognized as duplicates.
+func (u *InboxUsecase) Purge(ctx context.Context) error {
+	before := time.Now().AddDate(0, 0, -u.RetentionDays)
+	if err := u.Repository.DeleteReceivedBefore(ctx, before); err != nil {
+		return err
+	}
+
+	logging.Info(ctx).AddParam("before", before).Msg("Inbox purged")
+	return nil
+}
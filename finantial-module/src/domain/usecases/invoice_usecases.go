@@ -4,102 +4,524 @@ package usecases
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/boleto"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/clients"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/producers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/qrcode"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/monitoring"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
 	"github.com/google/uuid"
 )
 
 type InvoiceUsecases interface {
-	GetAll(ctx context.Context) ([]models.Invoice, error)
+	GetAll(ctx context.Context) ([]models.InvoiceDetail, error)
+	GetById(ctx context.Context, id uuid.UUID, date time.Time) (*models.InvoiceDetail, error)
 	Create(ctx context.Context, model *models.Account) error
 	ProcessAllOverdueInvoices(ctx context.Context) error
-	UpdatePaymentDate(ctx context.Context, id uuid.UUID, paymentDate time.Time) error
+	ProcessOverdueAccounts(ctx context.Context) (*models.OverdueReport, error)
+	RefreshAccountStatus(ctx context.Context, account *models.Account) error
+	GeneratePix(ctx context.Context, id uuid.UUID) (*models.PixCharge, error)
+	GetBoleto(ctx context.Context, id uuid.UUID) (*models.Boleto, error)
 }
 
 type InvoiceUsecase struct {
-	InvoiceRepository repositories.InvoiceRepository
-	AccountRepository repositories.AccountRepository
-	AccountProducer   producers.AccountProducer
+	InvoiceRepository  repositories.InvoiceRepository
+	HolidayUsecases    HolidayUsecases
+	DiscountRepository repositories.DiscountRepository
+	AccountRepository  repositories.AccountRepository
+	AccountProducer    producers.AccountProducer
+	LateChargePolicy   models.LateChargePolicy
+	DueDatePolicy      models.DueDatePolicy
+	OverduePolicy      models.OverduePolicy
+	// OverdueBatchSize is the number of accounts evaluated by each statement
+	// of the overdue processing.
+	OverdueBatchSize int
+	PixMerchant      models.PixMerchant
+	PixClient        clients.PixClient
+	QRCodeGenerator  qrcode.QRCodeGenerator
+	BankLayout       boleto.BankLayout
+	UnitOfWork       transactions.UnitOfWork
+	Clock            func() time.Time
 }
 
 func NewInvoiceUsecase() *InvoiceUsecase {
 	return &InvoiceUsecase{
-		InvoiceRepository: repositories.NewInvoiceDBRepository(),
-		AccountRepository: repositories.NewAccountDBRepository(),
-		AccountProducer:   producers.NewAccountProducer(),
+		InvoiceRepository:  repositories.NewInvoiceDBRepository(),
+		HolidayUsecases:    NewHolidayUsecase(),
+		DiscountRepository: repositories.NewDiscountDBRepository(),
+		AccountRepository:  repositories.NewAccountDBRepository(),
+		AccountProducer:    producers.NewAccountProducer(),
+		LateChargePolicy:   newLateChargePolicy(),
+		DueDatePolicy:      newDueDatePolicy(),
+		OverduePolicy:      newOverduePolicy(),
+		OverdueBatchSize:   positiveIntFromEnv(overdueBatchSizeEnv, defaultOverdueBatchSize),
+		PixMerchant:        newPixMerchant(),
+		PixClient:          newPixClient(),
+		QRCodeGenerator:    qrcode.NewPNGQRCodeGenerator(),
+		BankLayout:         newBankLayout(),
+		UnitOfWork:         transactions.NewSQLUnitOfWork(),
+		Clock:              time.Now,
 	}
 }
 
-func (u *InvoiceUsecase) GetAll(ctx context.Context) ([]models.Invoice, error) {
-	return u.InvoiceRepository.FindAll(ctx)
+func (u *InvoiceUsecase) GetAll(ctx context.Context) ([]models.InvoiceDetail, error) {
+	invoices, err := u.InvoiceRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.Clock()
+	list := make([]models.InvoiceDetail, 0, len(invoices))
+	for _, invoice := range invoices {
+		list = append(list, models.NewInvoiceDetail(invoice, u.LateChargePolicy, now))
+	}
+
+	if err := u.loadDiscounts(ctx, list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (u *InvoiceUsecase) GetById(ctx context.Context, id uuid.UUID, date time.Time) (*models.InvoiceDetail, error) {
+	invoice, err := u.InvoiceRepository.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice == nil {
+		return nil, errors.New(exceptions.ErrInvoiceNotFound)
+	}
+
+	list := []models.InvoiceDetail{models.NewInvoiceDetail(*invoice, u.LateChargePolicy, date)}
+	if err := u.loadDiscounts(ctx, list); err != nil {
+		return nil, err
+	}
+
+	return &list[0], nil
+}
+
+// loadDiscounts fills the discount breakdown of the invoices.
+func (u *InvoiceUsecase) loadDiscounts(ctx context.Context, list []models.InvoiceDetail) error {
+	ids := make([]uuid.UUID, 0, len(list))
+	for i := range list {
+		list[i].Discounts = []models.InvoiceDiscount{}
+		ids = append(ids, list[i].ID)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	discounts, err := u.DiscountRepository.FindByInvoices(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	byInvoice := make(map[uuid.UUID][]models.InvoiceDiscount, len(list))
+	for _, discount := range discounts {
+		byInvoice[discount.InvoiceID] = append(byInvoice[discount.InvoiceID], discount)
+	}
+
+	for i := range list {
+		if lines, ok := byInvoice[list[i].ID]; ok {
+			list[i].Discounts = lines
+		}
+	}
+
+	return nil
 }
 
 func (u *InvoiceUsecase) Create(ctx context.Context, model *models.Account) error {
+	dueDates, err := u.dueDates(ctx, model)
+	if err != nil {
+		return err
+	}
+
+	discounts, err := u.DiscountRepository.FindByStudentAndCourse(ctx, model.StudentID, model.CourseID)
+	if err != nil {
+		return err
+	}
+
+	values := model.Value.Split(int(model.Installments))
+
 	invoices := []models.Invoice{}
+	invoiceDiscounts := []models.InvoiceDiscount{}
 	for installment := uint8(1); installment <= model.Installments; installment++ {
 		invoice := models.Invoice{
 			ID:          uuid.New(),
 			Account:     *model,
 			Installment: installment,
-			DueDate:     model.CreatedAt.Add(time.Duration(installment) * (30 * (24 * time.Hour))),
-			Value:       model.Value / float64(model.Installments),
-			CreatedAt:   time.Now(),
+			DueDate:     dueDates[installment-1],
+			GrossValue:  values[installment-1],
+			CreatedAt:   u.Clock(),
+		}
+
+		var lines []models.InvoiceDiscount
+		lines, invoice.Value = models.ApplyDiscounts(invoice.GrossValue, invoice.DueDate, discounts)
+		invoice.Discount = invoice.GrossValue - invoice.Value
+		if invoice.Value.IsZero() {
+			// A fully discounted installment has nothing to be paid.
+			invoice.PaidAt = types.NullDateTime{Time: invoice.CreatedAt, Valid: true}
+		}
+		for position, line := range lines {
+			line.InvoiceID = invoice.ID
+			line.Position = uint8(position + 1)
+			invoiceDiscounts = append(invoiceDiscounts, line)
 		}
+
 		invoices = append(invoices, invoice)
 	}
 
-	return u.InvoiceRepository.BulkInsert(ctx, invoices)
+	if err := u.generateBoletos(ctx, invoices); err != nil {
+		return err
+	}
+
+	if err := u.InvoiceRepository.BulkInsert(ctx, invoices); err != nil {
+		return err
+	}
+
+	return u.DiscountRepository.BulkInsertInvoiceDiscounts(ctx, invoiceDiscounts)
 }
 
-func (u *InvoiceUsecase) ProcessAllOverdueInvoices(ctx context.Context) error {
-	result, err := u.InvoiceRepository.FindAllOverdueInvoices(ctx)
+func (u *InvoiceUsecase) dueDates(ctx context.Context, model *models.Account) ([]time.Time, error) {
+	cal, err := planCalendar(ctx, u.HolidayUsecases, u.DueDatePolicy, model.CreatedAt, model.Installments)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.DueDatePolicy.DueDates(model.CreatedAt, model.Installments, cal), nil
+}
+
+// planCalendar returns the calendar with the holidays registered over the
+// months a plan of installments opened at the given instant spans, plus a
+// margin for the days moved forward.
+func planCalendar(ctx context.Context, holidays HolidayUsecases, policy models.DueDatePolicy, openedAt time.Time, installments uint8) (*calendar.Calendar, error) {
+	from := calendar.Date(openedAt)
+	to := from.AddDate(0, int(installments)+2, policy.Days)
+
+	return holidays.Calendar(ctx, from, to)
+}
+
+func (u *InvoiceUsecase) generateBoletos(ctx context.Context, invoices []models.Invoice) error {
+	return generateBoletos(ctx, u.InvoiceRepository, u.BankLayout, invoices)
+}
+
+// generateBoletos issues the boletos of the open invoices, when a bank layout
+// is configured.
+func generateBoletos(ctx context.Context, repository repositories.InvoiceRepository, layout boleto.BankLayout, invoices []models.Invoice) error {
+	open := []*models.Invoice{}
+	for i := range invoices {
+		if invoices[i].IsOpen() {
+			open = append(open, &invoices[i])
+		}
+	}
+
+	if layout == nil || len(open) == 0 {
+		return nil
+	}
+
+	sequences, err := repository.NextOurNumberSequences(ctx, len(open))
 	if err != nil {
 		return err
 	}
 
-	for _, invoice := range result {
-		account := &models.Account{
-			StudentID:    invoice.StudentID,
-			CourseID:     invoice.CourseID,
-			Installments: invoice.Installments,
-			Status:       enums.INADIMPLENTE,
+	for i, invoice := range open {
+		ourNumber, err := layout.OurNumber(sequences[i])
+		if err != nil {
+			return err
 		}
 
-		u.AccountRepository.UpdateStatus(ctx, account)
-		u.AccountProducer.StatusUpdated(ctx, account)
+		if invoice.Boleto, err = boleto.Generate(layout, ourNumber, invoice.DueDate, invoice.Value); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func (u *InvoiceUsecase) UpdatePaymentDate(ctx context.Context, id uuid.UUID, paymentDate time.Time) error {
-	invoice, err := u.InvoiceRepository.FindById(ctx, id)
+// ProcessAllOverdueInvoices runs the overdue processing as a scheduled job.
+// The run fails when any batch failed, so the job records it.
+func (u *InvoiceUsecase) ProcessAllOverdueInvoices(ctx context.Context) error {
+	report, err := u.ProcessOverdueAccounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	logging.Info(ctx).
+		AddParam("cutoff", report.Cutoff).
+		AddParam("scanned", report.Scanned).
+		AddParam("delinquent", report.Delinquent).
+		AddParam("regularized", report.Regularized).
+		AddParam("errors", report.Errors).
+		Msg("Overdue accounts processed")
+
+	if report.Errors > 0 {
+		return fmt.Errorf("%d of %d overdue batches failed", report.Errors, report.Batches)
+	}
+
+	return nil
+}
+
+// ProcessOverdueAccounts moves to INADIMPLENTE the accounts with invoices
+// overdue past the grace period, and back to ADIMPLENTE the ones that no
+// longer have any. Accounts are walked in batches ordered by id, each batch
+// updated by a single statement whose status events are stored in the outbox
+// by the same transaction, so a status never changes without its event. A
+// failed batch is rolled back and reported, and the walk goes on with the
+// next one.
+//
+// The events are not published here but by the outbox relay of every
+// instance, which share the outbox through leases. Publishing them
+// concurrently would let two events of the same account reach the consumers
+// out of order.
+func (u *InvoiceUsecase) ProcessOverdueAccounts(ctx context.Context) (*models.OverdueReport, error) {
+	seg := monitoring.StartTransactionSegment(ctx, "usecase.ProcessOverdueAccounts", nil)
+	defer monitoring.EndTransactionSegment(seg)
+
+	report := &models.OverdueReport{
+		Cutoff:        u.OverduePolicy.Cutoff(u.Clock()),
+		FailedBatches: []models.OverdueBatchFailure{},
+	}
+	for after := uuid.Nil; ; {
+		page, err := u.AccountRepository.FindPageEnd(ctx, after, u.OverdueBatchSize)
+		if err != nil {
+			monitoring.NoticeError(seg, err)
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		report.Batches++
+		report.Scanned += page.Total
+		updated, err := u.updateOverdueBatch(ctx, after, page.LastID, report.Cutoff)
+		if err != nil {
+			monitoring.NoticeError(seg, err)
+			logging.Error(ctx).
+				Err(err).
+				AddParam("after", after).
+				AddParam("lastID", page.LastID).
+				Msg("Could not process overdue accounts batch")
+			report.Errors++
+			report.FailedBatches = append(report.FailedBatches, models.OverdueBatchFailure{
+				After:  after,
+				LastID: page.LastID,
+				Error:  err.Error(),
+			})
+		}
+
+		for _, account := range updated {
+			if account.Status == enums.INADIMPLENTE {
+				report.Delinquent++
+			} else {
+				report.Regularized++
+			}
+		}
+
+		if page.Total < u.OverdueBatchSize {
+			break
+		}
+		after = page.LastID
+	}
+
+	monitoring.AddTransactionAttribute(seg, "batches", strconv.Itoa(report.Batches))
+	monitoring.AddTransactionAttribute(seg, "scanned", strconv.Itoa(report.Scanned))
+	monitoring.AddTransactionAttribute(seg, "delinquent", strconv.Itoa(report.Delinquent))
+	monitoring.AddTransactionAttribute(seg, "regularized", strconv.Itoa(report.Regularized))
+	monitoring.AddTransactionAttribute(seg, "errors", strconv.Itoa(report.Errors))
+	return report, nil
+}
+
+// updateOverdueBatch returns no account when the batch is rolled back.
+func (u *InvoiceUsecase) updateOverdueBatch(ctx context.Context, after, lastID uuid.UUID, cutoff time.Time) ([]models.Account, error) {
+	var updated []models.Account
+	err := u.UnitOfWork.Execute(ctx, func(ctx context.Context) (err error) {
+		if updated, err = u.AccountRepository.UpdateOverdueStatuses(ctx, after, lastID, cutoff); err != nil {
+			return err
+		}
+
+		return u.AccountProducer.BulkStatusUpdated(ctx, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (u *InvoiceUsecase) RefreshAccountStatus(ctx context.Context, account *models.Account) error {
+	oldestOpenDueDate, err := u.InvoiceRepository.FindOldestOpenDueDateByAccount(ctx, account.ID)
 	if err != nil {
 		return err
 	}
 
-	if invoice.ID == uuid.Nil {
-		return errors.New("parcela não encontrada")
+	if oldestOpenDueDate == nil {
+		oldestOpenDueDate = &types.NullDateTime{}
+	}
+
+	return u.updateAccountStatus(ctx, account, u.OverduePolicy.Evaluate(*oldestOpenDueDate, u.Clock()))
+}
+
+// updateAccountStatus leaves cancelled accounts as they are, as paying what
+// they still owe does not reopen them.
+func (u *InvoiceUsecase) updateAccountStatus(ctx context.Context, account *models.Account, status enums.AccountStatus) error {
+	if account.Status == status || account.Status == enums.CANCELADO {
+		return nil
 	}
 
-	invoice.PaidAt.Time = paymentDate
-	if err := u.InvoiceRepository.UpdatePaymentDate(ctx, invoice); err != nil {
+	account.Status = status
+	if err := u.AccountRepository.UpdateStatus(ctx, account); err != nil {
 		return err
 	}
 
-	if invoice.Account.Status == enums.INADIMPLENTE {
-		total, _ := u.InvoiceRepository.FindTotalOverdueInvoicesByAccount(ctx, invoice.Account.ID)
+	return u.AccountProducer.StatusUpdated(ctx, account)
+}
+
+func (u *InvoiceUsecase) GeneratePix(ctx context.Context, id uuid.UUID) (*models.PixCharge, error) {
+	if err := u.PixMerchant.Validate(); err != nil {
+		return nil, err
+	}
+
+	var charge *models.PixCharge
+	var location string
+	err := u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		// Locking the invoice keeps concurrent requests from registering its
+		// charge at the PSP more than once.
+		if _, err := u.InvoiceRepository.FindByIdForUpdate(ctx, id); err != nil {
+			return err
+		}
+
+		now := u.Clock()
+		detail, err := u.GetById(ctx, id, now)
+		if err != nil {
+			return err
+		}
+
+		if detail.IsPaid() {
+			return errors.New(exceptions.ErrInvoiceAlreadyPaid)
+		}
+
+		if detail.IsCancelled() {
+			return errors.New(exceptions.ErrInvoiceCancelled)
+		}
+
+		charge = &models.PixCharge{
+			InvoiceID: detail.ID,
+			TxID:      models.PixTxID(detail.ID),
+			Amount:    detail.AmountDue,
+		}
+
+		if u.PixClient == nil {
+			return nil
+		}
 
-		if *total == 0 {
-			invoice.Account.Status = enums.ADIMPLENTE
-			u.AccountRepository.UpdateStatus(ctx, &invoice.Account)
-			u.AccountProducer.StatusUpdated(ctx, &invoice.Account)
+		registration, err := u.registerPixCharge(ctx, &detail.Invoice, charge.Amount, now)
+		if err != nil {
+			return err
 		}
+		charge.TxID, location = registration.TxID, registration.Location
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	charge.Payload = u.PixMerchant.Payload(charge.Amount, charge.TxID, location)
+	if charge.QRCode, err = u.QRCodeGenerator.Generate(charge.Payload); err != nil {
+		return nil, err
+	}
+
+	return charge, nil
+}
+
+// registerPixCharge creates the invoice charge at the PSP on the first request
+// and afterwards only amends it when the amount due has changed or it is about
+// to expire, extending its expiration, so reading the PIX of an invoice does
+// not recreate the charge every time.
+func (u *InvoiceUsecase) registerPixCharge(ctx context.Context, invoice *models.Invoice, amount models.Money, now time.Time) (*models.PixRegistration, error) {
+	registration := invoice.Pix
+	expired := registration.IsExpired(now)
+	if !registration.IsEmpty() && registration.Amount == amount && !expired {
+		return &registration, nil
+	}
+
+	if registration.IsEmpty() {
+		registration.TxID = models.PixChargeTxID(invoice.ID)
+		registration.CreatedAt = types.NullDateTime{Time: now, Valid: true}
+	}
+	if expired {
+		registration.ExpiresAt = types.NullDateTime{Time: now.Add(models.PixChargeExpiration), Valid: true}
+	}
+
+	var err error
+	if registration.IsEmpty() {
+		registration.Location, err = u.PixClient.CreateCharge(ctx, registration.TxID, amount, u.PixMerchant.Key, registration.Expiration())
+	} else {
+		registration.Location, err = u.PixClient.UpdateCharge(ctx, registration.TxID, amount, registration.Expiration())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	registration.Amount = amount
+	invoice.Pix = registration
+	if err := u.InvoiceRepository.UpdatePix(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	return &registration, nil
+}
+
+// GetBoleto returns the boleto stored for the invoice, generating it for
+// invoices created before boletos were enabled.
+func (u *InvoiceUsecase) GetBoleto(ctx context.Context, id uuid.UUID) (*models.Boleto, error) {
+	invoice, err := u.InvoiceRepository.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice == nil {
+		return nil, errors.New(exceptions.ErrInvoiceNotFound)
+	}
+
+	if invoice.IsCancelled() {
+		return nil, errors.New(exceptions.ErrInvoiceCancelled)
+	}
+
+	if !invoice.Boleto.IsEmpty() {
+		return &invoice.Boleto, nil
+	}
+
+	if invoice.IsPaid() {
+		return nil, errors.New(exceptions.ErrInvoiceAlreadyPaid)
+	}
+
+	if u.BankLayout == nil {
+		return nil, errors.New(exceptions.ErrBoletoNotConfigured)
+	}
+
+	invoices := []models.Invoice{*invoice}
+	if err := u.generateBoletos(ctx, invoices); err != nil {
+		return nil, err
+	}
+
+	if err := u.InvoiceRepository.UpdateBoleto(ctx, &invoices[0]); err != nil {
+		return nil, err
+	}
+
+	return &invoices[0].Boleto, nil
 }
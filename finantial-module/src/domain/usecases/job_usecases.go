@@ -0,0 +1,50 @@
+//go:generate mockgen -source job_usecases.go -destination mock/job_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/scheduler"
+)
+
+const (
+	defaultJobRunsLimit = 50
+	maxJobRunsLimit     = 500
+)
+
+type JobUsecases interface {
+	GetAll(ctx context.Context) []models.Job
+	GetRuns(ctx context.Context, filter *models.JobRunFilter) ([]models.JobRun, error)
+	Trigger(ctx context.Context, name string) (*models.JobRun, error)
+}
+
+type JobUsecase struct {
+	Scheduler  scheduler.Scheduler
+	Repository repositories.JobRunRepository
+}
+
+func NewJobUsecase() *JobUsecase {
+	return &JobUsecase{
+		Scheduler:  scheduler.Instance(),
+		Repository: repositories.NewJobRunDBRepository(),
+	}
+}
+
+func (u *JobUsecase) GetAll(ctx context.Context) []models.Job {
+	return u.Scheduler.Jobs()
+}
+
+func (u *JobUsecase) GetRuns(ctx context.Context, filter *models.JobRunFilter) ([]models.JobRun, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultJobRunsLimit
+	}
+	filter.Limit = min(filter.Limit, maxJobRunsLimit)
+
+	return u.Repository.FindAll(ctx, filter)
+}
+
+func (u *JobUsecase) Trigger(ctx context.Context, name string) (*models.JobRun, error) {
+	return u.Scheduler.Trigger(ctx, name)
+}
@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"context"
+	"os"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+)
+
+const (
+	lateFeePercentageEnv             = "LATE_FEE_PERCENTAGE"
+	lateInterestMonthlyPercentageEnv = "LATE_INTEREST_MONTHLY_PERCENTAGE"
+
+	defaultLateFeePercentage             models.Percentage = 200
+	defaultLateInterestMonthlyPercentage models.Percentage = 100
+)
+
+// newLateChargePolicy reads the late charge policy from the environment,
+// falling back to 2% fee and 1% monthly interest when unset or invalid.
+func newLateChargePolicy() models.LateChargePolicy {
+	return models.LateChargePolicy{
+		FeePercentage:             percentageFromEnv(lateFeePercentageEnv, defaultLateFeePercentage),
+		MonthlyInterestPercentage: percentageFromEnv(lateInterestMonthlyPercentageEnv, defaultLateInterestMonthlyPercentage),
+	}
+}
+
+func percentageFromEnv(name string, fallback models.Percentage) models.Percentage {
+	raw, ok := os.LookupEnv(name)
+	if !ok || raw == "" {
+		return fallback
+	}
+
+	value, err := models.ParsePercentage(raw)
+	if err != nil || value < 0 {
+		logging.Warn(context.Background()).
+			Err(err).
+			AddParam("env", name).
+			AddParam("value", raw).
+			AddParam("fallback", fallback.String()).
+			Msg("Invalid late charge percentage, using default")
+		return fallback
+	}
+
+	return value
+}
@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+)
+
+const (
+	overdueGraceDaysEnv = "OVERDUE_GRACE_DAYS"
+	overdueBatchSizeEnv = "OVERDUE_BATCH_SIZE"
+
+	defaultOverdueBatchSize = 1000
+)
+
+// newOverduePolicy reads the overdue grace period from the environment,
+// falling back to no grace period when unset or invalid.
+func newOverduePolicy() models.OverduePolicy {
+	raw, ok := os.LookupEnv(overdueGraceDaysEnv)
+	if !ok || raw == "" {
+		return models.OverduePolicy{}
+	}
+
+	graceDays, err := strconv.Atoi(raw)
+	if err != nil || graceDays < 0 {
+		logging.Warn(context.Background()).
+			Err(err).
+			AddParam("env", overdueGraceDaysEnv).
+			AddParam("value", raw).
+			Msg("Invalid overdue grace days, using no grace period")
+		return models.OverduePolicy{}
+	}
+
+	return models.OverduePolicy{GraceDays: graceDays}
+}
+
+func positiveIntFromEnv(name string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+
+	return value
+}
@@ -0,0 +1,48 @@
+//go:generate mockgen -source payer_usecases.go -destination mock/payer_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/google/uuid"
+)
+
+type PayerUsecases interface {
+	GetByStudent(ctx context.Context, studentID uuid.UUID) (*models.Payer, error)
+	Save(ctx context.Context, model *models.Payer) error
+}
+
+type PayerUsecase struct {
+	Repository repositories.PayerRepository
+}
+
+func NewPayerUsecase() *PayerUsecase {
+	return &PayerUsecase{
+		Repository: repositories.NewPayerDBRepository(),
+	}
+}
+
+func (u *PayerUsecase) GetByStudent(ctx context.Context, studentID uuid.UUID) (*models.Payer, error) {
+	payer, err := u.Repository.FindByStudent(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payer == nil {
+		return nil, errors.New(exceptions.ErrPayerNotFound)
+	}
+
+	return payer, nil
+}
+
+func (u *PayerUsecase) Save(ctx context.Context, model *models.Payer) error {
+	if err := model.Prepare(); err != nil {
+		return err
+	}
+
+	return u.Repository.Save(ctx, model)
+}
@@ -0,0 +1,133 @@
+//go:generate mockgen -source payment_usecases.go -destination mock/payment_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/google/uuid"
+)
+
+type PaymentUsecases interface {
+	GetAllByInvoice(ctx context.Context, invoiceId uuid.UUID) ([]models.Payment, error)
+	Create(ctx context.Context, invoiceId uuid.UUID, model *models.Payment) error
+	// Collect registers a payment the bank has already collected, which
+	// cannot be refused for exceeding the amount due: the excess is recorded
+	// as overpaid instead.
+	Collect(ctx context.Context, invoiceId uuid.UUID, model *models.Payment) error
+}
+
+type PaymentUsecase struct {
+	InvoiceUsecases   InvoiceUsecases
+	InvoiceRepository repositories.InvoiceRepository
+	Repository        repositories.PaymentRepository
+	LateChargePolicy  models.LateChargePolicy
+	UnitOfWork        transactions.UnitOfWork
+}
+
+func NewPaymentUsecase() *PaymentUsecase {
+	return &PaymentUsecase{
+		InvoiceUsecases:   NewInvoiceUsecase(),
+		InvoiceRepository: repositories.NewInvoiceDBRepository(),
+		Repository:        repositories.NewPaymentDBRepository(),
+		LateChargePolicy:  newLateChargePolicy(),
+		UnitOfWork:        transactions.NewSQLUnitOfWork(),
+	}
+}
+
+func (u *PaymentUsecase) GetAllByInvoice(ctx context.Context, invoiceId uuid.UUID) ([]models.Payment, error) {
+	invoice, err := u.InvoiceRepository.FindById(ctx, invoiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice == nil {
+		return nil, errors.New(exceptions.ErrInvoiceNotFound)
+	}
+
+	return u.Repository.FindAllByInvoice(ctx, invoiceId)
+}
+
+func (u *PaymentUsecase) Create(ctx context.Context, invoiceId uuid.UUID, model *models.Payment) error {
+	return u.register(ctx, invoiceId, model, false)
+}
+
+func (u *PaymentUsecase) Collect(ctx context.Context, invoiceId uuid.UUID, model *models.Payment) error {
+	return u.register(ctx, invoiceId, model, true)
+}
+
+// register validates the payment before the invoice is locked, so an invalid
+// one never opens a transaction.
+func (u *PaymentUsecase) register(ctx context.Context, invoiceId uuid.UUID, model *models.Payment, collected bool) error {
+	model.InvoiceID = invoiceId
+	if err := model.Prepare(); err != nil {
+		logging.Warn(ctx).
+			Err(err).
+			AddParam("invoiceID", invoiceId).
+			Msg("Invalid payment")
+		return errors.New(exceptions.ErrPaymentInvalid)
+	}
+
+	return u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		return u.create(ctx, model, collected)
+	})
+}
+
+func (u *PaymentUsecase) create(ctx context.Context, model *models.Payment, collected bool) error {
+	// The invoice stays locked until the payment is committed, so concurrent
+	// payments see each other's paid value.
+	invoice, err := u.InvoiceRepository.FindByIdForUpdate(ctx, model.InvoiceID)
+	if err != nil {
+		return err
+	}
+
+	if invoice == nil {
+		return errors.New(exceptions.ErrInvoiceNotFound)
+	}
+
+	if invoice.IsPaid() {
+		return errors.New(exceptions.ErrInvoiceAlreadyPaid)
+	}
+
+	if invoice.IsCancelled() {
+		return errors.New(exceptions.ErrInvoiceCancelled)
+	}
+
+	charge := u.LateChargePolicy.Calculate(invoice, model.PaidAt)
+	if amountDue := invoice.AmountDue(charge); model.Value > amountDue {
+		if !collected {
+			return errors.New(exceptions.ErrPaymentExceedsOpenValue)
+		}
+
+		model.Overpaid = model.Value - amountDue
+		logging.Warn(ctx).
+			AddParam("invoiceID", invoice.ID).
+			AddParam("paymentID", model.ID).
+			AddParam("overpaid", model.Overpaid.String()).
+			Msg("Collected payment exceeds the amount due")
+	}
+
+	if err := u.Repository.Insert(ctx, model); err != nil {
+		return err
+	}
+
+	invoice.Pay(model.Value, charge, model.PaidAt)
+
+	if err := u.InvoiceRepository.UpdatePayment(ctx, invoice); err != nil {
+		return err
+	}
+
+	logging.Info(ctx).
+		AddParam("invoiceID", invoice.ID).
+		AddParam("paymentID", model.ID).
+		AddParam("daysLate", charge.DaysLate).
+		AddParam("settled", invoice.IsPaid()).
+		Msg("Payment registered")
+
+	return u.InvoiceUsecases.RefreshAccountStatus(ctx, &invoice.Account)
+}
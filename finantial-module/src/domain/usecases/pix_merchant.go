@@ -0,0 +1,26 @@
+package usecases
+
+import (
+	"os"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/clients"
+)
+
+func newPixMerchant() models.PixMerchant {
+	return models.PixMerchant{
+		Key:  os.Getenv("PIX_KEY"),
+		Name: os.Getenv("PIX_MERCHANT_NAME"),
+		City: os.Getenv("PIX_MERCHANT_CITY"),
+	}
+}
+
+// newPixClient returns the PSP client when PIX_PSP_BASE_URL is set, making
+// BR Codes dynamic, or nil to issue static ones.
+func newPixClient() clients.PixClient {
+	if os.Getenv("PIX_PSP_BASE_URL") == "" {
+		return nil
+	}
+
+	return clients.NewPixRestClient()
+}
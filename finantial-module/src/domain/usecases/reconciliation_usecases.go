@@ -0,0 +1,337 @@
+//go:generate mockgen -source reconciliation_usecases.go -destination mock/reconciliation_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/clients"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/producers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultReconciliationPageSize    = 500
+	defaultReconciliationGracePeriod = 30 * time.Minute
+)
+
+type ReconciliationUsecases interface {
+	// Reconcile compares the enrollments of school-module with the accounts
+	// and reports the discrepancies, healing them when heal is true.
+	Reconcile(ctx context.Context, heal bool) (*models.ReconciliationReport, error)
+	// Run reconciles as configured by RECONCILIATION_HEAL, which defaults to a
+	// dry run.
+	Run(ctx context.Context) error
+}
+
+type ReconciliationUsecase struct {
+	SchoolClient    clients.SchoolClient
+	Repository      repositories.AccountRepository
+	AccountUsecases AccountUsecases
+	AccountProducer producers.AccountProducer
+	UnitOfWork      transactions.UnitOfWork
+	PageSize        int
+	GracePeriod     time.Duration
+	Heal            bool
+}
+
+func NewReconciliationUsecase() *ReconciliationUsecase {
+	pageSize, err := strconv.Atoi(os.Getenv("RECONCILIATION_PAGE_SIZE"))
+	if err != nil || pageSize <= 0 {
+		pageSize = defaultReconciliationPageSize
+	}
+
+	gracePeriod, err := time.ParseDuration(os.Getenv("RECONCILIATION_GRACE_PERIOD"))
+	if err != nil || gracePeriod < 0 {
+		gracePeriod = defaultReconciliationGracePeriod
+	}
+
+	return &ReconciliationUsecase{
+		SchoolClient:    clients.NewSchoolRestClient(),
+		Repository:      repositories.NewAccountDBRepository(),
+		AccountUsecases: NewAccountUsecase(),
+		AccountProducer: producers.NewAccountProducer(),
+		UnitOfWork:      transactions.NewSQLUnitOfWork(),
+		PageSize:        pageSize,
+		GracePeriod:     gracePeriod,
+		Heal:            os.Getenv("RECONCILIATION_HEAL") == "true",
+	}
+}
+
+func (u *ReconciliationUsecase) Run(ctx context.Context) error {
+	report, err := u.Reconcile(ctx, u.Heal)
+	if err != nil {
+		return err
+	}
+
+	logging.Info(ctx).
+		AddParam("dryRun", report.DryRun).
+		AddParam("enrollments", report.Enrollments).
+		AddParam("accounts", report.Accounts).
+		AddParam("skipped", report.Skipped).
+		AddParam("discrepancies", len(report.Discrepancies)).
+		AddParam("healed", report.Healed).
+		Msg("Enrollments reconciled")
+	return nil
+}
+
+// Reconcile pages through both sides ordered by student and course and
+// merges them. Records created within the grace period are skipped, since
+// their events may still be in flight. A failed heal is recorded in the
+// report and does not stop the reconciliation.
+func (u *ReconciliationUsecase) Reconcile(ctx context.Context, heal bool) (*models.ReconciliationReport, error) {
+	report := &models.ReconciliationReport{
+		DryRun:        !heal,
+		StartedAt:     time.Now(),
+		Discrepancies: []models.Discrepancy{},
+	}
+	settledBefore := report.StartedAt.Add(-u.GracePeriod)
+
+	enrollments := &pageCursor[models.EnrollmentSnapshot]{size: u.PageSize, fetch: u.fetchEnrollments}
+	accounts := &pageCursor[models.Account]{size: u.PageSize, fetch: u.Repository.FindPage}
+
+	var previous *models.Account
+	for {
+		enrollment, err := enrollments.peek(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		account, err := accounts.peek(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if enrollment == nil && account == nil {
+			break
+		}
+
+		if account != nil && previous != nil && compareKeys(account.StudentID, account.CourseID, previous.StudentID, previous.CourseID) == 0 {
+			report.Accounts++
+			u.report(ctx, report, u.duplicate(account), heal)
+			accounts.advance()
+			continue
+		}
+
+		var comparison int
+		switch {
+		case enrollment == nil:
+			comparison = 1
+		case account == nil:
+			comparison = -1
+		default:
+			comparison = compareKeys(enrollment.StudentID, enrollment.CourseID, account.StudentID, account.CourseID)
+		}
+
+		var found *finding
+		switch {
+		case comparison < 0:
+			report.Enrollments++
+			enrollments.advance()
+			if enrollment.CreatedAt.After(settledBefore) {
+				report.Skipped++
+				continue
+			}
+			found = u.enrollmentOnly(enrollment)
+		case comparison > 0:
+			report.Accounts++
+			previous = account
+			accounts.advance()
+			if account.CreatedAt.After(settledBefore) {
+				report.Skipped++
+				continue
+			}
+			found = u.accountOnly(account)
+		default:
+			report.Enrollments++
+			report.Accounts++
+			previous = account
+			enrollments.advance()
+			accounts.advance()
+			if enrollment.CreatedAt.After(settledBefore) || account.CreatedAt.After(settledBefore) {
+				report.Skipped++
+				continue
+			}
+			found = u.compare(enrollment, account)
+		}
+
+		if found != nil {
+			u.report(ctx, report, found, heal)
+		}
+	}
+
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+func (u *ReconciliationUsecase) fetchEnrollments(ctx context.Context, after *models.EnrollmentSnapshot, limit int) ([]models.EnrollmentSnapshot, error) {
+	if after == nil {
+		return u.SchoolClient.FindEnrollments(ctx, uuid.Nil, uuid.Nil, limit)
+	}
+
+	return u.SchoolClient.FindEnrollments(ctx, after.StudentID, after.CourseID, limit)
+}
+
+// enrollmentOnly handles an enrollment without account. Pending enrollments
+// are still handled by the enrollment saga and cancelled ones need none.
+func (u *ReconciliationUsecase) enrollmentOnly(enrollment *models.EnrollmentSnapshot) *finding {
+	switch enrollment.Status {
+	case models.EnrollmentPendingFinancial, models.EnrollmentCancelled:
+		return nil
+	}
+
+	account := enrollment.ToAccount()
+	return &finding{
+		Discrepancy: models.Discrepancy{
+			Type:             enums.MISSING_ACCOUNT,
+			StudentID:        enrollment.StudentID,
+			CourseID:         enrollment.CourseID,
+			EnrollmentStatus: enrollment.Status,
+			Action:           enums.CREATE_ACCOUNT,
+		},
+		heal: func(ctx context.Context) error {
+			return u.AccountUsecases.Create(ctx, account)
+		},
+	}
+}
+
+func (u *ReconciliationUsecase) accountOnly(account *models.Account) *finding {
+	return u.newAccountFinding(enums.ORPHAN_ACCOUNT, account, enums.CANCEL_ACCOUNT, u.cancelAccount(account))
+}
+
+// duplicate is only reported: which of the accounts holds the payments must
+// be decided by hand.
+func (u *ReconciliationUsecase) duplicate(account *models.Account) *finding {
+	return u.newAccountFinding(enums.DUPLICATE_ACCOUNT, account, enums.NO_ACTION, nil)
+}
+
+// compare handles an enrollment with its account. The account status is the
+// reference, since it is computed from the invoices.
+func (u *ReconciliationUsecase) compare(enrollment *models.EnrollmentSnapshot, account *models.Account) *finding {
+	var found *finding
+	switch enrollment.Status {
+	case models.EnrollmentCancelled:
+		found = u.newAccountFinding(enums.ORPHAN_ACCOUNT, account, enums.CANCEL_ACCOUNT, u.cancelAccount(account))
+	case models.EnrollmentPendingFinancial:
+		found = u.newAccountFinding(enums.UNCONFIRMED_ENROLLMENT, account, enums.EMIT_ACCOUNT_CREATED, u.emit(account, u.AccountProducer.Created))
+	case string(account.Status):
+		return nil
+	default:
+		found = u.newAccountFinding(enums.STATUS_MISMATCH, account, enums.EMIT_ACCOUNT_STATUS_UPDATED, u.emit(account, u.AccountProducer.StatusUpdated))
+	}
+
+	found.EnrollmentStatus = enrollment.Status
+	return found
+}
+
+func (u *ReconciliationUsecase) newAccountFinding(discrepancyType enums.DiscrepancyType, account *models.Account, action enums.ReconciliationAction, heal func(ctx context.Context) error) *finding {
+	return &finding{
+		Discrepancy: models.Discrepancy{
+			Type:          discrepancyType,
+			StudentID:     account.StudentID,
+			CourseID:      account.CourseID,
+			AccountID:     uuid.NullUUID{UUID: account.ID, Valid: true},
+			AccountStatus: account.Status,
+			Action:        action,
+		},
+		heal: heal,
+	}
+}
+
+func (u *ReconciliationUsecase) cancelAccount(account *models.Account) func(ctx context.Context) error {
+	studentID, courseID := account.StudentID, account.CourseID
+	return func(ctx context.Context) error {
+		return u.AccountUsecases.CancelByStudentAndCourse(ctx, studentID, courseID, models.CancellationReconciliation)
+	}
+}
+
+func (u *ReconciliationUsecase) emit(account *models.Account, send func(ctx context.Context, model *models.Account) error) func(ctx context.Context) error {
+	model := *account
+	return func(ctx context.Context) error {
+		return send(ctx, &model)
+	}
+}
+
+// report adds the discrepancy to the report, healing it in its own
+// transaction when requested.
+func (u *ReconciliationUsecase) report(ctx context.Context, report *models.ReconciliationReport, found *finding, heal bool) {
+	if heal && found.heal != nil {
+		if err := u.UnitOfWork.Execute(ctx, found.heal); err != nil {
+			logging.Error(ctx).
+				Err(err).
+				AddParam("discrepancy", found.Discrepancy).
+				Msg("Could not heal discrepancy")
+			found.Error = err.Error()
+		} else {
+			found.Healed = true
+			report.Healed++
+		}
+	}
+
+	report.Discrepancies = append(report.Discrepancies, found.Discrepancy)
+}
+
+// finding is a discrepancy with the action that heals it, if any.
+type finding struct {
+	models.Discrepancy
+	heal func(ctx context.Context) error
+}
+
+// compareKeys orders enrollments the way PostgreSQL orders uuids, byte by
+// byte, so both sides can be merged.
+func compareKeys(studentA, courseA, studentB, courseB uuid.UUID) int {
+	if c := bytes.Compare(studentA[:], studentB[:]); c != 0 {
+		return c
+	}
+
+	return bytes.Compare(courseA[:], courseB[:])
+}
+
+// pageCursor walks a keyset paginated listing, fetching the page after the
+// last item read once the current one is consumed.
+type pageCursor[T any] struct {
+	fetch func(ctx context.Context, after *T, limit int) ([]T, error)
+	size  int
+	page  []T
+	pos   int
+	done  bool
+}
+
+func (c *pageCursor[T]) peek(ctx context.Context) (*T, error) {
+	if c.pos < len(c.page) {
+		return &c.page[c.pos], nil
+	}
+
+	if c.done {
+		return nil, nil
+	}
+
+	var after *T
+	if len(c.page) > 0 {
+		after = &c.page[len(c.page)-1]
+	}
+
+	page, err := c.fetch(ctx, after, c.size)
+	if err != nil {
+		return nil, err
+	}
+
+	c.page, c.pos, c.done = page, 0, len(page) < c.size
+	if len(page) == 0 {
+		return nil, nil
+	}
+
+	return &c.page[0], nil
+}
+
+func (c *pageCursor[T]) advance() {
+	c.pos++
+}
@@ -0,0 +1,63 @@
+//go:generate mockgen -source refund_usecases.go -destination mock/refund_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/google/uuid"
+)
+
+// RefundUsecases manages what is owed to students after the cancellation of
+// accounts paid in advance. Refunds are created by the cancellation and
+// marked as paid once paid back.
+type RefundUsecases interface {
+	GetAll(ctx context.Context, status enums.RefundStatus) ([]models.Refund, error)
+	Pay(ctx context.Context, id uuid.UUID) (*models.Refund, error)
+}
+
+type RefundUsecase struct {
+	Repository repositories.RefundRepository
+	Clock      func() time.Time
+}
+
+func NewRefundUsecase() *RefundUsecase {
+	return &RefundUsecase{
+		Repository: repositories.NewRefundDBRepository(),
+		Clock:      time.Now,
+	}
+}
+
+func (u *RefundUsecase) GetAll(ctx context.Context, status enums.RefundStatus) ([]models.Refund, error) {
+	return u.Repository.FindAll(ctx, status)
+}
+
+func (u *RefundUsecase) Pay(ctx context.Context, id uuid.UUID) (*models.Refund, error) {
+	refund, err := u.Repository.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if refund == nil {
+		return nil, errors.New(exceptions.ErrRefundNotFound)
+	}
+
+	now := u.Clock()
+	paid, err := u.Repository.MarkAsPaid(ctx, id, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if !paid {
+		return nil, errors.New(exceptions.ErrRefundAlreadyPaid)
+	}
+
+	refund.Status = enums.REFUND_PAID
+	refund.PaidAt.Time, refund.PaidAt.Valid = now, true
+	return refund, nil
+}
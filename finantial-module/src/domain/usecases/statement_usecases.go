@@ -0,0 +1,92 @@
+//go:generate mockgen -source statement_usecases.go -destination mock/statement_usecases_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/google/uuid"
+)
+
+// StatementUsecases builds the financial statement of a student.
+type StatementUsecases interface {
+	GetByStudent(ctx context.Context, studentID uuid.UUID, period models.StatementPeriod) (*models.Statement, error)
+}
+
+type StatementUsecase struct {
+	AccountRepository      repositories.AccountRepository
+	InvoiceRepository      repositories.InvoiceRepository
+	PaymentRepository      repositories.PaymentRepository
+	AgreementRepository    repositories.AgreementRepository
+	CancellationRepository repositories.CancellationRepository
+	HolidayUsecases        HolidayUsecases
+	Clock                  func() time.Time
+}
+
+func NewStatementUsecase() *StatementUsecase {
+	return &StatementUsecase{
+		AccountRepository:      repositories.NewAccountDBRepository(),
+		InvoiceRepository:      repositories.NewInvoiceDBRepository(),
+		PaymentRepository:      repositories.NewPaymentDBRepository(),
+		AgreementRepository:    repositories.NewAgreementDBRepository(),
+		CancellationRepository: repositories.NewCancellationDBRepository(),
+		HolidayUsecases:        NewHolidayUsecase(),
+		Clock:                  time.Now,
+	}
+}
+
+func (u *StatementUsecase) GetByStudent(ctx context.Context, studentID uuid.UUID, period models.StatementPeriod) (*models.Statement, error) {
+	if period.From.Valid && period.To.Valid && period.To.Time.Before(period.From.Time) {
+		return nil, errors.New(exceptions.ErrStatementInvalidPeriod)
+	}
+
+	accounts, err := u.AccountRepository.FindAllByStudent(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accounts) == 0 {
+		return nil, errors.New(exceptions.ErrStatementStudentNotFound)
+	}
+
+	invoices, err := u.InvoiceRepository.FindAllByStudent(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := u.PaymentRepository.FindAllByStudent(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	agreements := []models.Agreement{}
+	cancellations := []models.Cancellation{}
+	for _, account := range accounts {
+		list, err := u.AgreementRepository.FindAllByAccount(ctx, account.ID)
+		if err != nil {
+			return nil, err
+		}
+		agreements = append(agreements, list...)
+
+		cancellation, err := u.CancellationRepository.FindByAccount(ctx, account.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if cancellation != nil {
+			cancellations = append(cancellations, *cancellation)
+		}
+	}
+
+	now := u.Clock()
+	cal, err := u.HolidayUsecases.Calendar(ctx, now, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewStatement(studentID, accounts, invoices, payments, agreements, cancellations, period, cal.Day, cal.Day(now)), nil
+}
@@ -0,0 +1,113 @@
+//go:generate mockgen -source pix_client.go -destination mock/pix_client_mock.go -package clientsmock
+package clients
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restclient"
+)
+
+const (
+	pixClientTimeoutSeconds = 30
+)
+
+// PixClient registers immediate charges (cob) at the PSP through the PIX API
+// defined by the Central Bank.
+type PixClient interface {
+	// CreateCharge creates the charge with the given txid, payable for the
+	// given expiration, and returns the location the PSP serves it from, to
+	// be embedded in dynamic BR Codes.
+	CreateCharge(ctx context.Context, txid string, amount models.Money, key string, expiration time.Duration) (string, error)
+	// UpdateCharge amends the amount and the expiration, counted from its
+	// creation, of a charge already created, keeping its txid and location.
+	UpdateCharge(ctx context.Context, txid string, amount models.Money, expiration time.Duration) (string, error)
+}
+
+type pixChargeValue struct {
+	Original string `json:"original"`
+}
+
+type pixChargeCalendar struct {
+	Expiration int `json:"expiracao"`
+}
+
+type pixChargeRequest struct {
+	Calendar pixChargeCalendar `json:"calendario"`
+	Value    pixChargeValue    `json:"valor"`
+	Key      string            `json:"chave"`
+}
+
+type pixChargePatchRequest struct {
+	Calendar pixChargeCalendar `json:"calendario"`
+	Value    pixChargeValue    `json:"valor"`
+}
+
+type pixChargeResponse struct {
+	TxID     string `json:"txid"`
+	Location string `json:"location"`
+}
+
+type PixRestClient struct {
+	client *restclient.RestClient
+	token  string
+}
+
+func NewPixRestClient() *PixRestClient {
+	return &PixRestClient{
+		client: restclient.NewRestClient(&restclient.RestClientConfig{
+			Name:                "pix-psp-client",
+			BaseURL:             os.Getenv("PIX_PSP_BASE_URL"),
+			Timeout:             pixClientTimeoutSeconds,
+			Retries:             2,
+			RetrySleepInSeconds: 1,
+		}),
+		token: os.Getenv("PIX_PSP_TOKEN"),
+	}
+}
+
+func (c *PixRestClient) CreateCharge(ctx context.Context, txid string, amount models.Money, key string, expiration time.Duration) (string, error) {
+	return c.send(ctx, http.MethodPut, txid, pixChargeRequest{
+		Calendar: pixChargeCalendar{Expiration: int(expiration.Seconds())},
+		Value:    pixChargeValue{Original: amount.String()},
+		Key:      key,
+	})
+}
+
+func (c *PixRestClient) UpdateCharge(ctx context.Context, txid string, amount models.Money, expiration time.Duration) (string, error) {
+	return c.send(ctx, http.MethodPatch, txid, pixChargePatchRequest{
+		Calendar: pixChargeCalendar{Expiration: int(expiration.Seconds())},
+		Value:    pixChargeValue{Original: amount.String()},
+	})
+}
+
+func (c *PixRestClient) send(ctx context.Context, method string, txid string, body any) (string, error) {
+	headers := map[string]string{"Content-Type": "application/json"}
+	if c.token != "" {
+		headers["Authorization"] = "Bearer " + c.token
+	}
+
+	response := restclient.Request[pixChargeResponse, any]{
+		Ctx:        ctx,
+		Client:     c.client,
+		HttpMethod: method,
+		Path:       "/cob/" + txid,
+		Headers:    headers,
+		Body:       body,
+	}.Call()
+
+	if response.HasError() {
+		return "", response.Error()
+	}
+
+	charge := response.SuccessBody()
+	if charge == nil || charge.Location == "" {
+		return "", errors.New("PSP returned no location for PIX charge " + txid)
+	}
+
+	return charge.Location, nil
+}
@@ -0,0 +1,62 @@
+//go:generate mockgen -source school_client.go -destination mock/school_client_mock.go -package clientsmock
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restclient"
+	"github.com/google/uuid"
+)
+
+const schoolClientTimeoutSeconds = 30
+
+type SchoolClient interface {
+	// FindEnrollments returns up to limit enrollments ordered by student and
+	// course, starting after the given pair.
+	FindEnrollments(ctx context.Context, afterStudentID, afterCourseID uuid.UUID, limit int) ([]models.EnrollmentSnapshot, error)
+}
+
+type SchoolRestClient struct {
+	client *restclient.RestClient
+}
+
+func NewSchoolRestClient() *SchoolRestClient {
+	return &SchoolRestClient{
+		client: restclient.NewRestClient(&restclient.RestClientConfig{
+			Name:                "school-module-client",
+			BaseURL:             os.Getenv("SCHOOL_MODULE_BASE_URL"),
+			Timeout:             schoolClientTimeoutSeconds,
+			Retries:             2,
+			RetrySleepInSeconds: 1,
+		}),
+	}
+}
+
+func (c *SchoolRestClient) FindEnrollments(ctx context.Context, afterStudentID, afterCourseID uuid.UUID, limit int) ([]models.EnrollmentSnapshot, error) {
+	query := url.Values{}
+	query.Set("afterStudentId", afterStudentID.String())
+	query.Set("afterCourseId", afterCourseID.String())
+	query.Set("limit", strconv.Itoa(limit))
+
+	response := restclient.Request[[]models.EnrollmentSnapshot, any]{
+		Ctx:        ctx,
+		Client:     c.client,
+		HttpMethod: http.MethodGet,
+		Path:       "/private/v1/enrollments/snapshots?" + query.Encode(),
+	}.Call()
+
+	if response.HasError() {
+		return nil, response.Error()
+	}
+
+	if body := response.SuccessBody(); body != nil {
+		return *body, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,65 @@
+//go:generate mockgen -source file_storage.go -destination mock/file_storage_mock.go -package filesmock
+package files
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime/multipart"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/storage"
+)
+
+// FileStorage keeps the files generated by the module where every replica
+// can read them.
+type FileStorage interface {
+	Upload(ctx context.Context, key string, content []byte) error
+	// Download copies the file to a temporary file, which the caller must
+	// remove, and returns nil when there is no file with the key.
+	Download(ctx context.Context, key string) (*os.File, error)
+}
+
+// CloudFileStorage stores files in a bucket of the cloud provider configured
+// for the SDK.
+type CloudFileStorage struct {
+	Bucket string
+}
+
+func NewCloudFileStorage(bucket string) *CloudFileStorage {
+	return &CloudFileStorage{Bucket: bucket}
+}
+
+func (s *CloudFileStorage) Upload(ctx context.Context, key string, content []byte) error {
+	var file multipart.File = contentFile{bytes.NewReader(content)}
+	_, err := storage.UploadFile(ctx, s.Bucket, key, &file)
+	return err
+}
+
+func (s *CloudFileStorage) Download(ctx context.Context, key string) (*os.File, error) {
+	file, err := storage.DownloadFile(ctx, s.Bucket, key)
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && (awsErr.Code() == s3.ErrCodeNoSuchKey || awsErr.Code() == "NotFound") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// contentFile serves in-memory content as the multipart file the SDK uploads.
+type contentFile struct {
+	*bytes.Reader
+}
+
+func (contentFile) Close() error {
+	return nil
+}
@@ -3,28 +3,87 @@ package producers
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
-	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
 )
 
 const (
-	action_UPDATE_ACCOUNT_STATUS = "UPDATE_ACCOUNT_STATUS"
-	topic_FINANCIAL_INSTALLMENT  = "FINANCIAL_INSTALLMENT"
+	topic_FINANCIAL_INSTALLMENT = "FINANCIAL_INSTALLMENT"
 )
 
 type AccountProducer interface {
-	StatusUpdated(ctx context.Context, model *models.Account)
+	Created(ctx context.Context, model *models.Account) error
+	Rejected(ctx context.Context, model *models.Account, reason string) error
+	StatusUpdated(ctx context.Context, model *models.Account) error
+	// BulkStatusUpdated stores the status events of the accounts together.
+	BulkStatusUpdated(ctx context.Context, accounts []models.Account) error
+	Renegotiated(ctx context.Context, model *models.Account, agreement *models.Agreement) error
 }
 
 type AccountTopicProducer struct {
-	producer *messaging.Producer
+	producer *outbox.Producer
 }
 
 func NewAccountProducer() *AccountTopicProducer {
-	return &AccountTopicProducer{messaging.NewProducer(topic_FINANCIAL_INSTALLMENT)}
+	return &AccountTopicProducer{newOutboxProducer(topic_FINANCIAL_INSTALLMENT)}
 }
 
-func (p *AccountTopicProducer) StatusUpdated(ctx context.Context, model *models.Account) {
-	p.producer.Publish(ctx, action_UPDATE_ACCOUNT_STATUS, model)
+func (p *AccountTopicProducer) Created(ctx context.Context, model *models.Account) error {
+	return p.producer.Publish(ctx, contracts.AccountCreatedV1{
+		ID:           model.ID,
+		StudentID:    model.StudentID,
+		CourseID:     model.CourseID,
+		Installments: model.Installments,
+		Value:        json.Number(model.Value.String()),
+		CreatedAt:    model.CreatedAt,
+	})
+}
+
+func (p *AccountTopicProducer) Rejected(ctx context.Context, model *models.Account, reason string) error {
+	return p.producer.Publish(ctx, contracts.AccountRejectedV1{
+		StudentID: model.StudentID,
+		CourseID:  model.CourseID,
+		Reason:    reason,
+	})
+}
+
+func (p *AccountTopicProducer) StatusUpdated(ctx context.Context, model *models.Account) error {
+	return p.producer.Publish(ctx, newAccountStatusUpdated(model))
+}
+
+func (p *AccountTopicProducer) BulkStatusUpdated(ctx context.Context, accounts []models.Account) error {
+	data := make([]contracts.Data, 0, len(accounts))
+	for i := range accounts {
+		data = append(data, newAccountStatusUpdated(&accounts[i]))
+	}
+
+	return p.producer.PublishAll(ctx, data)
+}
+
+func (p *AccountTopicProducer) Renegotiated(ctx context.Context, model *models.Account, agreement *models.Agreement) error {
+	return p.producer.Publish(ctx, contracts.AccountRenegotiatedV1{
+		ID:           model.ID,
+		StudentID:    model.StudentID,
+		CourseID:     model.CourseID,
+		AgreementID:  agreement.ID,
+		Value:        json.Number(agreement.Value.String()),
+		Installments: agreement.Installments,
+		Status:       string(model.Status),
+		CreatedAt:    agreement.CreatedAt,
+	})
+}
+
+func newAccountStatusUpdated(model *models.Account) contracts.AccountStatusUpdatedV1 {
+	return contracts.AccountStatusUpdatedV1{
+		ID:           model.ID,
+		StudentID:    model.StudentID,
+		CourseID:     model.CourseID,
+		Installments: model.Installments,
+		Value:        json.Number(model.Value.String()),
+		Status:       string(model.Status),
+		CreatedAt:    model.CreatedAt,
+	}
 }
@@ -0,0 +1,13 @@
+//go:generate go run github.com/colibriproject-dev/colibri-sdk-go-examples/contracts/cmd/schemagen -out ../../../contracts/schemas dev.colibri.finantial.account.created.v1 dev.colibri.finantial.account.rejected.v1 dev.colibri.finantial.account.status-updated.v1 dev.colibri.finantial.account.renegotiated.v1
+package producers
+
+import (
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
+)
+
+// newOutboxProducer stores the events of the module in the outbox, to be
+// published to the topic by the relay.
+func newOutboxProducer(topic string) *outbox.Producer {
+	return outbox.NewProducer(contracts.FinantialSource, topic)
+}
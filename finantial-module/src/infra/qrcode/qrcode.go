@@ -0,0 +1,26 @@
+//go:generate mockgen -source qrcode.go -destination mock/qrcode_mock.go -package qrcodemock
+package qrcode
+
+import (
+	goqrcode "github.com/skip2/go-qrcode"
+)
+
+// moduleScale is the size in pixels of each QR code module. Negative sizes
+// tell the encoder to scale modules instead of fitting a fixed image size.
+const moduleScale = -8
+
+type QRCodeGenerator interface {
+	Generate(content string) ([]byte, error)
+}
+
+// PNGQRCodeGenerator renders QR codes with error correction level M as PNG
+// images.
+type PNGQRCodeGenerator struct{}
+
+func NewPNGQRCodeGenerator() *PNGQRCodeGenerator {
+	return &PNGQRCodeGenerator{}
+}
+
+func (g *PNGQRCodeGenerator) Generate(content string) ([]byte, error) {
+	return goqrcode.Encode(content, goqrcode.Medium, moduleScale)
+}
@@ -3,6 +3,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
@@ -11,11 +12,30 @@ import (
 
 type AccountRepository interface {
 	FindAll(ctx context.Context) ([]models.Account, error)
+	FindById(ctx context.Context, id uuid.UUID) (*models.Account, error)
+	// FindPage returns up to limit accounts not cancelled ordered by student,
+	// course and id, starting after the given account (or from the first one
+	// when nil).
+	FindPage(ctx context.Context, after *models.Account, limit int) ([]models.Account, error)
+	// FindPageEnd returns the last id and size of the page of limit accounts
+	// ordered by id after the given one, or nil when there are none left.
+	FindPageEnd(ctx context.Context, after uuid.UUID, limit int) (*models.AccountPage, error)
+	// UpdateOverdueStatuses sets the status of the accounts with id in
+	// (after, last] from their open invoices, an invoice due before cutoff
+	// making the account INADIMPLENTE. Cancelled accounts are left as they
+	// are, and only the accounts that changed are returned.
+	UpdateOverdueStatuses(ctx context.Context, after, last uuid.UUID, cutoff time.Time) ([]models.Account, error)
+	// ExistsByStudentAndCourse tells whether the student has an account on
+	// the course that was not cancelled.
+	ExistsByStudentAndCourse(ctx context.Context, studentId, courseId uuid.UUID) (bool, error)
+	// FindAllByStudent returns every account of the student, cancelled ones
+	// included, from the oldest.
+	FindAllByStudent(ctx context.Context, studentId uuid.UUID) ([]models.Account, error)
+	FindActiveByStudentAndCourse(ctx context.Context, studentId, courseId uuid.UUID) (*models.Account, error)
+	FindAllActiveByCourse(ctx context.Context, courseId uuid.UUID) ([]models.Account, error)
+	FindAllActiveByStudent(ctx context.Context, studentId uuid.UUID) ([]models.Account, error)
 	Insert(ctx context.Context, model *models.Account) error
 	UpdateStatus(ctx context.Context, account *models.Account) error
-	DeleteByStudentAndCourse(ctx context.Context, studentId, courseId uuid.UUID) error
-	DeleteByCourse(ctx context.Context, courseId uuid.UUID) error
-	DeleteByStudent(ctx context.Context, studentId uuid.UUID) error
 }
 
 type AccountDBRepository struct{}
@@ -30,34 +50,102 @@ func (r *AccountDBRepository) FindAll(ctx context.Context) ([]models.Account, er
 	return sqlDB.NewQuery[models.Account](ctx, query).Many()
 }
 
-func (r *AccountDBRepository) Insert(ctx context.Context, model *models.Account) error {
-	const query = `INSERT INTO accounts (id, student_id, course_id, installments, value, status, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+func (r *AccountDBRepository) FindById(ctx context.Context, id uuid.UUID) (*models.Account, error) {
+	const query = `SELECT a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at FROM accounts a WHERE a.id = $1`
 
-	return sqlDB.NewStatement(ctx, query,
-		model.ID, model.StudentID, model.CourseID, model.Installments, model.Value, model.Status, model.CreatedAt,
-	).Execute()
+	return sqlDB.NewQuery[models.Account](ctx, query, id).One()
 }
 
-func (r *AccountDBRepository) UpdateStatus(ctx context.Context, account *models.Account) error {
-	const query = `UPDATE accounts SET status = $3 WHERE student_id = $1 AND course_id = $2`
+func (r *AccountDBRepository) FindPage(ctx context.Context, after *models.Account, limit int) ([]models.Account, error) {
+	const query = `SELECT a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at FROM accounts a
+		WHERE (a.student_id, a.course_id, a.id) > ($1, $2, $3) AND a.status <> 'CANCELADO'
+		ORDER BY a.student_id, a.course_id, a.id
+		LIMIT $4`
+
+	if after == nil {
+		after = &models.Account{}
+	}
+
+	return sqlDB.NewQuery[models.Account](ctx, query, after.StudentID, after.CourseID, after.ID, limit).Many()
+}
+
+func (r *AccountDBRepository) FindPageEnd(ctx context.Context, after uuid.UUID, limit int) (*models.AccountPage, error) {
+	const query = `
+		SELECT p.id, COUNT(p.id) OVER ()
+		FROM (SELECT a.id FROM accounts a WHERE a.id > $1 ORDER BY a.id LIMIT $2) p
+		ORDER BY p.id DESC
+		LIMIT 1`
+
+	return sqlDB.NewQuery[models.AccountPage](ctx, query, after, limit).One()
+}
+
+func (r *AccountDBRepository) UpdateOverdueStatuses(ctx context.Context, after, last uuid.UUID, cutoff time.Time) ([]models.Account, error) {
+	const query = `
+		UPDATE accounts a
+		SET status = CASE WHEN o.overdue THEN 'INADIMPLENTE' ELSE 'ADIMPLENTE' END::ACCOUNT_STATUS
+		FROM (
+			SELECT
+				a.id,
+				EXISTS (SELECT 1 FROM invoices i WHERE i.account_id = a.id AND i.paid_at IS NULL AND i.cancelled_at IS NULL AND i.due_date < $3::date) AS overdue
+			FROM accounts a
+			WHERE a.id > $1 AND a.id <= $2 AND a.status <> 'CANCELADO'
+		) o
+		WHERE a.id = o.id AND (a.status = 'INADIMPLENTE') <> o.overdue
+		RETURNING a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at`
+
+	return sqlDB.NewQuery[models.Account](ctx, query, after, last, cutoff).Many()
+}
+
+func (r *AccountDBRepository) ExistsByStudentAndCourse(ctx context.Context, studentId, courseId uuid.UUID) (bool, error) {
+	const query = `SELECT EXISTS (SELECT 1 FROM accounts a WHERE a.student_id = $1 AND a.course_id = $2 AND a.status <> 'CANCELADO')`
+
+	exists, err := sqlDB.NewQuery[bool](ctx, query, studentId, courseId).One()
+	if err != nil {
+		return false, err
+	}
 
-	return sqlDB.NewStatement(ctx, query, account.StudentID, account.CourseID, account.Status).Execute()
+	return exists != nil && *exists, nil
 }
 
-func (r *AccountDBRepository) DeleteByStudentAndCourse(ctx context.Context, studentId, courseId uuid.UUID) error {
-	const query = `DELETE FROM accounts WHERE student_id = $1 AND course_id = $2`
+func (r *AccountDBRepository) FindAllByStudent(ctx context.Context, studentId uuid.UUID) ([]models.Account, error) {
+	const query = `SELECT a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at FROM accounts a
+		WHERE a.student_id = $1
+		ORDER BY a.created_at, a.id`
 
-	return sqlDB.NewStatement(ctx, query, studentId, courseId).Execute()
+	return sqlDB.NewQuery[models.Account](ctx, query, studentId).Many()
 }
 
-func (r *AccountDBRepository) DeleteByCourse(ctx context.Context, courseId uuid.UUID) error {
-	const query = `DELETE FROM accounts WHERE course_id = $1`
+func (r *AccountDBRepository) FindActiveByStudentAndCourse(ctx context.Context, studentId, courseId uuid.UUID) (*models.Account, error) {
+	const query = `SELECT a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at FROM accounts a
+		WHERE a.student_id = $1 AND a.course_id = $2 AND a.status <> 'CANCELADO'`
 
-	return sqlDB.NewStatement(ctx, query, courseId).Execute()
+	return sqlDB.NewQuery[models.Account](ctx, query, studentId, courseId).One()
 }
 
-func (r *AccountDBRepository) DeleteByStudent(ctx context.Context, studentId uuid.UUID) error {
-	const query = `DELETE FROM accounts WHERE student_id = $1`
+func (r *AccountDBRepository) FindAllActiveByCourse(ctx context.Context, courseId uuid.UUID) ([]models.Account, error) {
+	const query = `SELECT a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at FROM accounts a
+		WHERE a.course_id = $1 AND a.status <> 'CANCELADO'`
+
+	return sqlDB.NewQuery[models.Account](ctx, query, courseId).Many()
+}
+
+func (r *AccountDBRepository) FindAllActiveByStudent(ctx context.Context, studentId uuid.UUID) ([]models.Account, error) {
+	const query = `SELECT a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at FROM accounts a
+		WHERE a.student_id = $1 AND a.status <> 'CANCELADO'`
+
+	return sqlDB.NewQuery[models.Account](ctx, query, studentId).Many()
+}
+
+func (r *AccountDBRepository) Insert(ctx context.Context, model *models.Account) error {
+	const query = `INSERT INTO accounts (id, student_id, course_id, installments, value, status, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	return sqlDB.NewStatement(ctx, query,
+		model.ID, model.StudentID, model.CourseID, model.Installments, model.Value, model.Status, model.CreatedAt,
+	).Execute()
+}
+
+func (r *AccountDBRepository) UpdateStatus(ctx context.Context, account *models.Account) error {
+	const query = `UPDATE accounts SET status = $2 WHERE id = $1`
 
-	return sqlDB.NewStatement(ctx, query, studentId).Execute()
+	return sqlDB.NewStatement(ctx, query, account.ID, account.Status).Execute()
 }
@@ -0,0 +1,40 @@
+//go:generate mockgen -source agreement_repository.go -destination mock/agreement_repository_mock.go -package repositoriesmock
+package repositories
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+	"github.com/google/uuid"
+)
+
+type AgreementRepository interface {
+	FindAllByAccount(ctx context.Context, accountID uuid.UUID) ([]models.Agreement, error)
+	Insert(ctx context.Context, model *models.Agreement) error
+}
+
+type AgreementDBRepository struct{}
+
+func NewAgreementDBRepository() *AgreementDBRepository {
+	return &AgreementDBRepository{}
+}
+
+func (r *AgreementDBRepository) FindAllByAccount(ctx context.Context, accountID uuid.UUID) ([]models.Agreement, error) {
+	const query = `
+		SELECT a.id, a.account_id, a.principal, a.late_charges, a.value, a.down_payment, a.installments, a.created_at
+		FROM agreements a
+		WHERE a.account_id = $1
+		ORDER BY a.created_at`
+
+	return sqlDB.NewQuery[models.Agreement](ctx, query, accountID).Many()
+}
+
+func (r *AgreementDBRepository) Insert(ctx context.Context, model *models.Agreement) error {
+	const query = `
+		INSERT INTO agreements (id, account_id, principal, late_charges, value, down_payment, installments, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	return sqlDB.NewStatement(ctx, query, model.ID, model.AccountID, model.Principal, model.LateCharges, model.Value,
+		model.DownPayment, model.Installments, model.CreatedAt).Execute()
+}
@@ -0,0 +1,39 @@
+//go:generate mockgen -source cancellation_repository.go -destination mock/cancellation_repository_mock.go -package repositoriesmock
+package repositories
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+	"github.com/google/uuid"
+)
+
+type CancellationRepository interface {
+	FindByAccount(ctx context.Context, accountID uuid.UUID) (*models.Cancellation, error)
+	Insert(ctx context.Context, model *models.Cancellation) error
+}
+
+type CancellationDBRepository struct{}
+
+func NewCancellationDBRepository() *CancellationDBRepository {
+	return &CancellationDBRepository{}
+}
+
+func (r *CancellationDBRepository) FindByAccount(ctx context.Context, accountID uuid.UUID) (*models.Cancellation, error) {
+	const query = `
+		SELECT c.id, c.account_id, c.reason, c.prorated_value, c.final_charge, c.refund, c.final_invoice_id, c.created_at
+		FROM cancellations c
+		WHERE c.account_id = $1`
+
+	return sqlDB.NewQuery[models.Cancellation](ctx, query, accountID).One()
+}
+
+func (r *CancellationDBRepository) Insert(ctx context.Context, model *models.Cancellation) error {
+	const query = `
+		INSERT INTO cancellations (id, account_id, reason, prorated_value, final_charge, refund, final_invoice_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	return sqlDB.NewStatement(ctx, query, model.ID, model.AccountID, model.Reason, model.ProratedValue, model.FinalCharge,
+		model.Refund, model.FinalInvoiceID, model.CreatedAt).Execute()
+}
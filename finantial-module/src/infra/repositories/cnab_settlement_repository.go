@@ -0,0 +1,34 @@
+//go:generate mockgen -source cnab_settlement_repository.go -destination mock/cnab_settlement_repository_mock.go -package repositoriesmock
+package repositories
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+)
+
+type CnabSettlementRepository interface {
+	// Insert reports whether the settlement was stored, false meaning a
+	// return file imported before already applied it.
+	Insert(ctx context.Context, model *models.CnabSettlement) (bool, error)
+}
+
+type CnabSettlementDBRepository struct{}
+
+func NewCnabSettlementDBRepository() *CnabSettlementDBRepository {
+	return &CnabSettlementDBRepository{}
+}
+
+func (r *CnabSettlementDBRepository) Insert(ctx context.Context, model *models.CnabSettlement) (bool, error) {
+	const query = `
+		INSERT INTO cnab_settlements (bank_code, our_number, occurred_at, paid_value, invoice_id)
+		VALUES ($1, $2, $3::date, $4, $5)
+		ON CONFLICT DO NOTHING
+		RETURNING TRUE`
+
+	inserted, err := sqlDB.NewQuery[bool](ctx, query,
+		model.BankCode, model.OurNumber, model.OccurredAt, model.PaidValue, model.InvoiceID,
+	).One()
+	return inserted != nil, err
+}
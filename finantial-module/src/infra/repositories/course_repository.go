@@ -0,0 +1,56 @@
+//go:generate mockgen -source course_repository.go -destination mock/course_repository_mock.go -package repositoriesmock
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+	"github.com/google/uuid"
+)
+
+type CourseRepository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Course, error)
+	// Upsert ignores changes older than the stored one and deleted courses,
+	// since course events may arrive out of order.
+	Upsert(ctx context.Context, model *models.Course) error
+	Delete(ctx context.Context, id uuid.UUID, deletedAt time.Time) error
+}
+
+type CourseDBRepository struct{}
+
+func NewCourseDBRepository() *CourseDBRepository {
+	return &CourseDBRepository{}
+}
+
+func (r *CourseDBRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Course, error) {
+	const query = `SELECT c.id, c.name, c.value, c.updated_at FROM courses c WHERE c.id = $1 AND c.deleted_at IS NULL`
+
+	return sqlDB.NewQuery[models.Course](ctx, query, id).One()
+}
+
+func (r *CourseDBRepository) Upsert(ctx context.Context, model *models.Course) error {
+	const query = `
+		INSERT INTO courses (id, name, value, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE
+		SET name = EXCLUDED.name, value = EXCLUDED.value, updated_at = EXCLUDED.updated_at
+		WHERE courses.updated_at <= EXCLUDED.updated_at
+		AND courses.deleted_at IS NULL`
+
+	return sqlDB.NewStatement(ctx, query, model.ID, model.Name, model.Value, model.UpdatedAt).Execute()
+}
+
+// Delete keeps a tombstone, so that a creation received after the deletion
+// does not bring the course back.
+func (r *CourseDBRepository) Delete(ctx context.Context, id uuid.UUID, deletedAt time.Time) error {
+	const query = `
+		INSERT INTO courses (id, name, value, updated_at, deleted_at)
+		VALUES ($1, '', 0, $2, $2)
+		ON CONFLICT (id) DO UPDATE
+		SET deleted_at = EXCLUDED.deleted_at
+		WHERE courses.deleted_at IS NULL`
+
+	return sqlDB.NewStatement(ctx, query, id, deletedAt).Execute()
+}
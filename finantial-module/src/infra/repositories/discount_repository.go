@@ -0,0 +1,93 @@
+//go:generate mockgen -source discount_repository.go -destination mock/discount_repository_mock.go -package repositoriesmock
+package repositories
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/bulk"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type DiscountRepository interface {
+	FindByStudent(ctx context.Context, studentID uuid.UUID) ([]models.Discount, error)
+	// FindByStudentAndCourse returns the discounts of the student on the
+	// course and on all courses, in the order they were granted.
+	FindByStudentAndCourse(ctx context.Context, studentID, courseID uuid.UUID) ([]models.Discount, error)
+	Insert(ctx context.Context, model *models.Discount) error
+	// Delete reports whether the discount existed.
+	Delete(ctx context.Context, id uuid.UUID) (bool, error)
+	FindByInvoices(ctx context.Context, ids []uuid.UUID) ([]models.InvoiceDiscount, error)
+	BulkInsertInvoiceDiscounts(ctx context.Context, discounts []models.InvoiceDiscount) error
+}
+
+var invoiceDiscountsBulkInsert = bulk.Insert[models.InvoiceDiscount]{
+	Table:   "invoice_discounts",
+	Columns: []string{"invoice_id", "position", "discount_id", "name", "type", "value", "percentage", "amount"},
+	Values: func(discount *models.InvoiceDiscount) []any {
+		return []any{discount.InvoiceID, discount.Position, discount.DiscountID, discount.Name, discount.Type, discount.Value, discount.Percentage, discount.Amount}
+	},
+}
+
+type DiscountDBRepository struct{}
+
+func NewDiscountDBRepository() *DiscountDBRepository {
+	return &DiscountDBRepository{}
+}
+
+func (r *DiscountDBRepository) FindByStudent(ctx context.Context, studentID uuid.UUID) ([]models.Discount, error) {
+	const query = `
+		SELECT d.id, d.student_id, d.course_id, d.name, d.type, d.value, d.percentage, d.stackable, d.valid_from, d.valid_until, d.created_at
+		FROM discounts d
+		WHERE d.student_id = $1
+		ORDER BY d.created_at`
+
+	return sqlDB.NewQuery[models.Discount](ctx, query, studentID).Many()
+}
+
+func (r *DiscountDBRepository) FindByStudentAndCourse(ctx context.Context, studentID, courseID uuid.UUID) ([]models.Discount, error) {
+	const query = `
+		SELECT d.id, d.student_id, d.course_id, d.name, d.type, d.value, d.percentage, d.stackable, d.valid_from, d.valid_until, d.created_at
+		FROM discounts d
+		WHERE d.student_id = $1 AND (d.course_id = $2 OR d.course_id IS NULL)
+		ORDER BY d.created_at`
+
+	return sqlDB.NewQuery[models.Discount](ctx, query, studentID, courseID).Many()
+}
+
+func (r *DiscountDBRepository) Insert(ctx context.Context, model *models.Discount) error {
+	const query = `
+		INSERT INTO discounts (id, student_id, course_id, name, type, value, percentage, stackable, valid_from, valid_until, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9::date, $10::date, $11)`
+
+	return sqlDB.NewStatement(ctx, query, model.ID, model.StudentID, model.CourseID, model.Name, model.Type, model.Value,
+		model.Percentage, model.Stackable, model.ValidFrom, model.ValidUntil, model.CreatedAt).Execute()
+}
+
+func (r *DiscountDBRepository) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
+	const query = `DELETE FROM discounts WHERE id = $1 RETURNING TRUE`
+
+	deleted, err := sqlDB.NewQuery[bool](ctx, query, id).One()
+	return deleted != nil, err
+}
+
+func (r *DiscountDBRepository) FindByInvoices(ctx context.Context, ids []uuid.UUID) ([]models.InvoiceDiscount, error) {
+	const query = `
+		SELECT d.invoice_id, d.position, d.discount_id, d.name, d.type, d.value, d.percentage, d.amount
+		FROM invoice_discounts d
+		WHERE d.invoice_id = ANY($1::uuid[])
+		ORDER BY d.invoice_id, d.position`
+
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+
+	return sqlDB.NewQuery[models.InvoiceDiscount](ctx, query, pq.StringArray(values)).Many()
+}
+
+func (r *DiscountDBRepository) BulkInsertInvoiceDiscounts(ctx context.Context, discounts []models.InvoiceDiscount) error {
+	return invoiceDiscountsBulkInsert.Execute(ctx, discounts)
+}
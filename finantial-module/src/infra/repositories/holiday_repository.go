@@ -0,0 +1,48 @@
+//go:generate mockgen -source holiday_repository.go -destination mock/holiday_repository_mock.go -package repositoriesmock
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+)
+
+type HolidayRepository interface {
+	// FindBetween returns the holidays registered from one day to the other,
+	// both included.
+	FindBetween(ctx context.Context, from, to time.Time) ([]calendar.Holiday, error)
+	// Insert reports whether the holiday was stored, false meaning the day
+	// already had one.
+	Insert(ctx context.Context, model *models.Holiday) (bool, error)
+	// Delete reports whether there was a holiday on the day.
+	Delete(ctx context.Context, date time.Time) (bool, error)
+}
+
+type HolidayDBRepository struct{}
+
+func NewHolidayDBRepository() *HolidayDBRepository {
+	return &HolidayDBRepository{}
+}
+
+func (r *HolidayDBRepository) FindBetween(ctx context.Context, from, to time.Time) ([]calendar.Holiday, error) {
+	const query = `SELECT h.date, h.name FROM holidays h WHERE h.date BETWEEN $1::date AND $2::date ORDER BY h.date`
+
+	return sqlDB.NewQuery[calendar.Holiday](ctx, query, from, to).Many()
+}
+
+func (r *HolidayDBRepository) Insert(ctx context.Context, model *models.Holiday) (bool, error) {
+	const query = `INSERT INTO holidays (date, name) VALUES ($1::date, $2) ON CONFLICT (date) DO NOTHING RETURNING TRUE`
+
+	inserted, err := sqlDB.NewQuery[bool](ctx, query, model.Date.Time(), model.Name).One()
+	return inserted != nil, err
+}
+
+func (r *HolidayDBRepository) Delete(ctx context.Context, date time.Time) (bool, error) {
+	const query = `DELETE FROM holidays WHERE date = $1::date RETURNING TRUE`
+
+	deleted, err := sqlDB.NewQuery[bool](ctx, query, date).One()
+	return deleted != nil, err
+}
@@ -3,23 +3,60 @@ package repositories
 
 import (
 	"context"
-	"fmt"
-	"strings"
 	"time"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/bulk"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type InvoiceRepository interface {
 	FindAll(ctx context.Context) ([]models.Invoice, error)
 	FindById(ctx context.Context, id uuid.UUID) (*models.Invoice, error)
+	// FindByIdForUpdate is FindById locking the invoice until the end of the
+	// transaction, so payments to it are applied one at a time.
+	FindByIdForUpdate(ctx context.Context, id uuid.UUID) (*models.Invoice, error)
+	FindAllByAccount(ctx context.Context, accountID uuid.UUID) ([]models.Invoice, error)
+	FindAllByStudent(ctx context.Context, studentID uuid.UUID) ([]models.Invoice, error)
 	Insert(ctx context.Context, invoice *models.Invoice) error
 	BulkInsert(ctx context.Context, invoices []models.Invoice) error
-	UpdatePaymentDate(ctx context.Context, invoice *models.Invoice) error
-	FindAllOverdueInvoices(ctx context.Context) ([]models.OverdueInvoices, error)
-	FindTotalOverdueInvoicesByAccount(ctx context.Context, id uuid.UUID) (*uint64, error)
+	UpdatePayment(ctx context.Context, invoice *models.Invoice) error
+	UpdateBoleto(ctx context.Context, invoice *models.Invoice) error
+	UpdatePix(ctx context.Context, invoice *models.Invoice) error
+	NextOurNumberSequences(ctx context.Context, total int) ([]int64, error)
+	FindByOurNumber(ctx context.Context, bankCode string, ourNumber string) (*models.Invoice, error)
+	// FindAllPendingRemittanceForUpdate locks the invoices whose boleto was
+	// not yet sent to the bank until the end of the transaction.
+	FindAllPendingRemittanceForUpdate(ctx context.Context) ([]models.Invoice, error)
+	MarkAsRemitted(ctx context.Context, ids []uuid.UUID) error
+	// FindAllPendingWriteOffForUpdate locks the cancelled invoices whose
+	// boleto was sent to the bank and not yet written off there until the end
+	// of the transaction.
+	FindAllPendingWriteOffForUpdate(ctx context.Context) ([]models.Invoice, error)
+	MarkAsWrittenOff(ctx context.Context, ids []uuid.UUID) error
+	NextRemittanceSequence(ctx context.Context) (*int64, error)
+	FindOldestOpenDueDateByAccount(ctx context.Context, id uuid.UUID) (*types.NullDateTime, error)
+	// Cancel cancels the given invoices that are still open, linking them to
+	// the agreement that replaced them, if any, and returns how many were
+	// cancelled.
+	Cancel(ctx context.Context, ids []uuid.UUID, replacedBy uuid.NullUUID, cancelledAt time.Time) (int, error)
+}
+
+var invoicesBulkInsert = bulk.Insert[models.Invoice]{
+	Table: "invoices",
+	Columns: []string{
+		"id", "account_id", "installment", "due_date", "value", "created_at",
+		"bank_code", "our_number", "barcode", "digitable_line",
+		"gross_value", "discount", "paid_at", "agreement_id",
+	},
+	Values: func(invoice *models.Invoice) []any {
+		return []any{invoice.ID, invoice.Account.ID, invoice.Installment, invoice.DueDate, invoice.Value, invoice.CreatedAt,
+			invoice.Boleto.BankCode, invoice.Boleto.OurNumber, invoice.Boleto.Barcode, invoice.Boleto.DigitableLine,
+			invoice.GrossValue, invoice.Discount, invoice.PaidAt, invoice.AgreementID}
+	},
 }
 
 type InvoiceDBRepository struct{}
@@ -33,7 +70,10 @@ func (r *InvoiceDBRepository) FindAll(ctx context.Context) ([]models.Invoice, er
 		SELECT
 			i.id,
 			a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at,
-			i.installment, i.due_date, i.value, i.created_at, i.paid_at
+			i.installment, i.due_date, i.value, i.created_at, i.paid_at, i.paid_value, i.late_fee, i.late_interest, i.late_charged_at, i.paid_charges,
+			i.bank_code, i.our_number, i.barcode, i.digitable_line, i.gross_value, i.discount,
+			i.cancelled_at, i.agreement_id, i.replaced_by, i.pix_txid, i.pix_location, i.pix_amount,
+			i.pix_created_at, i.pix_expires_at
 		FROM invoices i
 		INNER JOIN accounts a ON i.account_id = a.id`
 
@@ -45,7 +85,10 @@ func (r *InvoiceDBRepository) FindById(ctx context.Context, id uuid.UUID) (*mode
 		SELECT
 			i.id,
 			a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at,
-			i.installment, i.due_date, i.value, i.created_at, i.paid_at
+			i.installment, i.due_date, i.value, i.created_at, i.paid_at, i.paid_value, i.late_fee, i.late_interest, i.late_charged_at, i.paid_charges,
+			i.bank_code, i.our_number, i.barcode, i.digitable_line, i.gross_value, i.discount,
+			i.cancelled_at, i.agreement_id, i.replaced_by, i.pix_txid, i.pix_location, i.pix_amount,
+			i.pix_created_at, i.pix_expires_at
 		FROM invoices i
 		INNER JOIN accounts a ON i.account_id = a.id
 		WHERE i.id = $1`
@@ -53,52 +96,194 @@ func (r *InvoiceDBRepository) FindById(ctx context.Context, id uuid.UUID) (*mode
 	return sqlDB.NewQuery[models.Invoice](ctx, query, id).One()
 }
 
+func (r *InvoiceDBRepository) FindByIdForUpdate(ctx context.Context, id uuid.UUID) (*models.Invoice, error) {
+	const query = `
+		SELECT
+			i.id,
+			a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at,
+			i.installment, i.due_date, i.value, i.created_at, i.paid_at, i.paid_value, i.late_fee, i.late_interest, i.late_charged_at, i.paid_charges,
+			i.bank_code, i.our_number, i.barcode, i.digitable_line, i.gross_value, i.discount,
+			i.cancelled_at, i.agreement_id, i.replaced_by, i.pix_txid, i.pix_location, i.pix_amount,
+			i.pix_created_at, i.pix_expires_at
+		FROM invoices i
+		INNER JOIN accounts a ON i.account_id = a.id
+		WHERE i.id = $1
+		FOR UPDATE OF i`
+
+	return sqlDB.NewQuery[models.Invoice](ctx, query, id).One()
+}
+
+func (r *InvoiceDBRepository) FindAllByAccount(ctx context.Context, accountID uuid.UUID) ([]models.Invoice, error) {
+	const query = `
+		SELECT
+			i.id,
+			a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at,
+			i.installment, i.due_date, i.value, i.created_at, i.paid_at, i.paid_value, i.late_fee, i.late_interest, i.late_charged_at, i.paid_charges,
+			i.bank_code, i.our_number, i.barcode, i.digitable_line, i.gross_value, i.discount,
+			i.cancelled_at, i.agreement_id, i.replaced_by, i.pix_txid, i.pix_location, i.pix_amount,
+			i.pix_created_at, i.pix_expires_at
+		FROM invoices i
+		INNER JOIN accounts a ON i.account_id = a.id
+		WHERE i.account_id = $1
+		ORDER BY i.due_date, i.created_at, i.installment`
+
+	return sqlDB.NewQuery[models.Invoice](ctx, query, accountID).Many()
+}
+
+func (r *InvoiceDBRepository) FindAllByStudent(ctx context.Context, studentID uuid.UUID) ([]models.Invoice, error) {
+	const query = `
+		SELECT
+			i.id,
+			a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at,
+			i.installment, i.due_date, i.value, i.created_at, i.paid_at, i.paid_value, i.late_fee, i.late_interest, i.late_charged_at, i.paid_charges,
+			i.bank_code, i.our_number, i.barcode, i.digitable_line, i.gross_value, i.discount,
+			i.cancelled_at, i.agreement_id, i.replaced_by, i.pix_txid, i.pix_location, i.pix_amount,
+			i.pix_created_at, i.pix_expires_at
+		FROM invoices i
+		INNER JOIN accounts a ON i.account_id = a.id
+		WHERE a.student_id = $1
+		ORDER BY i.due_date, i.created_at, i.installment`
+
+	return sqlDB.NewQuery[models.Invoice](ctx, query, studentID).Many()
+}
+
 func (r *InvoiceDBRepository) Insert(ctx context.Context, invoice *models.Invoice) error {
-	const query = `INSERT INTO invoices (id, account_id, installment, due_date, value, created_at) VALUES ($1, $2, $3, $4, $5, $6)`
+	const query = `
+		INSERT INTO invoices (id, account_id, installment, due_date, value, created_at, bank_code, our_number, barcode, digitable_line, gross_value, discount, paid_at, agreement_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`
 
-	return sqlDB.NewStatement(ctx, query, invoice.ID, invoice.Account.ID, invoice.Installment, invoice.DueDate, invoice.Value, invoice.CreatedAt).Execute()
+	return sqlDB.NewStatement(ctx, query, invoice.ID, invoice.Account.ID, invoice.Installment, invoice.DueDate, invoice.Value, invoice.CreatedAt,
+		invoice.Boleto.BankCode, invoice.Boleto.OurNumber, invoice.Boleto.Barcode, invoice.Boleto.DigitableLine,
+		invoice.GrossValue, invoice.Discount, invoice.PaidAt, invoice.AgreementID).Execute()
 }
 
 func (r *InvoiceDBRepository) BulkInsert(ctx context.Context, invoices []models.Invoice) error {
-	const query = `INSERT INTO invoices (id, account_id, installment, due_date, value, created_at) VALUES %s`
+	return invoicesBulkInsert.Execute(ctx, invoices)
+}
 
-	values := []string{}
-	for _, invoice := range invoices {
-		value := fmt.Sprintf("('%s', '%s', %d, '%v', %v, '%v')", invoice.ID, invoice.Account.ID, invoice.Installment, invoice.DueDate.Format(time.RFC3339Nano), invoice.Value, invoice.CreatedAt.Format(time.RFC3339Nano))
-		values = append(values, value)
-	}
+func (r *InvoiceDBRepository) UpdatePayment(ctx context.Context, invoice *models.Invoice) error {
+	const query = `UPDATE invoices SET paid_value=$2, paid_at=$3, late_fee=$4, late_interest=$5, late_charged_at=$6, paid_charges=$7 WHERE id=$1`
+
+	return sqlDB.NewStatement(ctx, query, invoice.ID, invoice.PaidValue, invoice.PaidAt, invoice.LateFee, invoice.LateInterest,
+		invoice.LateChargedAt, invoice.PaidCharges).Execute()
+}
+
+func (r *InvoiceDBRepository) UpdatePix(ctx context.Context, invoice *models.Invoice) error {
+	const query = `UPDATE invoices SET pix_txid=$2, pix_location=$3, pix_amount=$4, pix_created_at=$5, pix_expires_at=$6 WHERE id=$1`
+
+	return sqlDB.NewStatement(ctx, query, invoice.ID, invoice.Pix.TxID, invoice.Pix.Location, invoice.Pix.Amount,
+		invoice.Pix.CreatedAt, invoice.Pix.ExpiresAt).Execute()
+}
+
+func (r *InvoiceDBRepository) UpdateBoleto(ctx context.Context, invoice *models.Invoice) error {
+	const query = `UPDATE invoices SET bank_code=$2, our_number=$3, barcode=$4, digitable_line=$5 WHERE id=$1`
 
-	return sqlDB.NewStatement(ctx, fmt.Sprintf(query, strings.Join(values, ", "))).Execute()
+	return sqlDB.NewStatement(ctx, query, invoice.ID, invoice.Boleto.BankCode, invoice.Boleto.OurNumber, invoice.Boleto.Barcode, invoice.Boleto.DigitableLine).Execute()
 }
 
-func (r *InvoiceDBRepository) UpdatePaymentDate(ctx context.Context, invoice *models.Invoice) error {
-	const query = `UPDATE invoices SET due_date=$2 WHERE id=$1`
+func (r *InvoiceDBRepository) NextOurNumberSequences(ctx context.Context, total int) ([]int64, error) {
+	const query = `SELECT nextval('invoices_our_number_seq') FROM generate_series(1, $1)`
 
-	return sqlDB.NewStatement(ctx, query, invoice.ID, invoice.DueDate).Execute()
+	return sqlDB.NewQuery[int64](ctx, query, total).Many()
 }
 
-func (r *InvoiceDBRepository) FindAllOverdueInvoices(ctx context.Context) ([]models.OverdueInvoices, error) {
+func (r *InvoiceDBRepository) FindByOurNumber(ctx context.Context, bankCode string, ourNumber string) (*models.Invoice, error) {
 	const query = `
 		SELECT
-			a.student_id,
-			a.course_id,
-			COUNT(i.id) AS TOTAL
+			i.id,
+			a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at,
+			i.installment, i.due_date, i.value, i.created_at, i.paid_at, i.paid_value, i.late_fee, i.late_interest, i.late_charged_at, i.paid_charges,
+			i.bank_code, i.our_number, i.barcode, i.digitable_line, i.gross_value, i.discount,
+			i.cancelled_at, i.agreement_id, i.replaced_by, i.pix_txid, i.pix_location, i.pix_amount,
+			i.pix_created_at, i.pix_expires_at
+		FROM invoices i
+		INNER JOIN accounts a ON i.account_id = a.id
+		WHERE i.bank_code = $1 AND i.our_number = $2`
+
+	return sqlDB.NewQuery[models.Invoice](ctx, query, bankCode, ourNumber).One()
+}
+
+func (r *InvoiceDBRepository) FindAllPendingRemittanceForUpdate(ctx context.Context) ([]models.Invoice, error) {
+	const query = `
+		SELECT
+			i.id,
+			a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at,
+			i.installment, i.due_date, i.value, i.created_at, i.paid_at, i.paid_value, i.late_fee, i.late_interest, i.late_charged_at, i.paid_charges,
+			i.bank_code, i.our_number, i.barcode, i.digitable_line, i.gross_value, i.discount,
+			i.cancelled_at, i.agreement_id, i.replaced_by, i.pix_txid, i.pix_location, i.pix_amount,
+			i.pix_created_at, i.pix_expires_at
 		FROM invoices i
-		INNER JOIN accounts a ON i.account_id = a.id AND a.status = 'ADIMPLENTE'
-		WHERE i.due_date < CURRENT_DATE
-		GROUP BY a.student_id, a.course_id
-		HAVING COUNT(i.id) > 0`
+		INNER JOIN accounts a ON i.account_id = a.id
+		WHERE i.paid_at IS NULL AND i.cancelled_at IS NULL AND i.remitted_at IS NULL AND i.our_number <> ''
+		ORDER BY i.due_date, i.our_number
+		FOR UPDATE OF i`
+
+	return sqlDB.NewQuery[models.Invoice](ctx, query).Many()
+}
 
-	return sqlDB.NewQuery[models.OverdueInvoices](ctx, query).Many()
+func (r *InvoiceDBRepository) MarkAsRemitted(ctx context.Context, ids []uuid.UUID) error {
+	const query = `UPDATE invoices SET remitted_at = NOW() WHERE id = ANY($1::uuid[])`
+
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+
+	return sqlDB.NewStatement(ctx, query, pq.StringArray(values)).Execute()
 }
 
-func (r *InvoiceDBRepository) FindTotalOverdueInvoicesByAccount(ctx context.Context, id uuid.UUID) (*uint64, error) {
+func (r *InvoiceDBRepository) FindAllPendingWriteOffForUpdate(ctx context.Context) ([]models.Invoice, error) {
 	const query = `
 		SELECT
-			COUNT(i.id) AS TOTAL
+			i.id,
+			a.id, a.student_id, a.course_id, a.installments, a.value, a.status, a.created_at,
+			i.installment, i.due_date, i.value, i.created_at, i.paid_at, i.paid_value, i.late_fee, i.late_interest, i.late_charged_at, i.paid_charges,
+			i.bank_code, i.our_number, i.barcode, i.digitable_line, i.gross_value, i.discount,
+			i.cancelled_at, i.agreement_id, i.replaced_by, i.pix_txid, i.pix_location, i.pix_amount,
+			i.pix_created_at, i.pix_expires_at
 		FROM invoices i
-		INNER JOIN accounts a ON i.account_id = a.id AND a.id = $1
-		WHERE i.due_date < CURRENT_DATE`
+		INNER JOIN accounts a ON i.account_id = a.id
+		WHERE i.cancelled_at IS NOT NULL AND i.remitted_at IS NOT NULL AND i.written_off_at IS NULL
+		ORDER BY i.due_date, i.our_number
+		FOR UPDATE OF i`
+
+	return sqlDB.NewQuery[models.Invoice](ctx, query).Many()
+}
+
+func (r *InvoiceDBRepository) MarkAsWrittenOff(ctx context.Context, ids []uuid.UUID) error {
+	const query = `UPDATE invoices SET written_off_at = NOW() WHERE id = ANY($1::uuid[])`
+
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+
+	return sqlDB.NewStatement(ctx, query, pq.StringArray(values)).Execute()
+}
+
+func (r *InvoiceDBRepository) NextRemittanceSequence(ctx context.Context) (*int64, error) {
+	const query = `SELECT nextval('cnab_remittance_seq')`
+
+	return sqlDB.NewQuery[int64](ctx, query).One()
+}
+
+func (r *InvoiceDBRepository) FindOldestOpenDueDateByAccount(ctx context.Context, id uuid.UUID) (*types.NullDateTime, error) {
+	const query = `SELECT MIN(i.due_date) FROM invoices i WHERE i.account_id = $1 AND i.paid_at IS NULL AND i.cancelled_at IS NULL`
+
+	return sqlDB.NewQuery[types.NullDateTime](ctx, query, id).One()
+}
+
+func (r *InvoiceDBRepository) Cancel(ctx context.Context, ids []uuid.UUID, replacedBy uuid.NullUUID, cancelledAt time.Time) (int, error) {
+	const query = `
+		UPDATE invoices SET cancelled_at = $3, replaced_by = $2
+		WHERE id = ANY($1::uuid[]) AND paid_at IS NULL AND cancelled_at IS NULL
+		RETURNING TRUE`
+
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
 
-	return sqlDB.NewQuery[uint64](ctx, query).One()
+	cancelled, err := sqlDB.NewQuery[bool](ctx, query, pq.StringArray(values), replacedBy, cancelledAt).Many()
+	return len(cancelled), err
 }
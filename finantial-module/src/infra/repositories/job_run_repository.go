@@ -0,0 +1,69 @@
+//go:generate mockgen -source job_run_repository.go -destination mock/job_run_repository_mock.go -package repositoriesmock
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+)
+
+type JobRunRepository interface {
+	FindAll(ctx context.Context, filter *models.JobRunFilter) ([]models.JobRun, error)
+	Insert(ctx context.Context, run *models.JobRun) (bool, error)
+	Finish(ctx context.Context, run *models.JobRun) error
+	FailRunning(ctx context.Context, jobName string, finishedAt time.Time, reason string) (int, error)
+}
+
+type JobRunDBRepository struct{}
+
+func NewJobRunDBRepository() *JobRunDBRepository {
+	return &JobRunDBRepository{}
+}
+
+func (r *JobRunDBRepository) FindAll(ctx context.Context, filter *models.JobRunFilter) ([]models.JobRun, error) {
+	const query = `
+		SELECT id, job_name, trigger, status, scheduled_for, started_at, finished_at, error, instance
+		FROM job_runs
+		WHERE $1 = '' OR job_name = $1
+		ORDER BY started_at DESC
+		LIMIT $2`
+
+	return sqlDB.NewQuery[models.JobRun](ctx, query, filter.JobName, filter.Limit).Many()
+}
+
+// Insert records the start of a run. It returns false when the scheduled
+// occurrence was already claimed by another instance.
+func (r *JobRunDBRepository) Insert(ctx context.Context, run *models.JobRun) (bool, error) {
+	const query = `
+		INSERT INTO job_runs (id, job_name, trigger, status, scheduled_for, started_at, instance)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT DO NOTHING
+		RETURNING id`
+
+	id, err := sqlDB.NewQuery[string](ctx, query, run.ID, run.JobName, run.Trigger, run.Status, run.ScheduledFor, run.StartedAt, run.Instance).One()
+	if err != nil {
+		return false, err
+	}
+
+	return id != nil, nil
+}
+
+func (r *JobRunDBRepository) Finish(ctx context.Context, run *models.JobRun) error {
+	const query = `UPDATE job_runs SET status=$2, finished_at=$3, error=$4 WHERE id=$1`
+
+	return sqlDB.NewStatement(ctx, query, run.ID, run.Status, run.FinishedAt, run.Error).Execute()
+}
+
+// FailRunning marks as failed the runs of the job still recorded as running
+// and returns how many there were.
+func (r *JobRunDBRepository) FailRunning(ctx context.Context, jobName string, finishedAt time.Time, reason string) (int, error) {
+	const query = `
+		UPDATE job_runs SET status='FAILED', finished_at=$2, error=$3
+		WHERE job_name=$1 AND status='RUNNING'
+		RETURNING TRUE`
+
+	failed, err := sqlDB.NewQuery[bool](ctx, query, jobName, finishedAt, reason).Many()
+	return len(failed), err
+}
@@ -0,0 +1,48 @@
+//go:generate mockgen -source payer_repository.go -destination mock/payer_repository_mock.go -package repositoriesmock
+package repositories
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type PayerRepository interface {
+	FindByStudent(ctx context.Context, studentID uuid.UUID) (*models.Payer, error)
+	FindAllByStudents(ctx context.Context, studentIDs []uuid.UUID) ([]models.Payer, error)
+	Save(ctx context.Context, model *models.Payer) error
+}
+
+type PayerDBRepository struct{}
+
+func NewPayerDBRepository() *PayerDBRepository {
+	return &PayerDBRepository{}
+}
+
+func (r *PayerDBRepository) FindByStudent(ctx context.Context, studentID uuid.UUID) (*models.Payer, error) {
+	const query = `SELECT p.student_id, p.name, p.document, p.updated_at FROM payers p WHERE p.student_id = $1`
+
+	return sqlDB.NewQuery[models.Payer](ctx, query, studentID).One()
+}
+
+func (r *PayerDBRepository) FindAllByStudents(ctx context.Context, studentIDs []uuid.UUID) ([]models.Payer, error) {
+	const query = `SELECT p.student_id, p.name, p.document, p.updated_at FROM payers p WHERE p.student_id = ANY($1::uuid[])`
+
+	values := make([]string, 0, len(studentIDs))
+	for _, id := range studentIDs {
+		values = append(values, id.String())
+	}
+
+	return sqlDB.NewQuery[models.Payer](ctx, query, pq.StringArray(values)).Many()
+}
+
+func (r *PayerDBRepository) Save(ctx context.Context, model *models.Payer) error {
+	const query = `
+		INSERT INTO payers (student_id, name, document, updated_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (student_id) DO UPDATE SET name = EXCLUDED.name, document = EXCLUDED.document, updated_at = EXCLUDED.updated_at`
+
+	return sqlDB.NewStatement(ctx, query, model.StudentID, model.Name, model.Document, model.UpdatedAt).Execute()
+}
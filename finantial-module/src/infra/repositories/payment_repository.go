@@ -0,0 +1,52 @@
+//go:generate mockgen -source payment_repository.go -destination mock/payment_repository_mock.go -package repositoriesmock
+package repositories
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+	"github.com/google/uuid"
+)
+
+type PaymentRepository interface {
+	FindAllByInvoice(ctx context.Context, invoiceId uuid.UUID) ([]models.Payment, error)
+	FindAllByStudent(ctx context.Context, studentID uuid.UUID) ([]models.Payment, error)
+	Insert(ctx context.Context, payment *models.Payment) error
+}
+
+type PaymentDBRepository struct{}
+
+func NewPaymentDBRepository() *PaymentDBRepository {
+	return &PaymentDBRepository{}
+}
+
+func (r *PaymentDBRepository) FindAllByInvoice(ctx context.Context, invoiceId uuid.UUID) ([]models.Payment, error) {
+	const query = `
+		SELECT p.id, p.invoice_id, p.value, p.overpaid, p.method, p.paid_at, p.created_at
+		FROM payments p
+		WHERE p.invoice_id = $1
+		ORDER BY p.paid_at`
+
+	return sqlDB.NewQuery[models.Payment](ctx, query, invoiceId).Many()
+}
+
+func (r *PaymentDBRepository) FindAllByStudent(ctx context.Context, studentID uuid.UUID) ([]models.Payment, error) {
+	const query = `
+		SELECT p.id, p.invoice_id, p.value, p.overpaid, p.method, p.paid_at, p.created_at
+		FROM payments p
+		INNER JOIN invoices i ON p.invoice_id = i.id
+		INNER JOIN accounts a ON i.account_id = a.id
+		WHERE a.student_id = $1
+		ORDER BY p.paid_at, p.created_at`
+
+	return sqlDB.NewQuery[models.Payment](ctx, query, studentID).Many()
+}
+
+func (r *PaymentDBRepository) Insert(ctx context.Context, payment *models.Payment) error {
+	const query = `INSERT INTO payments (id, invoice_id, value, overpaid, method, paid_at, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	return sqlDB.NewStatement(ctx, query,
+		payment.ID, payment.InvoiceID, payment.Value, payment.Overpaid, payment.Method, payment.PaidAt, payment.CreatedAt,
+	).Execute()
+}
@@ -0,0 +1,62 @@
+//go:generate mockgen -source refund_repository.go -destination mock/refund_repository_mock.go -package repositoriesmock
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+	"github.com/google/uuid"
+)
+
+type RefundRepository interface {
+	FindAll(ctx context.Context, status enums.RefundStatus) ([]models.Refund, error)
+	FindById(ctx context.Context, id uuid.UUID) (*models.Refund, error)
+	Insert(ctx context.Context, model *models.Refund) error
+	// MarkAsPaid reports whether the refund was still pending.
+	MarkAsPaid(ctx context.Context, id uuid.UUID, paidAt time.Time) (bool, error)
+}
+
+type RefundDBRepository struct{}
+
+func NewRefundDBRepository() *RefundDBRepository {
+	return &RefundDBRepository{}
+}
+
+// FindAll returns the refunds in the given status, or all of them when the
+// status is empty, oldest first.
+func (r *RefundDBRepository) FindAll(ctx context.Context, status enums.RefundStatus) ([]models.Refund, error) {
+	const query = `
+		SELECT r.id, r.account_id, r.cancellation_id, r.value, r.status, r.created_at, r.paid_at
+		FROM refunds r
+		WHERE $1 = '' OR r.status::text = $1
+		ORDER BY r.created_at`
+
+	return sqlDB.NewQuery[models.Refund](ctx, query, status).Many()
+}
+
+func (r *RefundDBRepository) FindById(ctx context.Context, id uuid.UUID) (*models.Refund, error) {
+	const query = `
+		SELECT r.id, r.account_id, r.cancellation_id, r.value, r.status, r.created_at, r.paid_at
+		FROM refunds r
+		WHERE r.id = $1`
+
+	return sqlDB.NewQuery[models.Refund](ctx, query, id).One()
+}
+
+func (r *RefundDBRepository) Insert(ctx context.Context, model *models.Refund) error {
+	const query = `
+		INSERT INTO refunds (id, account_id, cancellation_id, value, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	return sqlDB.NewStatement(ctx, query, model.ID, model.AccountID, model.CancellationID, model.Value, model.Status, model.CreatedAt).Execute()
+}
+
+func (r *RefundDBRepository) MarkAsPaid(ctx context.Context, id uuid.UUID, paidAt time.Time) (bool, error) {
+	const query = `UPDATE refunds SET status = 'PAID', paid_at = $2 WHERE id = $1 AND status = 'PENDING' RETURNING TRUE`
+
+	updated, err := sqlDB.NewQuery[bool](ctx, query, id, paidAt).One()
+	return updated != nil, err
+}
@@ -0,0 +1,67 @@
+//go:generate mockgen -source lock.go -destination mock/lock_mock.go -package schedulermock
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/config"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+)
+
+const lockDatabaseName = "SCHEDULER LOCK"
+
+type Locker interface {
+	// TryLock acquires the named lock without waiting. The returned function
+	// releases it and is nil when the lock is held elsewhere.
+	TryLock(ctx context.Context, name string) (func(), error)
+}
+
+// AdvisoryLocker elects the instance running a job through Postgres session
+// advisory locks. Each lock pins a connection of a dedicated pool, so a
+// crashed instance releases its locks as soon as its session ends.
+type AdvisoryLocker struct {
+	once sync.Once
+	db   *sql.DB
+}
+
+func NewAdvisoryLocker() *AdvisoryLocker {
+	return &AdvisoryLocker{}
+}
+
+func (l *AdvisoryLocker) instance() *sql.DB {
+	l.once.Do(func() {
+		l.db = sqlDB.NewSQLDatabaseInstance(lockDatabaseName, config.SQL_DB_CONNECTION_URI)
+		l.db.SetMaxOpenConns(5)
+		l.db.SetMaxIdleConns(1)
+	})
+
+	return l.db
+}
+
+func (l *AdvisoryLocker) TryLock(ctx context.Context, name string) (func(), error) {
+	conn, err := l.instance().Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", name).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if !acquired {
+		conn.Close()
+		return nil, nil
+	}
+
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", name); err != nil {
+			logging.Error(context.Background()).Err(err).AddParam("lock", name).Msg("Could not release advisory lock")
+		}
+		conn.Close()
+	}, nil
+}
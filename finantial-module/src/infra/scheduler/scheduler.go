@@ -0,0 +1,359 @@
+//go:generate mockgen -source scheduler.go -destination mock/scheduler_mock.go -package schedulermock
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/observer"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultTimezone = "America/Sao_Paulo"
+	disabledSpec    = "off"
+
+	interruptedRunError = "job run interrupted before it finished"
+)
+
+var (
+	instance     *JobScheduler
+	instanceOnce sync.Once
+)
+
+type Scheduler interface {
+	Jobs() []models.Job
+	Trigger(ctx context.Context, name string) (*models.JobRun, error)
+}
+
+// JobScheduler runs registered jobs on their cron schedules. Every instance
+// of the service runs the loop, but a run only happens on the instance that
+// takes the job's advisory lock and claims the occurrence in job_runs. The
+// lock is held until the run records its result, so a run still recorded as
+// running while its job's lock is free was interrupted, e.g. by a crash, and
+// is closed as failed by the next instance that takes the lock.
+type JobScheduler struct {
+	Repository repositories.JobRunRepository
+	Locker     Locker
+	Location   *time.Location
+	Instance   string
+
+	mu      sync.Mutex
+	jobs    map[string]*job
+	started bool
+	stop    chan struct{}
+	running sync.WaitGroup
+}
+
+type job struct {
+	name     string
+	spec     string
+	schedule *Schedule
+	run      func(ctx context.Context) error
+}
+
+// Instance returns the scheduler shared by the application.
+func Instance() *JobScheduler {
+	instanceOnce.Do(func() {
+		instance = NewJobScheduler()
+	})
+
+	return instance
+}
+
+func NewJobScheduler() *JobScheduler {
+	location, err := time.LoadLocation(envOrDefault("SCHEDULER_TIMEZONE", defaultTimezone))
+	if err != nil {
+		logging.Error(context.Background()).Err(err).Msg("Invalid scheduler timezone, using UTC")
+		location = time.UTC
+	}
+
+	hostname, _ := os.Hostname()
+
+	return &JobScheduler{
+		Repository: repositories.NewJobRunDBRepository(),
+		Locker:     NewAdvisoryLocker(),
+		Location:   location,
+		Instance:   hostname,
+		jobs:       map[string]*job{},
+		stop:       make(chan struct{}),
+	}
+}
+
+// Register adds a job whose cron expression is read from the given env var,
+// falling back to defaultSpec. Setting the variable to "off" keeps the job
+// available for manual triggers only.
+func (s *JobScheduler) Register(name, specEnv, defaultSpec string, run func(ctx context.Context) error) error {
+	spec := envOrDefault(specEnv, defaultSpec)
+
+	var schedule *Schedule
+	if spec != disabledSpec {
+		var err error
+		if schedule, err = ParseSchedule(spec, s.Location); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.jobs[name]; exists {
+		return fmt.Errorf("job %q already registered", name)
+	}
+
+	s.jobs[name] = &job{name: name, spec: spec, schedule: schedule, run: run}
+	return nil
+}
+
+// Start closes the interrupted runs of every registered job not running
+// elsewhere and launches their scheduling loops. It is a no-op when
+// SCHEDULER_ENABLED is "false".
+func (s *JobScheduler) Start() {
+	if os.Getenv("SCHEDULER_ENABLED") == "false" {
+		logging.Info(context.Background()).Msg("Scheduler disabled")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return
+	}
+	s.started = true
+
+	for _, j := range s.jobs {
+		s.closeInterrupted(j)
+
+		if j.schedule == nil {
+			continue
+		}
+
+		s.running.Add(1)
+		go s.loop(j)
+	}
+
+	observer.Attach(s)
+	logging.Info(context.Background()).AddParam("jobs", len(s.jobs)).Msg("Scheduler started")
+}
+
+// Close stops the scheduling loops and waits for running jobs to finish.
+func (s *JobScheduler) Close() {
+	close(s.stop)
+	s.running.Wait()
+	logging.Info(context.Background()).Msg("Scheduler stopped")
+}
+
+func (s *JobScheduler) Jobs() []models.Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	result := make([]models.Job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		item := models.Job{Name: j.name, Spec: j.spec}
+		if j.schedule != nil {
+			if next := j.schedule.Next(now); !next.IsZero() {
+				item.NextRun = types.NullDateTime{Time: next, Valid: true}
+			}
+		}
+		result = append(result, item)
+	}
+
+	slices.SortFunc(result, func(a, b models.Job) int {
+		if a.Name < b.Name {
+			return -1
+		}
+		if a.Name > b.Name {
+			return 1
+		}
+		return 0
+	})
+
+	return result
+}
+
+// Trigger starts a manual run in background and returns its record. It fails
+// with exceptions.ErrJobAlreadyRunning when any instance is running the job.
+func (s *JobScheduler) Trigger(ctx context.Context, name string) (*models.JobRun, error) {
+	s.mu.Lock()
+	j, ok := s.jobs[name]
+	s.mu.Unlock()
+
+	if !ok {
+		return nil, errors.New(exceptions.ErrJobNotFound)
+	}
+
+	run, release, err := s.claim(ctx, j, enums.MANUAL, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	if run == nil {
+		return nil, errors.New(exceptions.ErrJobAlreadyRunning)
+	}
+
+	s.running.Add(1)
+	go func() {
+		defer s.running.Done()
+		defer release()
+		s.execute(context.WithoutCancel(ctx), j, run)
+	}()
+
+	return run, nil
+}
+
+func (s *JobScheduler) loop(j *job) {
+	defer s.running.Done()
+
+	for {
+		next := j.schedule.Next(time.Now())
+		if next.IsZero() {
+			logging.Warn(context.Background()).AddParam("job", j.name).Msg("Job has no future occurrences")
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-s.stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		ctx := context.Background()
+		run, release, err := s.claim(ctx, j, enums.SCHEDULED, next)
+		if err != nil {
+			logging.Error(ctx).Err(err).AddParam("job", j.name).Msg("Could not claim scheduled job run")
+			continue
+		}
+
+		if run == nil {
+			logging.Debug(ctx).AddParam("job", j.name).AddParam("scheduledFor", next).Msg("Job run claimed by another instance")
+			continue
+		}
+
+		s.execute(ctx, j, run)
+		release()
+	}
+}
+
+// claim takes the job lock and records the run. A nil run means another
+// instance holds the lock or already ran this scheduled occurrence.
+func (s *JobScheduler) claim(ctx context.Context, j *job, trigger enums.JobTrigger, scheduledFor time.Time) (*models.JobRun, func(), error) {
+	release, err := s.Locker.TryLock(ctx, "job:"+j.name)
+	if err != nil || release == nil {
+		return nil, nil, err
+	}
+
+	if err := s.failInterrupted(ctx, j); err != nil {
+		release()
+		return nil, nil, err
+	}
+
+	run := &models.JobRun{
+		ID:        uuid.New(),
+		JobName:   j.name,
+		Trigger:   trigger,
+		Status:    enums.RUNNING,
+		StartedAt: time.Now(),
+		Instance:  s.Instance,
+	}
+	if !scheduledFor.IsZero() {
+		run.ScheduledFor = types.NullDateTime{Time: scheduledFor, Valid: true}
+	}
+
+	inserted, err := s.Repository.Insert(ctx, run)
+	if err != nil || !inserted {
+		release()
+		return nil, nil, err
+	}
+
+	return run, release, nil
+}
+
+// closeInterrupted fails the interrupted runs of the job unless another
+// instance holds its lock.
+func (s *JobScheduler) closeInterrupted(j *job) {
+	ctx := context.Background()
+	release, err := s.Locker.TryLock(ctx, "job:"+j.name)
+	if err != nil {
+		logging.Error(ctx).Err(err).AddParam("job", j.name).Msg("Could not lock job to close its interrupted runs")
+		return
+	}
+
+	if release == nil {
+		return
+	}
+	defer release()
+
+	if err := s.failInterrupted(ctx, j); err != nil {
+		logging.Error(ctx).Err(err).AddParam("job", j.name).Msg("Could not close interrupted job runs")
+	}
+}
+
+// failInterrupted fails the runs of the job left running. It must be called
+// while holding the job lock.
+func (s *JobScheduler) failInterrupted(ctx context.Context, j *job) error {
+	failed, err := s.Repository.FailRunning(ctx, j.name, time.Now(), interruptedRunError)
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		logging.Warn(ctx).AddParam("job", j.name).AddParam("runs", failed).Msg("Closed interrupted job runs")
+	}
+
+	return nil
+}
+
+func (s *JobScheduler) execute(ctx context.Context, j *job, run *models.JobRun) {
+	logging.Info(ctx).AddParam("job", j.name).AddParam("runID", run.ID).AddParam("trigger", run.Trigger).Msg("Job started")
+
+	err := safeRun(ctx, j.run)
+
+	run.Status = enums.SUCCEEDED
+	if err != nil {
+		run.Status = enums.FAILED
+		run.Error = err.Error()
+		logging.Error(ctx).Err(err).AddParam("job", j.name).AddParam("runID", run.ID).Msg("Job failed")
+	}
+	run.FinishedAt = types.NullDateTime{Time: time.Now(), Valid: true}
+
+	if err := s.Repository.Finish(ctx, run); err != nil {
+		logging.Error(ctx).Err(err).AddParam("job", j.name).AddParam("runID", run.ID).Msg("Could not record job run result")
+		return
+	}
+
+	logging.Info(ctx).AddParam("job", j.name).AddParam("runID", run.ID).AddParam("status", run.Status).Msg("Job finished")
+}
+
+func safeRun(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return fn(ctx)
+}
+
+func envOrDefault(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+
+	return fallback
+}
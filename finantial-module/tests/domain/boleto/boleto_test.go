@@ -0,0 +1,142 @@
+package boleto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/boleto"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// fixedLayout returns a given free field, to check the generic part of the
+// barcode against boletos issued by other banks.
+type fixedLayout struct {
+	bankCode  string
+	freeField string
+}
+
+func (l fixedLayout) BankCode() string                 { return l.bankCode }
+func (l fixedLayout) OurNumber(int64) (string, error)  { return "", nil }
+func (l fixedLayout) FreeField(string) (string, error) { return l.freeField, nil }
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestGenerate(t *testing.T) {
+	t.Run("Should match a boleto issued by Bradesco", func(t *testing.T) {
+		layout := fixedLayout{bankCode: "237", freeField: "3381260007827139500006330"}
+
+		result, err := boleto.Generate(layout, "", date(2018, time.June, 11), 3700_00)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "23799755200003700003381260007827139500006330", result.Barcode)
+		assert.Equal(t, "23793381286000782713695000063305975520000370000", result.DigitableLine)
+	})
+
+	t.Run("Should generate Banco do Brasil boleto for 7 digit agreements", func(t *testing.T) {
+		layout, err := boleto.NewBancoDoBrasil("1234567", "17")
+		assert.NoError(t, err)
+
+		ourNumber, err := layout.OurNumber(42)
+		assert.NoError(t, err)
+		assert.Equal(t, "12345670000000042", ourNumber)
+
+		result, err := boleto.Generate(layout, ourNumber, date(2025, time.March, 10), 1500_75)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "001", result.BankCode)
+		assert.Equal(t, ourNumber, result.OurNumber)
+		assert.Equal(t, "00196101600001500750000001234567000000004217", result.Barcode)
+		assert.Equal(t, "00190000090123456700400000042176610160000150075", result.DigitableLine)
+	})
+
+	t.Run("Should generate Itaú boleto with our number and account check digits", func(t *testing.T) {
+		layout, err := boleto.NewItau("0057", "12345", "109")
+		assert.NoError(t, err)
+
+		ourNumber, err := layout.OurNumber(42)
+		assert.NoError(t, err)
+		assert.Equal(t, "00000042", ourNumber)
+
+		result, err := boleto.Generate(layout, ourNumber, date(2024, time.December, 20), 999_90)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "341", result.BankCode)
+		assert.Equal(t, "34191993600000999901090000004200057123457000", result.Barcode)
+		assert.Equal(t, "34191090080000420005171234570001199360000099990", result.DigitableLine)
+	})
+
+	t.Run("Should use the Itaú manual check digits", func(t *testing.T) {
+		layout, err := boleto.NewItau("0057", "12345", "110")
+		assert.NoError(t, err)
+
+		freeField, err := layout.FreeField("12345678")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1101234567880057123457000", freeField)
+	})
+}
+
+func TestGenerate_DueDateFactor(t *testing.T) {
+	layout := fixedLayout{bankCode: "237", freeField: "3381260007827139500006330"}
+
+	tests := []struct {
+		name     string
+		dueDate  time.Time
+		expected string
+	}{
+		{"Should start at 1000 on 2000-07-03", date(2000, time.July, 3), "1000"},
+		{"Should reach 9999 on 2025-02-21", date(2025, time.February, 21), "9999"},
+		{"Should roll over to 1000 on 2025-02-22", date(2025, time.February, 22), "1000"},
+		{"Should keep counting after the rollover", date(2025, time.February, 23), "1001"},
+		{"Should ignore the time of day", time.Date(2025, time.February, 22, 23, 59, 0, 0, time.UTC), "1000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := boleto.Generate(layout, "", tt.dueDate, 1_00)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result.Barcode[5:9])
+		})
+	}
+
+	t.Run("Should reject due dates before the first factor", func(t *testing.T) {
+		_, err := boleto.Generate(layout, "", date(2000, time.July, 2), 1_00)
+
+		assert.ErrorIs(t, err, boleto.ErrDueDateOutOfRange)
+	})
+}
+
+func TestGenerate_Value(t *testing.T) {
+	layout := fixedLayout{bankCode: "237", freeField: "3381260007827139500006330"}
+
+	for _, value := range []models.Money{0, -1, 100_000_000_00} {
+		_, err := boleto.Generate(layout, "", date(2025, time.March, 10), value)
+
+		assert.ErrorIs(t, err, boleto.ErrValueOutOfRange)
+	}
+}
+
+func TestNewBankLayout(t *testing.T) {
+	t.Run("Should reject invalid settings", func(t *testing.T) {
+		_, err := boleto.NewBankLayout("001", map[string]string{"agreement": "123", "wallet": "17"})
+		assert.Error(t, err)
+
+		_, err = boleto.NewBankLayout("341", map[string]string{"agency": "57", "account": "12345", "wallet": "109"})
+		assert.Error(t, err)
+
+		_, err = boleto.NewBankLayout("999", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should reject our number sequences out of range", func(t *testing.T) {
+		layout, err := boleto.NewItau("0057", "12345", "109")
+		assert.NoError(t, err)
+
+		_, err = layout.OurNumber(100_000_000)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,140 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func saoPaulo(t *testing.T) *time.Location {
+	location, err := time.LoadLocation("America/Sao_Paulo")
+	assert.NoError(t, err)
+	return location
+}
+
+func TestEaster(t *testing.T) {
+	tests := []struct {
+		name     string
+		year     int
+		expected time.Time
+	}{
+		{"Should return Easter of 2000", 2000, date(2000, time.April, 23)},
+		{"Should return Easter of 2019", 2019, date(2019, time.April, 21)},
+		{"Should return Easter of 2024", 2024, date(2024, time.March, 31)},
+		{"Should return Easter of 2025", 2025, date(2025, time.April, 20)},
+		{"Should return Easter of 2026", 2026, date(2026, time.April, 5)},
+		{"Should return the latest possible Easter", 2038, date(2038, time.April, 25)},
+		{"Should return the earliest possible Easter", 2285, date(2285, time.March, 22)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := calendar.Easter(tt.year)
+
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, time.Sunday, result.Weekday())
+		})
+	}
+}
+
+func TestNationalHolidays(t *testing.T) {
+	holidays := func(year int) map[time.Time]string {
+		result := map[time.Time]string{}
+		for _, holiday := range calendar.NationalHolidays(year) {
+			result[holiday.Date] = holiday.Name
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		year     int
+		date     time.Time
+		expected string
+	}{
+		{"Should include Carnival Monday", 2024, date(2024, time.February, 12), "Carnaval"},
+		{"Should include Carnival Tuesday", 2024, date(2024, time.February, 13), "Carnaval"},
+		{"Should include Good Friday", 2024, date(2024, time.March, 29), "Sexta-feira da Paixão"},
+		{"Should include Corpus Christi", 2024, date(2024, time.May, 30), "Corpus Christi"},
+		{"Should move Carnival with Easter", 2025, date(2025, time.March, 4), "Carnaval"},
+		{"Should move Corpus Christi with Easter", 2025, date(2025, time.June, 19), "Corpus Christi"},
+		{"Should include Tiradentes", 2025, date(2025, time.April, 21), "Tiradentes"},
+		{"Should include Black Consciousness Day from 2024", 2024, date(2024, time.November, 20), "Dia Nacional de Zumbi e da Consciência Negra"},
+		{"Should include Good Friday after Tiradentes in a late Easter", 2038, date(2038, time.April, 23), "Sexta-feira da Paixão"},
+		{"Should include Christmas", 2023, date(2023, time.December, 25), "Natal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, holidays(tt.year)[tt.date])
+		})
+	}
+
+	t.Run("Should not include Black Consciousness Day before 2024", func(t *testing.T) {
+		assert.NotContains(t, holidays(2023), date(2023, time.November, 20))
+	})
+
+	t.Run("Should return the holidays ordered by date", func(t *testing.T) {
+		for _, year := range []int{2023, 2024, 2025, 2038} {
+			result := calendar.NationalHolidays(year)
+			for i := 1; i < len(result); i++ {
+				assert.False(t, result[i].Date.Before(result[i-1].Date), "%d: %s", year, result[i].Name)
+			}
+		}
+	})
+}
+
+func TestCalendar_NextBusinessDay(t *testing.T) {
+	cal := calendar.New(saoPaulo(t), []calendar.Holiday{
+		{Date: date(2024, time.January, 25), Name: "Aniversário de São Paulo"},
+	})
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected time.Time
+	}{
+		{"Should keep a business day", date(2024, time.March, 5), date(2024, time.March, 5)},
+		{"Should drop the time of day", time.Date(2024, time.March, 5, 18, 45, 0, 0, time.UTC), date(2024, time.March, 5)},
+		{"Should move Saturday to Monday", date(2024, time.March, 9), date(2024, time.March, 11)},
+		{"Should move Sunday to Monday", date(2024, time.March, 10), date(2024, time.March, 11)},
+		{"Should move Good Friday past the weekend", date(2024, time.March, 29), date(2024, time.April, 1)},
+		{"Should move Carnival Monday past Tuesday", date(2024, time.February, 12), date(2024, time.February, 14)},
+		{"Should move a custom holiday", date(2024, time.January, 25), date(2024, time.January, 26)},
+		{"Should move Black Consciousness Day from 2024", date(2024, time.November, 20), date(2024, time.November, 21)},
+		{"Should keep November 20 before 2024", date(2023, time.November, 20), date(2023, time.November, 20)},
+		{"Should move a holiday on Friday past the weekend", date(2024, time.November, 15), date(2024, time.November, 18)},
+		{"Should move New Year past the year", date(2023, time.December, 30), date(2024, time.January, 2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, cal.NextBusinessDay(tt.date))
+		})
+	}
+}
+
+func TestCalendar_Day(t *testing.T) {
+	cal := calendar.New(saoPaulo(t), nil)
+
+	tests := []struct {
+		name     string
+		instant  time.Time
+		expected time.Time
+	}{
+		{"Should keep the previous local day before 03:00 UTC", time.Date(2024, time.March, 1, 2, 59, 0, 0, time.UTC), date(2024, time.February, 29)},
+		{"Should move to the local day from 03:00 UTC", time.Date(2024, time.March, 1, 3, 0, 0, 0, time.UTC), date(2024, time.March, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, cal.Day(tt.instant))
+		})
+	}
+}
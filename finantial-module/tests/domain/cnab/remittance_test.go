@@ -0,0 +1,220 @@
+package cnab
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/cnab"
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+func remittance(format cnab.Format) cnab.Remittance {
+	return cnab.Remittance{
+		Format:    format,
+		Sequence:  42,
+		CreatedAt: time.Date(2024, time.March, 5, 14, 30, 15, 0, time.UTC),
+		Company: cnab.Company{
+			Name:      "Escola Colibri Ltda",
+			Document:  "11.222.333/0001-81",
+			BankCode:  "341",
+			BankName:  "Banco Itaú",
+			Agency:    "1234",
+			Account:   "56789",
+			Agreement: "998877",
+			Wallet:    "109",
+		},
+		Titles: []cnab.Title{
+			{
+				OurNumber:     "00000123",
+				Reference:     "0a1b2c3d4e5f60718293a4b5c",
+				DueDate:       time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC),
+				IssuedAt:      time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+				Value:         1500_50,
+				PayerDocument: "529.982.247-25",
+				PayerName:     "João da Silva",
+			},
+			{
+				OurNumber:     "00000124",
+				Reference:     "ffeeddccbbaa99887766554433",
+				DueDate:       time.Date(2024, time.May, 10, 0, 0, 0, 0, time.UTC),
+				IssuedAt:      time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+				Value:         99,
+				PayerDocument: "11222333000181",
+				PayerName:     "Empresa Pagadora com um nome maior que o campo permite",
+			},
+		},
+	}
+}
+
+// golden compares the content with the golden file, rewriting it when the
+// tests run with -update.
+func golden(t *testing.T, name string, content []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		assert.NoError(t, os.WriteFile(path, content, 0o644))
+	}
+
+	expected, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(content))
+}
+
+// lines splits the file and checks every line has the layout length.
+func lines(t *testing.T, content []byte, format cnab.Format) []string {
+	t.Helper()
+
+	assert.True(t, strings.HasSuffix(string(content), "\r\n"))
+	result := strings.Split(strings.TrimSuffix(string(content), "\r\n"), "\r\n")
+	for i, line := range result {
+		assert.Len(t, line, int(format), "line %d", i+1)
+	}
+
+	return result
+}
+
+// at returns the field at the one based, inclusive positions of the manuals.
+func at(line string, start, end int) string {
+	return line[start-1 : end]
+}
+
+func TestWriteRemittance_400(t *testing.T) {
+	content, err := cnab.WriteRemittance(remittance(cnab.Format400))
+	assert.NoError(t, err)
+
+	golden(t, "remittance_400.rem", content)
+
+	result := lines(t, content, cnab.Format400)
+	assert.Len(t, result, 4)
+
+	header := result[0]
+	assert.Equal(t, "01REMESSA01COBRANCA       ", at(header, 1, 26))
+	assert.Equal(t, "1234", at(header, 27, 30))
+	assert.Equal(t, "056789", at(header, 33, 38))
+	assert.Equal(t, "341", at(header, 77, 79))
+	assert.Equal(t, "BANCO ITAU     ", at(header, 80, 94))
+	assert.Equal(t, "050324", at(header, 95, 100))
+	assert.Equal(t, "0000042", at(header, 101, 107))
+	assert.Equal(t, "000001", at(header, 395, 400))
+
+	first := result[1]
+	assert.Equal(t, "1", at(first, 1, 1))
+	assert.Equal(t, "11222333000181", at(first, 4, 17))
+	assert.Equal(t, "0A1B2C3D4E5F60718293A4B5C", at(first, 38, 62))
+	assert.Equal(t, "00000123            ", at(first, 63, 82))
+	assert.Equal(t, "109", at(first, 106, 108))
+	assert.Equal(t, "01", at(first, 109, 110))
+	assert.Equal(t, "100424", at(first, 121, 126))
+	assert.Equal(t, "0000000150050", at(first, 127, 139))
+	assert.Equal(t, "010324", at(first, 151, 156))
+	assert.Equal(t, "01", at(first, 219, 220))
+	assert.Equal(t, "00052998224725", at(first, 221, 234))
+	assert.Equal(t, "JOAO DA SILVA", strings.TrimSpace(at(first, 235, 264)))
+	assert.Equal(t, "000002", at(first, 395, 400))
+
+	second := result[2]
+	assert.Equal(t, "0000000000099", at(second, 127, 139))
+	assert.Equal(t, "02", at(second, 219, 220))
+	assert.Equal(t, "11222333000181", at(second, 221, 234))
+	assert.Equal(t, "EMPRESA PAGADORA COM UM NOME M", at(second, 235, 264))
+	assert.Equal(t, "000003", at(second, 395, 400))
+
+	trailer := result[3]
+	assert.Equal(t, "9", at(trailer, 1, 1))
+	assert.Equal(t, "000004", at(trailer, 395, 400))
+}
+
+func TestWriteRemittance_240(t *testing.T) {
+	content, err := cnab.WriteRemittance(remittance(cnab.Format240))
+	assert.NoError(t, err)
+
+	golden(t, "remittance_240.rem", content)
+
+	result := lines(t, content, cnab.Format240)
+	assert.Len(t, result, 8)
+
+	header := result[0]
+	assert.Equal(t, "34100000", at(header, 1, 8))
+	assert.Equal(t, "2", at(header, 18, 18))
+	assert.Equal(t, "11222333000181", at(header, 19, 32))
+	assert.Equal(t, "05032024", at(header, 144, 151))
+	assert.Equal(t, "143015", at(header, 152, 157))
+	assert.Equal(t, "000042", at(header, 158, 163))
+
+	lotHeader := result[1]
+	assert.Equal(t, "34100011R01", at(lotHeader, 1, 11))
+	assert.Equal(t, "00000042", at(lotHeader, 184, 191))
+
+	segmentP := result[2]
+	assert.Equal(t, "34100013", at(segmentP, 1, 8))
+	assert.Equal(t, "00001", at(segmentP, 9, 13))
+	assert.Equal(t, "P", at(segmentP, 14, 14))
+	assert.Equal(t, "00000123            ", at(segmentP, 38, 57))
+	assert.Equal(t, "10042024", at(segmentP, 78, 85))
+	assert.Equal(t, "000000000150050", at(segmentP, 86, 100))
+	assert.Equal(t, "01032024", at(segmentP, 110, 117))
+	assert.Equal(t, "0A1B2C3D4E5F60718293A4B5C", at(segmentP, 196, 220))
+
+	segmentQ := result[3]
+	assert.Equal(t, "00002", at(segmentQ, 9, 13))
+	assert.Equal(t, "Q", at(segmentQ, 14, 14))
+	assert.Equal(t, "1", at(segmentQ, 18, 18))
+	assert.Equal(t, "000052998224725", at(segmentQ, 19, 33))
+	assert.Equal(t, "JOAO DA SILVA", strings.TrimSpace(at(segmentQ, 34, 73)))
+
+	assert.Equal(t, "00003", at(result[4], 9, 13))
+	assert.Equal(t, "2", at(result[5], 18, 18))
+	assert.Equal(t, "011222333000181", at(result[5], 19, 33))
+
+	lotTrailer := result[6]
+	assert.Equal(t, "34100015", at(lotTrailer, 1, 8))
+	assert.Equal(t, "000006", at(lotTrailer, 18, 23))
+
+	trailer := result[7]
+	assert.Equal(t, "34199999", at(trailer, 1, 8))
+	assert.Equal(t, "000001", at(trailer, 18, 23))
+	assert.Equal(t, "000008", at(trailer, 24, 29))
+}
+
+func TestWriteRemittance_WriteOff(t *testing.T) {
+	writeOff := func(format cnab.Format) cnab.Remittance {
+		result := remittance(format)
+		result.Titles[1].Instruction = cnab.InstructionWriteOff
+		return result
+	}
+
+	t.Run("Should request the write-off of the title in CNAB 400", func(t *testing.T) {
+		content, err := cnab.WriteRemittance(writeOff(cnab.Format400))
+		assert.NoError(t, err)
+
+		result := lines(t, content, cnab.Format400)
+		assert.Equal(t, "01", at(result[1], 109, 110))
+		assert.Equal(t, "02", at(result[2], 109, 110))
+		assert.Equal(t, "00000124            ", at(result[2], 63, 82))
+	})
+
+	t.Run("Should request the write-off of the title in CNAB 240", func(t *testing.T) {
+		content, err := cnab.WriteRemittance(writeOff(cnab.Format240))
+		assert.NoError(t, err)
+
+		result := lines(t, content, cnab.Format240)
+		assert.Equal(t, "01", at(result[2], 16, 17))
+		assert.Equal(t, "01", at(result[3], 16, 17))
+		assert.Equal(t, "02", at(result[4], 16, 17))
+		assert.Equal(t, "00000124            ", at(result[4], 38, 57))
+		assert.Equal(t, "02", at(result[5], 16, 17))
+	})
+}
+
+func TestWriteRemittance_UnsupportedFormat(t *testing.T) {
+	_, err := cnab.WriteRemittance(remittance(cnab.Format(300)))
+
+	assert.Error(t, err)
+}
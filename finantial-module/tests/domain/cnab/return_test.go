@@ -0,0 +1,103 @@
+package cnab
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/cnab"
+	"github.com/stretchr/testify/assert"
+)
+
+func readReturn(t *testing.T, name string) *cnab.ReturnFile {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	assert.NoError(t, err)
+
+	file, err := cnab.ParseReturn(data)
+	assert.NoError(t, err)
+
+	return file
+}
+
+func TestParseReturn_400(t *testing.T) {
+	file := readReturn(t, "return_400.ret")
+
+	assert.Equal(t, cnab.Format400, file.Format)
+	assert.Equal(t, "341", file.BankCode)
+	assert.Equal(t, []cnab.ReturnRecord{
+		{
+			Line:       2,
+			OurNumber:  "00000123",
+			Reference:  "0A1B2C3D4E5F60718293A4B5C",
+			Occurrence: cnab.OccurrenceSettled,
+			Value:      1500_50,
+			PaidValue:  1530_50,
+			Charges:    30_00,
+			OccurredAt: time.Date(2024, time.April, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Line:       3,
+			OurNumber:  "00000124",
+			Reference:  "FFEEDDCCBBAA9988776655443",
+			Occurrence: cnab.OccurrenceEntryConfirmed,
+			Value:      99,
+			OccurredAt: time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC),
+		},
+	}, file.Records)
+	assert.True(t, file.Records[0].IsSettlement())
+	assert.False(t, file.Records[1].IsSettlement())
+
+	assert.Equal(t, []cnab.LineError{
+		{Line: 4, Message: `invalid value at positions 153-165: "00000001500X0"`},
+		{Line: 5, Message: "expected 400 characters, got 12"},
+	}, file.Errors)
+}
+
+func TestParseReturn_240(t *testing.T) {
+	file := readReturn(t, "return_240.ret")
+
+	assert.Equal(t, cnab.Format240, file.Format)
+	assert.Equal(t, "341", file.BankCode)
+	assert.Equal(t, []cnab.ReturnRecord{
+		{
+			Line:       3,
+			OurNumber:  "00000123",
+			Reference:  "0A1B2C3D4E5F60718293A4B5C",
+			Occurrence: cnab.OccurrenceSettled,
+			Value:      1500_50,
+			PaidValue:  1530_50,
+			Charges:    30_00,
+			OccurredAt: time.Date(2024, time.April, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Line:       5,
+			OurNumber:  "00000124",
+			Reference:  "FFEEDDCCBBAA9988776655443",
+			Occurrence: cnab.OccurrenceEntryRejected,
+			Value:      99,
+		},
+	}, file.Records)
+	assert.True(t, file.Records[1].IsRejection())
+
+	assert.Equal(t, []cnab.LineError{
+		{Line: 8, Message: `invalid date at positions 138-145: "31022024"`},
+		{Line: 9, Message: "segment U without preceding segment T"},
+	}, file.Errors)
+}
+
+func TestParseReturn_Invalid(t *testing.T) {
+	t.Run("Should reject an empty file", func(t *testing.T) {
+		_, err := cnab.ParseReturn(nil)
+
+		assert.ErrorIs(t, err, cnab.ErrEmptyReturnFile)
+	})
+
+	t.Run("Should reject an unknown line length", func(t *testing.T) {
+		_, err := cnab.ParseReturn([]byte("0RETORNO\r\n"))
+
+		assert.EqualError(t, err, "unsupported CNAB line length: 8")
+	})
+}
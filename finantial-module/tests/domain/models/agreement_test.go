@@ -0,0 +1,184 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgreementRequest_Prepare(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		request models.AgreementRequest
+		err     string
+	}{
+		{"Should accept an agreement without down payment", models.AgreementRequest{InvoiceIDs: []uuid.UUID{first}, Installments: 3}, ""},
+		{"Should accept the maximum installments", models.AgreementRequest{InvoiceIDs: []uuid.UUID{first}, Installments: 60, DownPayment: 100_00}, ""},
+		{"Should require the renegotiated invoices", models.AgreementRequest{Installments: 3}, "campo Parcelas renegociadas é requerido"},
+		{"Should require the installments", models.AgreementRequest{InvoiceIDs: []uuid.UUID{first}}, "campo Parcelas é requerido"},
+		{"Should reject more than 60 installments", models.AgreementRequest{InvoiceIDs: []uuid.UUID{first}, Installments: 61}, "campo Parcelas deve ser no máximo 60"},
+		{"Should reject a negative down payment", models.AgreementRequest{InvoiceIDs: []uuid.UUID{first}, Installments: 3, DownPayment: -1}, "campo Entrada é inválido"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Prepare()
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+
+	t.Run("Should drop repeated invoices", func(t *testing.T) {
+		request := models.AgreementRequest{InvoiceIDs: []uuid.UUID{first, second, first}, Installments: 3}
+
+		assert.NoError(t, request.Prepare())
+		assert.ElementsMatch(t, []uuid.UUID{first, second}, request.InvoiceIDs)
+	})
+}
+
+func TestNewAgreement(t *testing.T) {
+	policy := models.LateChargePolicy{FeePercentage: 200, MonthlyInterestPercentage: 100}
+	account := &models.Account{ID: uuid.New()}
+	dueDate := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	date := dueDate.AddDate(0, 0, 30)
+	invoice := func(value, paidValue models.Money, dueDate time.Time) models.Invoice {
+		return models.Invoice{ID: uuid.New(), Account: *account, DueDate: dueDate, Value: value, PaidValue: paidValue}
+	}
+
+	tests := []struct {
+		name        string
+		invoices    []models.Invoice
+		principal   models.Money
+		lateCharges models.Money
+		value       models.Money
+	}{
+		{
+			"Should total the open principal and late charges of an invoice",
+			[]models.Invoice{invoice(1000_00, 0, dueDate)},
+			1000_00, 30_00, 1030_00,
+		},
+		{
+			"Should charge only the open principal of partially paid invoices",
+			[]models.Invoice{invoice(1000_00, 0, dueDate), invoice(1000_00, 400_00, dueDate)},
+			1600_00, 48_00, 1648_00,
+		},
+		{
+			"Should not charge late fees on invoices due on the agreement date",
+			[]models.Invoice{invoice(500_00, 0, date)},
+			500_00, 0, 500_00,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &models.AgreementRequest{Installments: 4, DownPayment: 200_00}
+
+			result := models.NewAgreement(account, tt.invoices, request, policy, date)
+
+			assert.NotEqual(t, uuid.Nil, result.ID)
+			assert.Equal(t, account.ID, result.AccountID)
+			assert.Equal(t, tt.principal, result.Principal)
+			assert.Equal(t, tt.lateCharges, result.LateCharges)
+			assert.Equal(t, tt.value, result.Value)
+			assert.Equal(t, tt.principal+tt.lateCharges, result.Value)
+			assert.Equal(t, models.Money(200_00), result.DownPayment)
+			assert.Equal(t, uint8(4), result.Installments)
+			assert.Equal(t, date, result.CreatedAt)
+		})
+	}
+}
+
+func TestAgreement_IsDownPaymentTooHigh(t *testing.T) {
+	tests := []struct {
+		name        string
+		downPayment models.Money
+		expected    bool
+	}{
+		{"Should accept no down payment", 0, false},
+		{"Should accept a down payment leaving a cent for the installments", 999_99, false},
+		{"Should reject a down payment of the whole value", 1000_00, true},
+		{"Should reject a down payment above the value", 1000_01, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agreement := models.Agreement{Value: 1000_00, DownPayment: tt.downPayment}
+
+			assert.Equal(t, tt.expected, agreement.IsDownPaymentTooHigh())
+		})
+	}
+}
+
+func TestAgreement_Plan(t *testing.T) {
+	account := &models.Account{ID: uuid.New(), Installments: 12}
+	createdAt := time.Date(2024, time.March, 10, 14, 0, 0, 0, time.UTC)
+	downPaymentDate := time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)
+	dueDates := []time.Time{
+		time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.May, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.June, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	type planned struct {
+		installment uint8
+		dueDate     time.Time
+		value       models.Money
+	}
+
+	tests := []struct {
+		name        string
+		value       models.Money
+		downPayment models.Money
+		expected    []planned
+	}{
+		{
+			"Should number the installments from 1 within the agreement",
+			900_00, 0,
+			[]planned{{1, dueDates[0], 300_00}, {2, dueDates[1], 300_00}, {3, dueDates[2], 300_00}},
+		},
+		{
+			"Should issue the down payment as installment 0",
+			1000_00, 100_00,
+			[]planned{{0, downPaymentDate, 100_00}, {1, dueDates[0], 300_00}, {2, dueDates[1], 300_00}, {3, dueDates[2], 300_00}},
+		},
+		{
+			"Should spread the remaining cents over the first installments",
+			1000_00, 0,
+			[]planned{{1, dueDates[0], 333_34}, {2, dueDates[1], 333_33}, {3, dueDates[2], 333_33}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agreement := &models.Agreement{ID: uuid.New(), AccountID: account.ID, Value: tt.value, DownPayment: tt.downPayment, Installments: 3, CreatedAt: createdAt}
+
+			invoices := agreement.Plan(account, downPaymentDate, dueDates)
+
+			result := make([]planned, 0, len(invoices))
+			var total models.Money
+			for _, invoice := range invoices {
+				result = append(result, planned{invoice.Installment, invoice.DueDate, invoice.Value})
+				total += invoice.Value
+
+				assert.NotEqual(t, uuid.Nil, invoice.ID)
+				assert.Equal(t, account.ID, invoice.Account.ID)
+				assert.Equal(t, uuid.NullUUID{UUID: agreement.ID, Valid: true}, invoice.AgreementID)
+				assert.Equal(t, invoice.Value, invoice.GrossValue)
+				assert.Equal(t, createdAt, invoice.CreatedAt)
+				assert.Equal(t, types.NullDateTime{}, invoice.PaidAt)
+			}
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.value, total)
+		})
+	}
+}
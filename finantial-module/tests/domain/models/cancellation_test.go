@@ -0,0 +1,155 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCancellation(t *testing.T) {
+	account := &models.Account{ID: uuid.New(), Installments: 3, Value: 900_00}
+	openedOn := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+	at := time.Date(2024, time.April, 11, 15, 0, 0, 0, time.UTC)
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	// The installments pay for the periods ending on May 1 (30 days),
+	// June 1 (31 days) and July 1 (30 days).
+	installment := func(number uint8, dueDate time.Time, paid models.Money) models.Invoice {
+		invoice := models.Invoice{ID: uuid.New(), Account: *account, Installment: number, DueDate: dueDate, Value: 300_00, PaidValue: paid}
+		if paid >= invoice.Value {
+			invoice.PaidAt = types.NullDateTime{Time: openedOn, Valid: true}
+		}
+		return invoice
+	}
+	first := installment(1, date(time.May, 1), 0)
+	second := installment(2, date(time.June, 1), 0)
+	third := installment(3, date(time.July, 1), 0)
+	paidFirst := installment(1, date(time.May, 1), 300_00)
+	paidSecond := installment(2, date(time.June, 1), 300_00)
+	paidThird := installment(3, date(time.July, 1), 300_00)
+	partialFirst := installment(1, date(time.May, 1), 60_00)
+	coveredFirst := installment(1, date(time.May, 1), 100_00)
+	agreementInvoice := models.Invoice{
+		ID:          uuid.New(),
+		Account:     *account,
+		Installment: 1,
+		DueDate:     date(time.May, 15),
+		Value:       500_00,
+		AgreementID: uuid.NullUUID{UUID: uuid.New(), Valid: true},
+	}
+
+	tests := []struct {
+		name          string
+		invoices      []models.Invoice
+		day           time.Time
+		cancelled     []uuid.UUID
+		proratedValue models.Money
+		finalCharge   models.Money
+		finalDueDate  time.Time
+		refund        models.Money
+	}{
+		{
+			name:          "Should charge the period in progress pro rata and cancel the open invoices",
+			invoices:      []models.Invoice{third, first, second},
+			day:           date(time.April, 11),
+			cancelled:     []uuid.UUID{first.ID, second.ID, third.ID},
+			proratedValue: 100_00,
+			finalCharge:   100_00,
+			finalDueDate:  date(time.May, 1),
+		},
+		{
+			name:          "Should keep the periods already ended",
+			invoices:      []models.Invoice{first, second, third},
+			day:           date(time.June, 11),
+			cancelled:     []uuid.UUID{third.ID},
+			proratedValue: 100_00,
+			finalCharge:   100_00,
+			finalDueDate:  date(time.July, 1),
+		},
+		{
+			name:          "Should set a partial payment against the prorated value",
+			invoices:      []models.Invoice{partialFirst, second, third},
+			day:           date(time.April, 11),
+			cancelled:     []uuid.UUID{partialFirst.ID, second.ID, third.ID},
+			proratedValue: 100_00,
+			finalCharge:   40_00,
+			finalDueDate:  date(time.May, 1),
+		},
+		{
+			name:          "Should refund what was paid in advance",
+			invoices:      []models.Invoice{paidFirst, paidSecond, paidThird},
+			day:           date(time.April, 11),
+			cancelled:     []uuid.UUID{},
+			proratedValue: 100_00,
+			refund:        800_00,
+		},
+		{
+			name:          "Should neither charge nor refund when the payments cover the prorated value",
+			invoices:      []models.Invoice{coveredFirst, second, third},
+			day:           date(time.April, 11),
+			cancelled:     []uuid.UUID{coveredFirst.ID, second.ID, third.ID},
+			proratedValue: 100_00,
+		},
+		{
+			name:          "Should ignore the agreement invoices",
+			invoices:      []models.Invoice{first, second, third, agreementInvoice},
+			day:           date(time.April, 11),
+			cancelled:     []uuid.UUID{first.ID, second.ID, third.ID},
+			proratedValue: 100_00,
+			finalCharge:   100_00,
+			finalDueDate:  date(time.May, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := models.NewCancellation(account, tt.invoices, models.CancellationEnrollmentDeleted, openedOn, tt.day, at)
+
+			assert.NoError(t, err)
+			assert.Equal(t, account.ID, plan.Cancellation.AccountID)
+			assert.Equal(t, models.CancellationEnrollmentDeleted, plan.Cancellation.Reason)
+			assert.ElementsMatch(t, tt.cancelled, plan.Cancelled)
+			assert.Equal(t, tt.proratedValue, plan.Cancellation.ProratedValue)
+			assert.Equal(t, tt.finalCharge, plan.Cancellation.FinalCharge)
+			assert.Equal(t, tt.refund, plan.Cancellation.Refund)
+
+			if tt.finalCharge == 0 {
+				assert.Nil(t, plan.FinalInvoice)
+				assert.False(t, plan.Cancellation.FinalInvoiceID.Valid)
+			} else if assert.NotNil(t, plan.FinalInvoice) {
+				assert.Equal(t, uint8(4), plan.FinalInvoice.Installment)
+				assert.True(t, plan.FinalInvoice.IsAdjustment())
+				assert.Equal(t, tt.finalDueDate, plan.FinalInvoice.DueDate)
+				assert.Equal(t, tt.finalCharge, plan.FinalInvoice.Value)
+				assert.Equal(t, tt.finalCharge, plan.FinalInvoice.GrossValue)
+				assert.Equal(t, uuid.NullUUID{UUID: plan.FinalInvoice.ID, Valid: true}, plan.Cancellation.FinalInvoiceID)
+			}
+
+			if tt.refund == 0 {
+				assert.Nil(t, plan.Refund)
+			} else if assert.NotNil(t, plan.Refund) {
+				assert.Equal(t, account.ID, plan.Refund.AccountID)
+				assert.Equal(t, plan.Cancellation.ID, plan.Refund.CancellationID)
+				assert.Equal(t, tt.refund, plan.Refund.Value)
+				assert.Equal(t, enums.REFUND_PENDING, plan.Refund.Status)
+				assert.Equal(t, at, plan.Refund.CreatedAt)
+			}
+		})
+	}
+
+	t.Run("Should return error when no installment number is left for the final charge", func(t *testing.T) {
+		last := installment(255, date(time.May, 1), 0)
+
+		plan, err := models.NewCancellation(account, []models.Invoice{last}, models.CancellationEnrollmentDeleted, openedOn, date(time.April, 11), at)
+
+		assert.Error(t, err)
+		assert.Nil(t, plan)
+	})
+}
@@ -0,0 +1,198 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyDiscounts(t *testing.T) {
+	dueDate := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	validFrom := dueDate.AddDate(0, -1, 0)
+
+	percentage := func(name string, percentage models.Percentage, stackable bool) models.Discount {
+		return models.Discount{ID: uuid.New(), Name: name, Type: enums.PERCENTAGE, Percentage: percentage, Stackable: stackable, ValidFrom: validFrom}
+	}
+	fixed := func(name string, value models.Money, stackable bool) models.Discount {
+		return models.Discount{ID: uuid.New(), Name: name, Type: enums.FIXED, Value: value, Stackable: stackable, ValidFrom: validFrom}
+	}
+	valid := func(discount models.Discount, from time.Time, until types.NullDateTime) models.Discount {
+		discount.ValidFrom, discount.ValidUntil = from, until
+		return discount
+	}
+	until := func(date time.Time) types.NullDateTime {
+		return types.NullDateTime{Time: date, Valid: true}
+	}
+
+	type line struct {
+		name   string
+		amount models.Money
+	}
+
+	tests := []struct {
+		name      string
+		discounts []models.Discount
+		lines     []line
+		net       models.Money
+	}{
+		{
+			"Should keep the value without discounts",
+			nil,
+			[]line{}, 1000_00,
+		},
+		{
+			"Should apply percentages before fixed amounts whatever their order",
+			[]models.Discount{fixed("Convênio", 100_00, true), percentage("Bolsa", 10_00, true)},
+			[]line{{"Bolsa", 100_00}, {"Convênio", 100_00}}, 800_00,
+		},
+		{
+			"Should apply each percentage over what the previous ones left",
+			[]models.Discount{percentage("Bolsa", 50_00, true), percentage("Irmãos", 50_00, true)},
+			[]line{{"Bolsa", 500_00}, {"Irmãos", 250_00}}, 250_00,
+		},
+		{
+			"Should replace the stack by a larger exclusive discount",
+			[]models.Discount{percentage("Bolsa", 10_00, true), fixed("Convênio", 100_00, true), percentage("Mérito", 30_00, false)},
+			[]line{{"Mérito", 300_00}}, 700_00,
+		},
+		{
+			"Should keep the stack when it is larger than the exclusive discount",
+			[]models.Discount{percentage("Bolsa", 10_00, true), fixed("Convênio", 100_00, true), fixed("Mérito", 150_00, false)},
+			[]line{{"Bolsa", 100_00}, {"Convênio", 100_00}}, 800_00,
+		},
+		{
+			"Should keep the stack when it ties with the exclusive discount",
+			[]models.Discount{percentage("Bolsa", 20_00, true), fixed("Mérito", 200_00, false)},
+			[]line{{"Bolsa", 200_00}}, 800_00,
+		},
+		{
+			"Should apply only the largest of the exclusive discounts",
+			[]models.Discount{percentage("Mérito", 20_00, false), fixed("Atleta", 250_00, false)},
+			[]line{{"Atleta", 250_00}}, 750_00,
+		},
+		{
+			"Should floor stacked fixed amounts at zero",
+			[]models.Discount{fixed("Convênio", 800_00, true), fixed("Parceria", 500_00, true)},
+			[]line{{"Convênio", 800_00}, {"Parceria", 200_00}}, 0,
+		},
+		{
+			"Should floor an exclusive fixed amount at zero",
+			[]models.Discount{fixed("Integral", 1500_00, false)},
+			[]line{{"Integral", 1000_00}}, 0,
+		},
+		{
+			"Should apply a full scholarship",
+			[]models.Discount{percentage("Integral", 100_00, true), fixed("Convênio", 100_00, true)},
+			[]line{{"Integral", 1000_00}, {"Convênio", 0}}, 0,
+		},
+		{
+			"Should skip discounts starting after the due date",
+			[]models.Discount{valid(percentage("Bolsa", 10_00, true), dueDate.AddDate(0, 0, 1), types.NullDateTime{})},
+			[]line{}, 1000_00,
+		},
+		{
+			"Should skip discounts ended before the due date",
+			[]models.Discount{valid(percentage("Bolsa", 10_00, true), validFrom, until(dueDate.AddDate(0, 0, -1)))},
+			[]line{}, 1000_00,
+		},
+		{
+			"Should apply discounts starting and ending on the due date",
+			[]models.Discount{valid(fixed("Convênio", 100_00, true), dueDate.Add(15*time.Hour), until(dueDate.Add(8*time.Hour)))},
+			[]line{{"Convênio", 100_00}}, 900_00,
+		},
+		{
+			"Should not let an expired exclusive discount replace the stack",
+			[]models.Discount{percentage("Bolsa", 10_00, true), valid(percentage("Mérito", 50_00, false), validFrom, until(dueDate.AddDate(0, 0, -1)))},
+			[]line{{"Bolsa", 100_00}}, 900_00,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, net := models.ApplyDiscounts(1000_00, dueDate, tt.discounts)
+
+			result := make([]line, 0, len(lines))
+			for _, l := range lines {
+				result = append(result, line{l.Name, l.Amount})
+			}
+			assert.Equal(t, tt.lines, result)
+			assert.Equal(t, tt.net, net)
+		})
+	}
+
+	t.Run("Should keep the percentage of percentage discounts in the breakdown", func(t *testing.T) {
+		discount := percentage("Bolsa", 12_50, true)
+
+		lines, _ := models.ApplyDiscounts(1000_00, dueDate, []models.Discount{discount})
+
+		assert.Equal(t, []models.InvoiceDiscount{{
+			DiscountID: uuid.NullUUID{UUID: discount.ID, Valid: true},
+			Name:       "Bolsa",
+			Type:       enums.PERCENTAGE,
+			Percentage: 12_50,
+			Amount:     125_00,
+		}}, lines)
+	})
+}
+
+func TestDiscount_Prepare(t *testing.T) {
+	validFrom := time.Date(2024, time.March, 10, 15, 0, 0, 0, time.UTC)
+	newDiscount := func(change func(*models.Discount)) *models.Discount {
+		discount := &models.Discount{StudentID: uuid.New(), Name: "Bolsa", Type: enums.PERCENTAGE, Percentage: 50_00, ValidFrom: validFrom}
+		change(discount)
+		return discount
+	}
+
+	tests := []struct {
+		name     string
+		discount *models.Discount
+		err      string
+	}{
+		{"Should accept a percentage discount", newDiscount(func(d *models.Discount) {}), ""},
+		{"Should accept a full scholarship", newDiscount(func(d *models.Discount) { d.Percentage = 100_00 }), ""},
+		{"Should require the percentage of percentage discounts", newDiscount(func(d *models.Discount) { d.Percentage, d.Value = 0, 50_00 }), "campo Percentual é requerido"},
+		{"Should reject percentages above 100", newDiscount(func(d *models.Discount) { d.Percentage = 100_01 }), "campo Percentual deve ser no máximo 100"},
+		{"Should accept a fixed discount", newDiscount(func(d *models.Discount) { d.Type, d.Percentage, d.Value = enums.FIXED, 0, 2000_00 }), ""},
+		{"Should require the value of fixed discounts", newDiscount(func(d *models.Discount) { d.Type = enums.FIXED }), "campo Valor é requerido"},
+		{"Should reject a validity ending before it starts", newDiscount(func(d *models.Discount) {
+			d.ValidUntil = types.NullDateTime{Time: validFrom.AddDate(0, 0, -1), Valid: true}
+		}), "campo Fim da vigência deve ser posterior ao início"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.discount.Prepare()
+
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, tt.discount.ID)
+			assert.Equal(t, time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC), tt.discount.ValidFrom)
+		})
+	}
+
+	t.Run("Should clear the field that does not apply to the type", func(t *testing.T) {
+		discount := newDiscount(func(d *models.Discount) { d.Value = 10_00 })
+
+		assert.NoError(t, discount.Prepare())
+		assert.Equal(t, models.Money(0), discount.Value)
+		assert.Equal(t, models.Percentage(50_00), discount.Percentage)
+	})
+
+	t.Run("Should read the percentage from JSON", func(t *testing.T) {
+		var discount models.Discount
+
+		assert.NoError(t, json.Unmarshal([]byte(`{"type":"PERCENTAGE","percentage":12.5}`), &discount))
+		assert.Equal(t, models.Percentage(12_50), discount.Percentage)
+		assert.Equal(t, models.Money(0), discount.Value)
+	})
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDueDatePolicy_DueDates(t *testing.T) {
+	location, err := time.LoadLocation("America/Sao_Paulo")
+	assert.NoError(t, err)
+	cal := calendar.New(location, nil)
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	fixedDay := func(day int) models.DueDatePolicy {
+		return models.DueDatePolicy{Rule: enums.FIXED_DAY, Day: day}
+	}
+	daysAfter := func(days int) models.DueDatePolicy {
+		return models.DueDatePolicy{Rule: enums.DAYS_AFTER_ENROLLMENT, Days: days}
+	}
+
+	tests := []struct {
+		name         string
+		policy       models.DueDatePolicy
+		openedAt     time.Time
+		installments uint8
+		expected     []time.Time
+	}{
+		{
+			"Should clamp day 31 to the end of shorter months and roll weekends",
+			fixedDay(31), time.Date(2024, time.January, 15, 12, 0, 0, 0, location), 4,
+			[]time.Time{date(2024, time.February, 29), date(2024, time.April, 1), date(2024, time.April, 30), date(2024, time.May, 31)},
+		},
+		{
+			"Should clamp day 31 to February 28 out of leap years",
+			fixedDay(31), time.Date(2025, time.January, 10, 12, 0, 0, 0, location), 2,
+			[]time.Time{date(2025, time.February, 28), date(2025, time.March, 31)},
+		},
+		{
+			"Should roll a fixed day over Carnival",
+			fixedDay(12), time.Date(2024, time.January, 5, 12, 0, 0, 0, location), 2,
+			[]time.Time{date(2024, time.February, 14), date(2024, time.March, 12)},
+		},
+		{
+			"Should take the local day of an account opened before midnight UTC",
+			fixedDay(31), time.Date(2024, time.January, 1, 2, 0, 0, 0, time.UTC), 2,
+			[]time.Time{date(2024, time.January, 31), date(2024, time.February, 29)},
+		},
+		{
+			"Should count the days after enrollment from the local day before midnight UTC",
+			daysAfter(28), time.Date(2024, time.March, 1, 1, 30, 0, 0, time.UTC), 3,
+			[]time.Time{date(2024, time.March, 28), date(2024, time.April, 29), date(2024, time.May, 28)},
+		},
+		{
+			"Should roll the days after enrollment over a holiday after midnight local time",
+			daysAfter(28), time.Date(2024, time.March, 1, 3, 30, 0, 0, time.UTC), 3,
+			[]time.Time{date(2024, time.April, 1), date(2024, time.April, 29), date(2024, time.May, 29)},
+		},
+		{
+			"Should return no dates without installments",
+			fixedDay(10), time.Date(2024, time.January, 1, 12, 0, 0, 0, location), 0,
+			[]time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.policy.DueDates(tt.openedAt, tt.installments, cal))
+		})
+	}
+}
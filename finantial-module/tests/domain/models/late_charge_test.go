@@ -0,0 +1,102 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLateChargePolicy_Calculate(t *testing.T) {
+	policy := models.LateChargePolicy{FeePercentage: 200, MonthlyInterestPercentage: 100}
+	dueDate := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		value     models.Money
+		paidValue models.Money
+		date      time.Time
+		expected  models.LateCharge
+	}{
+		{"Should charge nothing before the due date", 1000_00, 0, dueDate.AddDate(0, 0, -1), models.LateCharge{}},
+		{"Should charge nothing on the due date", 1000_00, 0, dueDate.Add(23 * time.Hour), models.LateCharge{}},
+		{"Should charge fee and one day of interest", 1000_00, 0, dueDate.AddDate(0, 0, 1), models.LateCharge{DaysLate: 1, Fee: 20_00, Interest: 33}},
+		{"Should charge a full month of interest after 30 days", 1000_00, 0, dueDate.AddDate(0, 0, 30), models.LateCharge{DaysLate: 30, Fee: 20_00, Interest: 10_00}},
+		{"Should charge only the open principal of a partially paid invoice", 1000_00, 400_00, dueDate.AddDate(0, 0, 30), models.LateCharge{DaysLate: 30, Fee: 12_00, Interest: 6_00}},
+		{"Should charge nothing when the principal is fully paid", 1000_00, 1000_00, dueDate.AddDate(0, 0, 30), models.LateCharge{DaysLate: 30}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoice := &models.Invoice{DueDate: dueDate, Value: tt.value, PaidValue: tt.paidValue}
+
+			result := policy.Calculate(invoice, tt.date)
+
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.value-tt.paidValue+result.Total(), invoice.AmountDue(result))
+		})
+	}
+}
+
+func TestInvoice_Pay(t *testing.T) {
+	policy := models.LateChargePolicy{FeePercentage: 200, MonthlyInterestPercentage: 100}
+	dueDate := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Should amortize the principal with a payment within the due date", func(t *testing.T) {
+		invoice := &models.Invoice{DueDate: dueDate, Value: 1000_00}
+		paidAt := dueDate.AddDate(0, 0, -5)
+
+		invoice.Pay(400_00, policy.Calculate(invoice, paidAt), paidAt)
+
+		assert.Equal(t, models.Money(600_00), invoice.OpenPrincipal())
+		assert.Equal(t, models.Money(0), invoice.PaidCharges)
+		assert.False(t, invoice.LateChargedAt.Valid)
+		assert.False(t, invoice.IsPaid())
+	})
+
+	t.Run("Should pay the late charges first and carry them to the settlement", func(t *testing.T) {
+		invoice := &models.Invoice{DueDate: dueDate, Value: 1000_00}
+
+		// 30 days late: fee of 20.00 and interest of 10.00 on the 1000.00
+		// open, paid before the principal.
+		firstPaidAt := dueDate.AddDate(0, 0, 30)
+		charge := policy.Calculate(invoice, firstPaidAt)
+		assert.Equal(t, models.LateCharge{DaysLate: 30, Fee: 20_00, Interest: 10_00}, charge)
+		invoice.Pay(999_99, charge, firstPaidAt)
+
+		assert.Equal(t, models.Money(20_00), invoice.LateFee)
+		assert.Equal(t, models.Money(10_00), invoice.LateInterest)
+		assert.Equal(t, models.Money(30_00), invoice.PaidCharges)
+		assert.Equal(t, models.Money(30_01), invoice.OpenPrincipal())
+		assert.Equal(t, firstPaidAt, invoice.LateChargedAt.Time)
+		assert.False(t, invoice.IsPaid())
+
+		// 30 days later the fee is not charged again and the interest
+		// accrues only on the 30.01 still open.
+		settledAt := firstPaidAt.AddDate(0, 0, 30)
+		charge = policy.Calculate(invoice, settledAt)
+		assert.Equal(t, models.LateCharge{DaysLate: 60, Fee: 20_00, Interest: 10_30}, charge)
+		assert.Equal(t, models.Money(30_31), invoice.AmountDue(charge))
+		invoice.Pay(30_31, charge, settledAt)
+
+		assert.Equal(t, models.Money(20_00), invoice.LateFee)
+		assert.Equal(t, models.Money(10_30), invoice.LateInterest)
+		assert.Equal(t, models.Money(30_30), invoice.PaidCharges)
+		assert.Equal(t, models.Money(1030_30), invoice.PaidValue)
+		assert.Equal(t, models.Money(0), invoice.OpenPrincipal())
+		assert.True(t, invoice.IsPaid())
+		assert.Equal(t, settledAt, invoice.PaidAt.Time)
+	})
+
+	t.Run("Should leave the principal open when the payment does not cover the late charges", func(t *testing.T) {
+		invoice := &models.Invoice{DueDate: dueDate, Value: 1000_00}
+		paidAt := dueDate.AddDate(0, 0, 30)
+
+		invoice.Pay(10_00, policy.Calculate(invoice, paidAt), paidAt)
+
+		assert.Equal(t, models.Money(10_00), invoice.PaidCharges)
+		assert.Equal(t, models.Money(1000_00), invoice.OpenPrincipal())
+		assert.Equal(t, models.Money(20_00), invoice.OpenCharges(invoice.RecordedCharge()))
+	})
+}
@@ -0,0 +1,118 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_Split(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   models.Money
+		parts    int
+		expected []models.Money
+	}{
+		{"Should split evenly", 300_00, 3, []models.Money{100_00, 100_00, 100_00}},
+		{"Should spread the remainder over the first parts", 100_00, 3, []models.Money{33_34, 33_33, 33_33}},
+		{"Should spread a two cent remainder", 100_02, 4, []models.Money{25_01, 25_01, 25_00, 25_00}},
+		{"Should give single cents to the first parts when there are more parts than cents", 2, 3, []models.Money{1, 1, 0}},
+		{"Should spread a negative remainder", -100_00, 3, []models.Money{-33_34, -33_33, -33_33}},
+		{"Should split zero", 0, 2, []models.Money{0, 0}},
+		{"Should keep the amount in a single part", 123_45, 1, []models.Money{123_45}},
+		{"Should return nil for zero parts", 100_00, 0, nil},
+		{"Should return nil for negative parts", 100_00, -1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.amount.Split(tt.parts)
+
+			assert.Equal(t, tt.expected, result)
+
+			var sum models.Money
+			for _, part := range result {
+				sum += part
+			}
+			if tt.parts > 0 {
+				assert.Equal(t, tt.amount, sum)
+			}
+		})
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected models.Money
+	}{
+		{"Should parse an integer", "1000", 1000_00},
+		{"Should parse one decimal place", "1000.5", 1000_50},
+		{"Should parse two decimal places", "0.01", 1},
+		{"Should parse negative amounts", "-2.50", -2_50},
+		{"Should parse zero", "0", 0},
+		{"Should parse negative zero", "-0.00", 0},
+		{"Should trim spaces", " 12.30 ", 12_30},
+		{"Should parse the largest amount", "92233720368547758.07", 9223372036854775807},
+		{"Should parse the smallest amount", "-92233720368547758.07", -9223372036854775807},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := models.ParseMoney(tt.raw)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	for _, raw := range []string{
+		"", "abc", "1.2.3", "1,50", "10.5x",
+		"1.-5", "+-5", "--5", "1.+5", "+3.10", ".75", "1.", "1.5e2", "10.005",
+		"92233720368547758.08", "92233720368547759", "99999999999999999999",
+	} {
+		t.Run("Should reject "+raw, func(t *testing.T) {
+			_, err := models.ParseMoney(raw)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMoney_Scan(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected models.Money
+	}{
+		{"Should scan NULL as zero", nil, 0},
+		{"Should scan integers as units", int64(15), 15_00},
+		{"Should scan decimal text", []byte("1234.56"), 1234_56},
+		{"Should scan strings", "-0.10", -10},
+		{"Should scan floats without binary rounding errors", 0.1 + 0.2, 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result models.Money
+
+			assert.NoError(t, result.Scan(tt.value))
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("Should reject unsupported types", func(t *testing.T) {
+		var result models.Money
+
+		assert.Error(t, result.Scan(true))
+	})
+}
+
+func TestMoney_String(t *testing.T) {
+	assert.Equal(t, "0.00", models.Money(0).String())
+	assert.Equal(t, "0.05", models.Money(5).String())
+	assert.Equal(t, "-0.05", models.Money(-5).String())
+	assert.Equal(t, "1234.50", models.Money(1234_50).String())
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverduePolicy_Cutoff(t *testing.T) {
+	date := time.Date(2024, time.March, 10, 18, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		graceDays int
+		expected  time.Time
+	}{
+		{"Should cut off at the start of the day without grace period", 0, time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		{"Should move the cutoff back by the grace days", 3, time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC)},
+		{"Should move the cutoff back across months", 10, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, models.OverduePolicy{GraceDays: tt.graceDays}.Cutoff(date))
+		})
+	}
+}
+
+func TestOverduePolicy_IsOverdue(t *testing.T) {
+	dueDate := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		graceDays int
+		date      time.Time
+		expected  bool
+	}{
+		{"Should not be overdue on the due date", 0, dueDate.Add(23 * time.Hour), false},
+		{"Should be overdue the day after the due date without grace period", 0, dueDate.AddDate(0, 0, 1), true},
+		{"Should not be overdue on the last grace day", 3, dueDate.AddDate(0, 0, 3).Add(23 * time.Hour), false},
+		{"Should be overdue the day after the last grace day", 3, dueDate.AddDate(0, 0, 4), true},
+		{"Should not be overdue before the due date", 3, dueDate.AddDate(0, 0, -1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, models.OverduePolicy{GraceDays: tt.graceDays}.IsOverdue(dueDate, tt.date))
+		})
+	}
+}
+
+// The cutoff and IsOverdue must agree: an invoice due before the cutoff is
+// exactly one the policy considers overdue on that date.
+func TestOverduePolicy_CutoffMatchesIsOverdue(t *testing.T) {
+	date := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	for graceDays := 0; graceDays <= 5; graceDays++ {
+		policy := models.OverduePolicy{GraceDays: graceDays}
+		cutoff := policy.Cutoff(date)
+
+		for offset := -8; offset <= 1; offset++ {
+			dueDate := time.Date(2024, time.March, 10+offset, 0, 0, 0, 0, time.UTC)
+			assert.Equal(t, dueDate.Before(cutoff), policy.IsOverdue(dueDate, date), "grace %d, due %s", graceDays, dueDate)
+		}
+	}
+}
+
+func TestOverduePolicy_Evaluate(t *testing.T) {
+	policy := models.OverduePolicy{GraceDays: 5}
+	date := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	dueOn := func(days int) types.NullDateTime {
+		return types.NullDateTime{Time: date.AddDate(0, 0, -days), Valid: true}
+	}
+
+	tests := []struct {
+		name              string
+		oldestOpenDueDate types.NullDateTime
+		expected          enums.AccountStatus
+	}{
+		{"Should be delinquent when the oldest open invoice is past the grace period", dueOn(6), enums.INADIMPLENTE},
+		{"Should stay regular while the oldest open invoice is within the grace period", dueOn(5), enums.ADIMPLENTE},
+		{"Should stay regular when the oldest open invoice is not due yet", dueOn(-10), enums.ADIMPLENTE},
+		{"Should be regularized when there is no open invoice", types.NullDateTime{}, enums.ADIMPLENTE},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, policy.Evaluate(tt.oldestOpenDueDate, date))
+		})
+	}
+}
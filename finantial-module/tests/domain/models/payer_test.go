@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		expected bool
+	}{
+		{"Should accept a CPF", "52998224725", true},
+		{"Should accept a CPF with zero check digits", "11144477735", true},
+		{"Should accept a CNPJ", "11222333000181", true},
+		{"Should reject a CPF with a wrong check digit", "52998224724", false},
+		{"Should reject a CNPJ with a wrong check digit", "11222333000182", false},
+		{"Should reject repeated digits", "11111111111", false},
+		{"Should reject punctuation", "529.982.247-25", false},
+		{"Should reject other lengths", "5299822472", false},
+		{"Should reject an empty document", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, models.IsValidDocument(tt.document))
+		})
+	}
+}
+
+func TestPayer_Prepare(t *testing.T) {
+	t.Run("Should strip the document punctuation", func(t *testing.T) {
+		payer := &models.Payer{StudentID: uuid.New(), Name: " João da Silva ", Document: "529.982.247-25"}
+
+		assert.NoError(t, payer.Prepare())
+		assert.Equal(t, "João da Silva", payer.Name)
+		assert.Equal(t, "52998224725", payer.Document)
+		assert.False(t, payer.UpdatedAt.IsZero())
+	})
+
+	t.Run("Should require the name", func(t *testing.T) {
+		payer := &models.Payer{StudentID: uuid.New(), Document: "52998224725"}
+
+		assert.Error(t, payer.Prepare())
+	})
+
+	t.Run("Should reject an invalid document", func(t *testing.T) {
+		payer := &models.Payer{StudentID: uuid.New(), Name: "João", Document: "123"}
+
+		assert.Error(t, payer.Prepare())
+	})
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePercentage(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected models.Percentage
+	}{
+		{"Should parse an integer", "2", 200},
+		{"Should parse one decimal place", "2.5", 250},
+		{"Should parse two decimal places", "0.03", 3},
+		{"Should parse zero", "0", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := models.ParsePercentage(tt.raw)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	for _, raw := range []string{"", "abc", "0.033", "1.005", "2%", "+1"} {
+		t.Run("Should reject "+raw, func(t *testing.T) {
+			_, err := models.ParsePercentage(raw)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMoney_Percent(t *testing.T) {
+	assert.Equal(t, models.Money(20_00), models.Money(1000_00).Percent(200))
+	assert.Equal(t, models.Money(3), models.Money(1_00).Percent(250))
+	assert.Equal(t, models.Money(-3), models.Money(-1_00).Percent(250))
+	assert.Equal(t, models.Money(0), models.Money(1_00).Percent(0))
+}
+
+func TestPercentage_Scan(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected models.Percentage
+	}{
+		{"Should scan NULL as zero", nil, 0},
+		{"Should scan decimal text", []byte("12.50"), 12_50},
+		{"Should scan integers as whole percentages", int64(100), 100_00},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result models.Percentage
+
+			assert.NoError(t, result.Scan(tt.value))
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("Should write the same decimal it scans", func(t *testing.T) {
+		value, err := models.Percentage(2_50).Value()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2.50", value)
+	})
+}
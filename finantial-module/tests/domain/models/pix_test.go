@@ -0,0 +1,55 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPixMerchant_Payload(t *testing.T) {
+	merchant := models.PixMerchant{Key: "financeiro@colibri.dev", Name: "Colibri Escola", City: "Sao Paulo"}
+
+	t.Run("Should match the static example of the Central Bank BR Code manual", func(t *testing.T) {
+		example := models.PixMerchant{Key: "123e4567-e12b-12d1-a456-426655440000", Name: "Fulano de Tal", City: "BRASILIA"}
+
+		result := example.Payload(0, "***", "")
+
+		assert.Equal(t, "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D", result)
+	})
+
+	t.Run("Should include amount and txid in static payloads", func(t *testing.T) {
+		result := merchant.Payload(150_75, "0123456789abcdef012345678", "")
+
+		assert.Equal(t, "00020126440014br.gov.bcb.pix0122financeiro@colibri.dev5204000053039865406150.755802BR5914Colibri Escola6009Sao Paulo622905250123456789abcdef0123456786304A85C", result)
+	})
+
+	t.Run("Should point dynamic payloads to the location returned by the PSP", func(t *testing.T) {
+		result := merchant.Payload(150_75, "9d36b84fc70b478fb95c12729b90ca25", "pix.example.com/qr/v2/9d36b84fc70b478fb95c12729b90ca25")
+
+		assert.Equal(t, "00020101021226760014br.gov.bcb.pix2554pix.example.com/qr/v2/9d36b84fc70b478fb95c12729b90ca255204000053039865406150.755802BR5914Colibri Escola6009Sao Paulo62070503***630466DB", result)
+	})
+
+	t.Run("Should strip accents and truncate merchant name and city", func(t *testing.T) {
+		accented := models.PixMerchant{Key: "k", Name: "João Conceição Serviços Educacionais", City: "São José dos Campos"}
+
+		result := accented.Payload(0, "***", "")
+
+		assert.Equal(t, "00020126230014br.gov.bcb.pix0101k5204000053039865802BR5925Joao Conceicao Servicos E6015Sao Jose dos Ca62070503***6304500C", result)
+	})
+}
+
+func TestPixMerchant_Validate(t *testing.T) {
+	assert.NoError(t, models.PixMerchant{Key: "k", Name: "n", City: "c"}.Validate())
+	assert.Error(t, models.PixMerchant{Name: "n", City: "c"}.Validate())
+	assert.Error(t, models.PixMerchant{Key: "k", City: "c"}.Validate())
+	assert.Error(t, models.PixMerchant{Key: "k", Name: "n"}.Validate())
+}
+
+func TestPixTxID(t *testing.T) {
+	id := uuid.MustParse("9d36b84f-c70b-478f-b95c-12729b90ca25")
+
+	assert.Equal(t, "9d36b84fc70b478fb95c12729", models.PixTxID(id))
+	assert.Equal(t, "9d36b84fc70b478fb95c12729b90ca25", models.PixChargeTxID(id))
+}
@@ -0,0 +1,218 @@
+package models
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type statementLine struct {
+	Date    time.Time
+	Type    enums.StatementEntryType
+	Amount  models.Money
+	Balance models.Money
+}
+
+func TestNewStatement(t *testing.T) {
+	studentID := uuid.New()
+	account := models.Account{ID: uuid.New(), StudentID: studentID, CourseID: uuid.New(), Installments: 3, Value: 900_00}
+	today := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	at := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 14, 30, 0, 0, time.UTC)
+	}
+	paidAt := func(month time.Month, day int) types.NullDateTime {
+		return types.NullDateTime{Time: at(month, day), Valid: true}
+	}
+	payment := func(invoice models.Invoice, value models.Money, month time.Month, day int) models.Payment {
+		return models.Payment{ID: uuid.New(), InvoiceID: invoice.ID, Value: value, Method: enums.PIX, PaidAt: at(month, day)}
+	}
+
+	// Discounted and paid in two payments, the last on the due date.
+	discounted := models.Invoice{
+		ID: uuid.New(), Account: account, Installment: 1, DueDate: date(time.February, 10),
+		GrossValue: 300_00, Discount: 30_00, Value: 270_00, PaidValue: 270_00, PaidAt: paidAt(time.February, 10),
+	}
+	discountedPayments := []models.Payment{
+		payment(discounted, 100_00, time.February, 5),
+		payment(discounted, 170_00, time.February, 10),
+	}
+
+	// Paid late, with the late charges recorded by the payment that settled it.
+	late := models.Invoice{
+		ID: uuid.New(), Account: account, Installment: 2, DueDate: date(time.March, 10),
+		GrossValue: 300_00, Value: 300_00, PaidValue: 307_00, LateFee: 6_00, LateInterest: 1_00,
+		LateChargedAt: paidAt(time.March, 15), PaidCharges: 7_00, PaidAt: paidAt(time.March, 15),
+	}
+	latePayments := []models.Payment{payment(late, 307_00, time.March, 15)}
+
+	// Partially paid and cancelled ahead of its due date.
+	cancelled := models.Invoice{
+		ID: uuid.New(), Account: account, Installment: 3, DueDate: date(time.April, 10),
+		GrossValue: 300_00, Value: 300_00, PaidValue: 100_00, CancelledAt: paidAt(time.March, 25),
+	}
+	cancelledPayments := []models.Payment{payment(cancelled, 100_00, time.March, 20)}
+
+	cancellation := models.Cancellation{ID: uuid.New(), AccountID: account.ID, Reason: models.CancellationEnrollmentDeleted, Refund: 50_00, CreatedAt: at(time.March, 25)}
+
+	tests := []struct {
+		name           string
+		invoices       []models.Invoice
+		payments       []models.Payment
+		cancellations  []models.Cancellation
+		period         models.StatementPeriod
+		openingBalance models.Money
+		closingBalance models.Money
+		lines          []statementLine
+	}{
+		{
+			name:           "Should post each payment on the day it was made",
+			invoices:       []models.Invoice{discounted},
+			payments:       discountedPayments,
+			closingBalance: 0,
+			lines: []statementLine{
+				{date(time.February, 5), enums.STATEMENT_PAYMENT, -100_00, -100_00},
+				{date(time.February, 10), enums.STATEMENT_CHARGE, 300_00, 200_00},
+				{date(time.February, 10), enums.STATEMENT_DISCOUNT, -30_00, 170_00},
+				{date(time.February, 10), enums.STATEMENT_PAYMENT, -170_00, 0},
+			},
+		},
+		{
+			name:           "Should post the late charges on the latest late payment",
+			invoices:       []models.Invoice{late},
+			payments:       latePayments,
+			closingBalance: 0,
+			lines: []statementLine{
+				{date(time.March, 10), enums.STATEMENT_CHARGE, 300_00, 300_00},
+				{date(time.March, 15), enums.STATEMENT_LATE_FEE, 6_00, 306_00},
+				{date(time.March, 15), enums.STATEMENT_LATE_INTEREST, 1_00, 307_00},
+				{date(time.March, 15), enums.STATEMENT_PAYMENT, -307_00, 0},
+			},
+		},
+		{
+			name:           "Should write off only the open principal of a cancelled partially paid invoice",
+			invoices:       []models.Invoice{cancelled},
+			payments:       cancelledPayments,
+			closingBalance: 0,
+			lines: []statementLine{
+				{date(time.March, 20), enums.STATEMENT_PAYMENT, -100_00, -100_00},
+				{date(time.March, 25), enums.STATEMENT_CHARGE, 300_00, 200_00},
+				{date(time.March, 25), enums.STATEMENT_CANCELLATION, -200_00, 0},
+			},
+		},
+		{
+			name:           "Should credit the refund of a cancelled account",
+			invoices:       []models.Invoice{cancelled},
+			payments:       cancelledPayments,
+			cancellations:  []models.Cancellation{cancellation},
+			closingBalance: -50_00,
+			lines: []statementLine{
+				{date(time.March, 20), enums.STATEMENT_PAYMENT, -100_00, -100_00},
+				{date(time.March, 25), enums.STATEMENT_CHARGE, 300_00, 200_00},
+				{date(time.March, 25), enums.STATEMENT_CANCELLATION, -200_00, 0},
+				{date(time.March, 25), enums.STATEMENT_REFUND, -50_00, -50_00},
+			},
+		},
+		{
+			name:     "Should carry the entries before the period into the opening balance and leave out those after it",
+			invoices: []models.Invoice{discounted, late},
+			payments: append(append([]models.Payment{}, discountedPayments...), latePayments...),
+			period: models.StatementPeriod{
+				From: types.NullDateTime{Time: date(time.February, 8), Valid: true},
+				To:   types.NullDateTime{Time: date(time.March, 10), Valid: true},
+			},
+			openingBalance: -100_00,
+			closingBalance: 300_00,
+			lines: []statementLine{
+				{date(time.February, 10), enums.STATEMENT_CHARGE, 300_00, 200_00},
+				{date(time.February, 10), enums.STATEMENT_DISCOUNT, -30_00, 170_00},
+				{date(time.February, 10), enums.STATEMENT_PAYMENT, -170_00, 0},
+				{date(time.March, 10), enums.STATEMENT_CHARGE, 300_00, 300_00},
+			},
+		},
+		{
+			name:           "Should have no entries when the period is after all of them",
+			invoices:       []models.Invoice{discounted, late},
+			payments:       append(append([]models.Payment{}, discountedPayments...), latePayments...),
+			period:         models.StatementPeriod{From: types.NullDateTime{Time: date(time.April, 1), Valid: true}},
+			openingBalance: 0,
+			closingBalance: 0,
+			lines:          []statementLine{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement := models.NewStatement(studentID, []models.Account{account}, tt.invoices, tt.payments, []models.Agreement{},
+				tt.cancellations, tt.period, calendar.Date, today)
+
+			lines := []statementLine{}
+			for _, entry := range statement.Entries {
+				assert.Equal(t, account.ID, entry.AccountID)
+				assert.Equal(t, account.CourseID, entry.CourseID)
+				lines = append(lines, statementLine{entry.Date, entry.Type, entry.Amount, entry.Balance})
+			}
+
+			assert.Equal(t, tt.lines, lines)
+			assert.Equal(t, tt.openingBalance, statement.OpeningBalance)
+			assert.Equal(t, tt.closingBalance, statement.ClosingBalance)
+		})
+	}
+}
+
+func TestStatement_WriteCSV(t *testing.T) {
+	accountID := uuid.MustParse("6f1c2a7e-0d3b-4c5a-9e8f-1a2b3c4d5e6f")
+	courseID := uuid.MustParse("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d")
+	invoiceID := uuid.MustParse("11111111-2222-4333-8444-555555555555")
+
+	tests := []struct {
+		name     string
+		entries  []models.StatementEntry
+		expected string
+	}{
+		{
+			name:     "Should write only the header without entries",
+			entries:  []models.StatementEntry{},
+			expected: "date,type,account_id,course_id,invoice_id,installment,description,amount,balance\n",
+		},
+		{
+			name: "Should write one line per entry, leaving the invoice columns empty for entries without invoice",
+			entries: []models.StatementEntry{
+				{
+					Date: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC), Type: enums.STATEMENT_CHARGE,
+					AccountID: accountID, CourseID: courseID, InvoiceID: uuid.NullUUID{UUID: invoiceID, Valid: true}, Installment: 1,
+					Description: "Parcela 1/3", Amount: 300_00, Balance: 300_00,
+				},
+				{
+					Date: time.Date(2024, time.March, 25, 0, 0, 0, 0, time.UTC), Type: enums.STATEMENT_REFUND,
+					AccountID: accountID, CourseID: courseID,
+					Description: "Crédito do cancelamento (ENROLLMENT_DELETED), com vírgula", Amount: -350_50, Balance: -50_50,
+				},
+			},
+			expected: "date,type,account_id,course_id,invoice_id,installment,description,amount,balance\n" +
+				"2024-02-10,CHARGE,6f1c2a7e-0d3b-4c5a-9e8f-1a2b3c4d5e6f,0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d,11111111-2222-4333-8444-555555555555,1,Parcela 1/3,300.00,300.00\n" +
+				"2024-03-25,REFUND,6f1c2a7e-0d3b-4c5a-9e8f-1a2b3c4d5e6f,0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d,,,\"Crédito do cancelamento (ENROLLMENT_DELETED), com vírgula\",-350.50,-50.50\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			statement := &models.Statement{Entries: tt.entries}
+
+			err := statement.WriteCSV(&buffer)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, buffer.String())
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	usecasesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases/mock"
+	producersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/producers/mock"
+	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountUsecase_Create(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC)
+	enrolledAt := time.Date(2024, time.March, 2, 15, 30, 0, 0, time.UTC)
+	priced := &models.Course{ID: uuid.New(), Value: 1200_00, UpdatedAt: enrolledAt.AddDate(0, -1, 0)}
+	repriced := &models.Course{ID: uuid.New(), Value: 1500_00, UpdatedAt: enrolledAt.AddDate(0, 0, 1)}
+
+	tests := []struct {
+		name          string
+		course        *models.Course
+		value         models.Money
+		createdAt     time.Time
+		expectedAt    time.Time
+		expectedValue models.Money
+	}{
+		{"Should open the account when the student enrolled", priced, 1000_00, enrolledAt, enrolledAt, 1200_00},
+		{"Should open the account now when the enrollment date is unknown", priced, 1000_00, time.Time{}, now, 1200_00},
+		{"Should price the account from the enrollment when the course was repriced after it", repriced, 1200_00, enrolledAt, enrolledAt, 1200_00},
+		{"Should price the account from the catalog when the enrollment has no price", repriced, 0, enrolledAt, enrolledAt, 1500_00},
+		{"Should price the account from the enrollment when the course is not in the catalog", nil, 1000_00, enrolledAt, enrolledAt, 1000_00},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			mockInvoiceUsecases := usecasesmock.NewMockInvoiceUsecases(controller)
+			mockAccountRepository := repositoriesmock.NewMockAccountRepository(controller)
+			mockCourseRepository := repositoriesmock.NewMockCourseRepository(controller)
+			mockAccountProducer := producersmock.NewMockAccountProducer(controller)
+			usecase := usecases.AccountUsecase{
+				InvoiceUsecases:  mockInvoiceUsecases,
+				Repository:       mockAccountRepository,
+				CourseRepository: mockCourseRepository,
+				AccountProducer:  mockAccountProducer,
+				UnitOfWork:       transaction.NewMockTransaction(),
+				Clock:            func() time.Time { return now },
+			}
+
+			account := &models.Account{StudentID: uuid.New(), CourseID: uuid.New(), Installments: 12, Value: tt.value, CreatedAt: tt.createdAt}
+			mockAccountRepository.EXPECT().ExistsByStudentAndCourse(gomock.Any(), account.StudentID, account.CourseID).Return(false, nil)
+			mockCourseRepository.EXPECT().FindByID(gomock.Any(), account.CourseID).Return(tt.course, nil)
+			mockAccountRepository.EXPECT().Insert(gomock.Any(), account).Return(nil)
+			mockInvoiceUsecases.EXPECT().Create(gomock.Any(), account).
+				DoAndReturn(func(_ context.Context, model *models.Account) error {
+					assert.Equal(t, tt.expectedAt, model.CreatedAt)
+					assert.Equal(t, tt.expectedValue, model.Value)
+					return nil
+				})
+			mockAccountProducer.EXPECT().Created(gomock.Any(), account).Return(nil)
+
+			err := usecase.Create(ctx, account)
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, account.ID)
+			assert.Equal(t, enums.ADIMPLENTE, account.Status)
+		})
+	}
+
+	t.Run("Should reject the account with more installments than a cancellation can number", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		mockAccountRepository := repositoriesmock.NewMockAccountRepository(controller)
+		mockCourseRepository := repositoriesmock.NewMockCourseRepository(controller)
+		mockAccountProducer := producersmock.NewMockAccountProducer(controller)
+		usecase := usecases.AccountUsecase{
+			Repository:       mockAccountRepository,
+			CourseRepository: mockCourseRepository,
+			AccountProducer:  mockAccountProducer,
+			UnitOfWork:       transaction.NewMockTransaction(),
+			Clock:            func() time.Time { return now },
+		}
+
+		account := &models.Account{StudentID: uuid.New(), CourseID: priced.ID, Installments: 255, Value: 1200_00, CreatedAt: enrolledAt}
+		mockAccountRepository.EXPECT().ExistsByStudentAndCourse(gomock.Any(), account.StudentID, account.CourseID).Return(false, nil)
+		mockCourseRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockAccountRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockAccountProducer.EXPECT().Rejected(gomock.Any(), account, exceptions.ErrAccountInstallmentsExceeded).Return(nil)
+
+		err := usecase.Create(ctx, account)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should reject the account when neither the catalog nor the enrollment has a price", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		mockAccountRepository := repositoriesmock.NewMockAccountRepository(controller)
+		mockCourseRepository := repositoriesmock.NewMockCourseRepository(controller)
+		mockAccountProducer := producersmock.NewMockAccountProducer(controller)
+		usecase := usecases.AccountUsecase{
+			Repository:       mockAccountRepository,
+			CourseRepository: mockCourseRepository,
+			AccountProducer:  mockAccountProducer,
+			UnitOfWork:       transaction.NewMockTransaction(),
+			Clock:            func() time.Time { return now },
+		}
+
+		account := &models.Account{StudentID: uuid.New(), CourseID: uuid.New(), Installments: 12, CreatedAt: enrolledAt}
+		mockAccountRepository.EXPECT().ExistsByStudentAndCourse(gomock.Any(), account.StudentID, account.CourseID).Return(false, nil)
+		mockCourseRepository.EXPECT().FindByID(gomock.Any(), account.CourseID).Return(nil, nil)
+		mockAccountRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockAccountProducer.EXPECT().Rejected(gomock.Any(), account, exceptions.ErrCoursePriceNotFound).Return(nil)
+
+		err := usecase.Create(ctx, account)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestAccountUsecase_CancelByStudentAndCourse(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.April, 11, 15, 0, 0, 0, time.UTC)
+
+	controller := gomock.NewController(t)
+	mockHolidayUsecases := usecasesmock.NewMockHolidayUsecases(controller)
+	mockAccountRepository := repositoriesmock.NewMockAccountRepository(controller)
+	mockInvoiceRepository := repositoriesmock.NewMockInvoiceRepository(controller)
+	mockCancellationRepository := repositoriesmock.NewMockCancellationRepository(controller)
+	mockRefundRepository := repositoriesmock.NewMockRefundRepository(controller)
+	mockAccountProducer := producersmock.NewMockAccountProducer(controller)
+	usecase := usecases.AccountUsecase{
+		HolidayUsecases:        mockHolidayUsecases,
+		Repository:             mockAccountRepository,
+		InvoiceRepository:      mockInvoiceRepository,
+		CancellationRepository: mockCancellationRepository,
+		RefundRepository:       mockRefundRepository,
+		AccountProducer:        mockAccountProducer,
+		UnitOfWork:             transaction.NewMockTransaction(),
+		Clock:                  func() time.Time { return now },
+	}
+	defer controller.Finish()
+
+	t.Run("Should cancel the account, refund the installment paid in advance and publish its new status", func(t *testing.T) {
+		account := &models.Account{
+			ID: uuid.New(), StudentID: uuid.New(), CourseID: uuid.New(), Installments: 1, Value: 300_00,
+			Status: enums.ADIMPLENTE, CreatedAt: time.Date(2024, time.April, 1, 10, 0, 0, 0, time.UTC),
+		}
+		open := models.Invoice{
+			ID: uuid.New(), Account: *account, Installment: 1, DueDate: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Value: 300_00, PaidValue: 300_00, PaidAt: types.NullDateTime{Time: account.CreatedAt, Valid: true},
+		}
+
+		mockAccountRepository.EXPECT().FindActiveByStudentAndCourse(gomock.Any(), account.StudentID, account.CourseID).Return(account, nil)
+		mockHolidayUsecases.EXPECT().Calendar(gomock.Any(), now, now).Return(calendar.New(time.UTC, nil), nil)
+		mockInvoiceRepository.EXPECT().FindAllByAccount(gomock.Any(), account.ID).Return([]models.Invoice{open}, nil)
+		mockInvoiceRepository.EXPECT().Cancel(gomock.Any(), []uuid.UUID{}, uuid.NullUUID{}, now).Return(0, nil)
+		mockCancellationRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockRefundRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockAccountRepository.EXPECT().UpdateStatus(gomock.Any(), account).Return(nil)
+		mockAccountProducer.EXPECT().StatusUpdated(gomock.Any(), account).
+			DoAndReturn(func(_ context.Context, model *models.Account) error {
+				assert.Equal(t, enums.CANCELADO, model.Status)
+				return nil
+			})
+
+		err := usecase.CancelByStudentAndCourse(ctx, account.StudentID, account.CourseID, models.CancellationEnrollmentDeleted)
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,181 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	usecasesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases/mock"
+	producersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/producers/mock"
+	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgreementUsecase_Create(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.March, 20, 9, 0, 0, 0, time.UTC)
+
+	controller := gomock.NewController(t)
+	mockAgreementRepository := repositoriesmock.NewMockAgreementRepository(controller)
+	mockAccountRepository := repositoriesmock.NewMockAccountRepository(controller)
+	mockInvoiceRepository := repositoriesmock.NewMockInvoiceRepository(controller)
+	mockHolidayUsecases := usecasesmock.NewMockHolidayUsecases(controller)
+	mockAccountProducer := producersmock.NewMockAccountProducer(controller)
+	usecase := usecases.AgreementUsecase{
+		Repository:        mockAgreementRepository,
+		AccountRepository: mockAccountRepository,
+		InvoiceRepository: mockInvoiceRepository,
+		HolidayUsecases:   mockHolidayUsecases,
+		AccountProducer:   mockAccountProducer,
+		DueDatePolicy:     models.DueDatePolicy{Rule: enums.FIXED_DAY, Day: 10},
+		OverduePolicy:     models.OverduePolicy{GraceDays: 3},
+		UnitOfWork:        transaction.NewMockTransaction(),
+		Clock:             func() time.Time { return now },
+	}
+	defer controller.Finish()
+
+	newAccount := func(status enums.AccountStatus) *models.Account {
+		return &models.Account{ID: uuid.New(), StudentID: uuid.New(), CourseID: uuid.New(), Installments: 3, Value: 900_00, Status: status}
+	}
+	newInvoices := func(account *models.Account) []models.Invoice {
+		invoices := []models.Invoice{}
+		for month := time.January; month <= time.March; month++ {
+			invoices = append(invoices, models.Invoice{
+				ID:          uuid.New(),
+				Account:     *account,
+				Installment: uint8(month),
+				DueDate:     time.Date(2024, month, 10, 0, 0, 0, 0, time.UTC),
+				Value:       300_00,
+			})
+		}
+		return invoices
+	}
+	expectAccount := func(account *models.Account, invoices []models.Invoice) {
+		mockAccountRepository.EXPECT().FindById(gomock.Any(), account.ID).Return(account, nil)
+		mockHolidayUsecases.EXPECT().Calendar(gomock.Any(), gomock.Any(), gomock.Any()).Return(calendar.New(time.UTC, nil), nil)
+		mockInvoiceRepository.EXPECT().FindAllByAccount(gomock.Any(), account.ID).Return(invoices, nil)
+	}
+
+	t.Run("Should cancel the renegotiated invoices and number the agreement invoices from the down payment", func(t *testing.T) {
+		account := newAccount(enums.INADIMPLENTE)
+		invoices := newInvoices(account)
+		request := &models.AgreementRequest{InvoiceIDs: []uuid.UUID{invoices[0].ID, invoices[1].ID, invoices[2].ID}, Installments: 3, DownPayment: 100_00}
+		expectAccount(account, invoices)
+
+		var agreementID uuid.UUID
+		mockAgreementRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, agreement *models.Agreement) error {
+				agreementID = agreement.ID
+				assert.Equal(t, models.Money(900_00), agreement.Value)
+				return nil
+			})
+		mockInvoiceRepository.EXPECT().Cancel(gomock.Any(), gomock.Any(), gomock.Any(), now).
+			DoAndReturn(func(_ context.Context, ids []uuid.UUID, replacedBy uuid.NullUUID, _ time.Time) (int, error) {
+				assert.ElementsMatch(t, []uuid.UUID{invoices[0].ID, invoices[1].ID, invoices[2].ID}, ids)
+				assert.Equal(t, uuid.NullUUID{UUID: agreementID, Valid: true}, replacedBy)
+				return len(ids), nil
+			})
+		mockInvoiceRepository.EXPECT().BulkInsert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, issued []models.Invoice) error {
+				installments := []uint8{}
+				values := []models.Money{}
+				for _, invoice := range issued {
+					installments = append(installments, invoice.Installment)
+					values = append(values, invoice.Value)
+					assert.Equal(t, uuid.NullUUID{UUID: agreementID, Valid: true}, invoice.AgreementID)
+				}
+				assert.Equal(t, []uint8{0, 1, 2, 3}, installments)
+				assert.Equal(t, []models.Money{100_00, 266_67, 266_67, 266_66}, values)
+				return nil
+			})
+		mockInvoiceRepository.EXPECT().FindOldestOpenDueDateByAccount(gomock.Any(), account.ID).
+			Return(&types.NullDateTime{Time: time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC), Valid: true}, nil)
+		mockAccountRepository.EXPECT().UpdateStatus(gomock.Any(), account).Return(nil)
+		mockAccountProducer.EXPECT().Renegotiated(gomock.Any(), account, gomock.Any()).Return(nil)
+
+		detail, err := usecase.Create(ctx, account.ID, request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.ADIMPLENTE, account.Status)
+		assert.Len(t, detail.Renegotiated, 3)
+		for _, invoice := range detail.Renegotiated {
+			assert.True(t, invoice.IsCancelled())
+			assert.Equal(t, uuid.NullUUID{UUID: detail.ID, Valid: true}, invoice.ReplacedBy)
+		}
+		assert.Equal(t, time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC), detail.Invoices[0].DueDate)
+		assert.Equal(t, time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC), detail.Invoices[1].DueDate)
+	})
+
+	t.Run("Should keep a cancelled account cancelled without notifying school", func(t *testing.T) {
+		account := newAccount(enums.CANCELADO)
+		invoices := newInvoices(account)
+		request := &models.AgreementRequest{InvoiceIDs: []uuid.UUID{invoices[0].ID}, Installments: 2}
+		expectAccount(account, invoices)
+		mockAgreementRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockInvoiceRepository.EXPECT().Cancel(gomock.Any(), request.InvoiceIDs, gomock.Any(), now).Return(1, nil)
+		mockInvoiceRepository.EXPECT().BulkInsert(gomock.Any(), gomock.Len(2)).Return(nil)
+		mockInvoiceRepository.EXPECT().FindOldestOpenDueDateByAccount(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockAccountRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockAccountProducer.EXPECT().Renegotiated(gomock.Any(), gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		detail, err := usecase.Create(ctx, account.ID, request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.CANCELADO, account.Status)
+		assert.Equal(t, []uint8{1, 2}, []uint8{detail.Invoices[0].Installment, detail.Invoices[1].Installment})
+	})
+
+	t.Run("Should return ErrAgreementInvoiceNotOverdue when a renegotiated invoice was paid since it was read", func(t *testing.T) {
+		account := newAccount(enums.INADIMPLENTE)
+		invoices := newInvoices(account)
+		request := &models.AgreementRequest{InvoiceIDs: []uuid.UUID{invoices[0].ID, invoices[1].ID}, Installments: 2}
+		expectAccount(account, invoices)
+		mockAgreementRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockInvoiceRepository.EXPECT().Cancel(gomock.Any(), gomock.Any(), gomock.Any(), now).Return(1, nil)
+		mockInvoiceRepository.EXPECT().BulkInsert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockAccountProducer.EXPECT().Renegotiated(gomock.Any(), gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		detail, err := usecase.Create(ctx, account.ID, request)
+
+		assert.EqualError(t, err, exceptions.ErrAgreementInvoiceNotOverdue)
+		assert.Nil(t, detail)
+	})
+
+	t.Run("Should return ErrAgreementInvoiceNotOverdue when an invoice is not due yet", func(t *testing.T) {
+		account := newAccount(enums.INADIMPLENTE)
+		invoices := newInvoices(account)
+		invoices[2].DueDate = time.Date(2024, time.March, 25, 0, 0, 0, 0, time.UTC)
+		request := &models.AgreementRequest{InvoiceIDs: []uuid.UUID{invoices[2].ID}, Installments: 2}
+		expectAccount(account, invoices)
+		mockAgreementRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockInvoiceRepository.EXPECT().Cancel(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		detail, err := usecase.Create(ctx, account.ID, request)
+
+		assert.EqualError(t, err, exceptions.ErrAgreementInvoiceNotOverdue)
+		assert.Nil(t, detail)
+	})
+
+	t.Run("Should return ErrAgreementDownPaymentTooHigh when the down payment covers the balance", func(t *testing.T) {
+		account := newAccount(enums.INADIMPLENTE)
+		invoices := newInvoices(account)
+		request := &models.AgreementRequest{InvoiceIDs: []uuid.UUID{invoices[0].ID}, Installments: 2, DownPayment: 300_00}
+		expectAccount(account, invoices)
+		mockAgreementRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockInvoiceRepository.EXPECT().Cancel(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		detail, err := usecase.Create(ctx, account.ID, request)
+
+		assert.EqualError(t, err, exceptions.ErrAgreementDownPaymentTooHigh)
+		assert.Nil(t, detail)
+	})
+}
@@ -0,0 +1,190 @@
+package usecases
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/boleto"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/cnab"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	usecasesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases/mock"
+	filesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/files/mock"
+	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCnabUsecase_ExportRemittance(t *testing.T) {
+	ctx := context.Background()
+	layout, err := boleto.NewItau("1234", "56789", "109")
+	assert.NoError(t, err)
+
+	sequence := int64(7)
+	invoice := func(ourNumber string) models.Invoice {
+		return models.Invoice{
+			ID:      uuid.New(),
+			Account: models.Account{ID: uuid.New(), StudentID: uuid.New()},
+			DueDate: time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC),
+			Value:   300_00,
+			Boleto:  models.Boleto{BankCode: "341", OurNumber: ourNumber},
+		}
+	}
+	pending := invoice("00000123")
+	cancelled := invoice("00000124")
+	payer := models.Payer{StudentID: pending.Account.StudentID, Name: "João da Silva", Document: "52998224725"}
+
+	// instructions returns the "código de movimento" of each title of the
+	// CNAB 400 file, keyed by its "nosso número".
+	instructions := func(content []byte) map[string]string {
+		result := map[string]string{}
+		for _, line := range strings.Split(string(content), "\r\n") {
+			if strings.HasPrefix(line, "1") {
+				result[strings.TrimSpace(line[62:82])] = line[108:110]
+			}
+		}
+		return result
+	}
+
+	tests := []struct {
+		name         string
+		pending      []models.Invoice
+		writeOffs    []models.Invoice
+		instructions map[string]string
+		err          string
+	}{
+		{
+			name:         "Should register the pending boletos and write off the cancelled ones in the same file",
+			pending:      []models.Invoice{pending},
+			writeOffs:    []models.Invoice{cancelled},
+			instructions: map[string]string{"00000123": cnab.InstructionEntry, "00000124": cnab.InstructionWriteOff},
+		},
+		{
+			name:         "Should write off the cancelled boletos when no boleto is pending",
+			pending:      []models.Invoice{},
+			writeOffs:    []models.Invoice{cancelled},
+			instructions: map[string]string{"00000124": cnab.InstructionWriteOff},
+		},
+		{
+			name:      "Should return ErrCnabNothingToRemit when no boleto is pending nor cancelled",
+			pending:   []models.Invoice{},
+			writeOffs: []models.Invoice{},
+			err:       exceptions.ErrCnabNothingToRemit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			mockInvoiceRepository := repositoriesmock.NewMockInvoiceRepository(controller)
+			mockPayerRepository := repositoriesmock.NewMockPayerRepository(controller)
+			mockFileStorage := filesmock.NewMockFileStorage(controller)
+			usecase := usecases.CnabUsecase{
+				InvoiceRepository: mockInvoiceRepository,
+				PayerRepository:   mockPayerRepository,
+				BankLayout:        layout,
+				FileStorage:       mockFileStorage,
+				UnitOfWork:        transaction.NewMockTransaction(),
+			}
+
+			mockInvoiceRepository.EXPECT().FindAllPendingRemittanceForUpdate(gomock.Any()).Return(tt.pending, nil)
+			mockInvoiceRepository.EXPECT().FindAllPendingWriteOffForUpdate(gomock.Any()).Return(tt.writeOffs, nil)
+			if len(tt.pending) > 0 {
+				mockPayerRepository.EXPECT().FindAllByStudents(gomock.Any(), []uuid.UUID{pending.Account.StudentID}).Return([]models.Payer{payer}, nil)
+				mockInvoiceRepository.EXPECT().MarkAsRemitted(gomock.Any(), []uuid.UUID{pending.ID}).Return(nil)
+			}
+			if tt.err == "" {
+				mockInvoiceRepository.EXPECT().NextRemittanceSequence(gomock.Any()).Return(&sequence, nil)
+				mockInvoiceRepository.EXPECT().MarkAsWrittenOff(gomock.Any(), []uuid.UUID{cancelled.ID}).Return(nil)
+				mockFileStorage.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, content []byte) error {
+						assert.Equal(t, tt.instructions, instructions(content))
+						return nil
+					})
+			}
+
+			file, err := usecase.ExportRemittance(ctx, cnab.Format400)
+
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				assert.Nil(t, file)
+				return
+			}
+
+			defer os.Remove(file.Path)
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.instructions), file.Records)
+			assert.Equal(t, len(tt.writeOffs), file.WriteOffs)
+			assert.Equal(t, 0, file.Skipped)
+		})
+	}
+}
+
+func TestCnabUsecase_ImportReturn(t *testing.T) {
+	ctx := context.Background()
+	data, err := os.ReadFile(filepath.Join("..", "cnab", "testdata", "return_400.ret"))
+	assert.NoError(t, err)
+
+	invoice := &models.Invoice{ID: uuid.New(), Boleto: models.Boleto{BankCode: "341", OurNumber: "00000123"}}
+	settlement := &models.CnabSettlement{
+		BankCode:   "341",
+		OurNumber:  "00000123",
+		OccurredAt: time.Date(2024, time.April, 15, 0, 0, 0, 0, time.UTC),
+		PaidValue:  1530_50,
+		InvoiceID:  invoice.ID,
+	}
+
+	tests := []struct {
+		name       string
+		inserted   bool
+		settled    int
+		duplicated int
+	}{
+		{"Should settle the invoices paid in the return file", true, 1, 0},
+		{"Should skip the settlements applied by an earlier import of the file", false, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			mockInvoiceRepository := repositoriesmock.NewMockInvoiceRepository(controller)
+			mockSettlementRepository := repositoriesmock.NewMockCnabSettlementRepository(controller)
+			mockPaymentUsecases := usecasesmock.NewMockPaymentUsecases(controller)
+			usecase := usecases.CnabUsecase{
+				InvoiceRepository:    mockInvoiceRepository,
+				SettlementRepository: mockSettlementRepository,
+				PaymentUsecases:      mockPaymentUsecases,
+				UnitOfWork:           transaction.NewMockTransaction(),
+			}
+
+			mockInvoiceRepository.EXPECT().FindByOurNumber(gomock.Any(), "341", "00000123").Return(invoice, nil)
+			mockSettlementRepository.EXPECT().Insert(gomock.Any(), settlement).Return(tt.inserted, nil)
+			mockPaymentUsecases.EXPECT().Collect(gomock.Any(), invoice.ID, &models.Payment{
+				Value:  1530_50,
+				Method: enums.BOLETO,
+				PaidAt: settlement.OccurredAt,
+			}).Return(nil).Times(tt.settled)
+
+			report, err := usecase.ImportReturn(ctx, data)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 2, report.TotalRecords)
+			assert.Equal(t, tt.settled, report.Settled)
+			assert.Equal(t, tt.duplicated, report.Duplicated)
+			assert.Equal(t, 1, report.Ignored)
+			assert.Len(t, report.Errors, 2)
+		})
+	}
+}
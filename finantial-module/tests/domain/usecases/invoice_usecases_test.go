@@ -0,0 +1,333 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	transactionsmock "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	clientsmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/clients/mock"
+	producersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/producers/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/qrcode"
+	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInvoiceUsecase_OverduePolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		graceDays string
+		batchSize string
+		expected  models.OverduePolicy
+		batch     int
+	}{
+		{"Should use no grace period and the default batch size when unset", "", "", models.OverduePolicy{}, 1000},
+		{"Should read the grace days and batch size", "5", "200", models.OverduePolicy{GraceDays: 5}, 200},
+		{"Should accept zero grace days", "0", "", models.OverduePolicy{}, 1000},
+		{"Should ignore negative grace days and batch size", "-1", "-10", models.OverduePolicy{}, 1000},
+		{"Should ignore invalid grace days and batch size", "three", "many", models.OverduePolicy{}, 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OVERDUE_GRACE_DAYS", tt.graceDays)
+			t.Setenv("OVERDUE_BATCH_SIZE", tt.batchSize)
+
+			result := usecases.NewInvoiceUsecase()
+
+			assert.Equal(t, tt.expected, result.OverduePolicy)
+			assert.Equal(t, tt.batch, result.OverdueBatchSize)
+			assert.NotNil(t, result.Clock)
+		})
+	}
+}
+
+func TestInvoiceUsecase_RefreshAccountStatus(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC)
+	dueOn := func(days int) *types.NullDateTime {
+		return &types.NullDateTime{Time: now.AddDate(0, 0, -days), Valid: true}
+	}
+
+	tests := []struct {
+		name              string
+		graceDays         int
+		status            enums.AccountStatus
+		oldestOpenDueDate *types.NullDateTime
+		expected          enums.AccountStatus
+	}{
+		{"Should make the account delinquent the day after the grace period", 3, enums.ADIMPLENTE, dueOn(4), enums.INADIMPLENTE},
+		{"Should keep the account regular on the last grace day", 3, enums.ADIMPLENTE, dueOn(3), enums.ADIMPLENTE},
+		{"Should make the account delinquent the day after the due date without grace period", 0, enums.ADIMPLENTE, dueOn(1), enums.INADIMPLENTE},
+		{"Should regularize the account when no invoice is open", 3, enums.INADIMPLENTE, nil, enums.ADIMPLENTE},
+		{"Should regularize the account when the oldest open invoice is back within the grace period", 3, enums.INADIMPLENTE, dueOn(2), enums.ADIMPLENTE},
+		{"Should keep the account delinquent while an invoice is past the grace period", 3, enums.INADIMPLENTE, dueOn(10), enums.INADIMPLENTE},
+		{"Should not reopen a cancelled account", 3, enums.CANCELADO, nil, enums.CANCELADO},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			mockInvoiceRepository := repositoriesmock.NewMockInvoiceRepository(controller)
+			mockAccountRepository := repositoriesmock.NewMockAccountRepository(controller)
+			mockAccountProducer := producersmock.NewMockAccountProducer(controller)
+			usecase := usecases.InvoiceUsecase{
+				InvoiceRepository: mockInvoiceRepository,
+				AccountRepository: mockAccountRepository,
+				AccountProducer:   mockAccountProducer,
+				OverduePolicy:     models.OverduePolicy{GraceDays: tt.graceDays},
+				UnitOfWork:        transaction.NewMockTransaction(),
+				Clock:             func() time.Time { return now },
+			}
+
+			account := &models.Account{ID: uuid.New(), Status: tt.status}
+			mockInvoiceRepository.EXPECT().FindOldestOpenDueDateByAccount(ctx, account.ID).Return(tt.oldestOpenDueDate, nil)
+			if tt.status != tt.expected {
+				mockAccountRepository.EXPECT().UpdateStatus(ctx, account).Return(nil)
+				mockAccountProducer.EXPECT().StatusUpdated(ctx, account).Return(nil)
+			}
+
+			err := usecase.RefreshAccountStatus(ctx, account)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, account.Status)
+		})
+	}
+}
+
+func TestInvoiceUsecase_ProcessOverdueAccounts(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC)
+	cutoff := time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC)
+
+	controller := gomock.NewController(t)
+	mockAccountRepository := repositoriesmock.NewMockAccountRepository(controller)
+	mockAccountProducer := producersmock.NewMockAccountProducer(controller)
+	mockUnitOfWork := transactionsmock.NewMockUnitOfWork(controller)
+	usecase := usecases.InvoiceUsecase{
+		AccountRepository: mockAccountRepository,
+		AccountProducer:   mockAccountProducer,
+		OverduePolicy:     models.OverduePolicy{GraceDays: 3},
+		OverdueBatchSize:  2,
+		UnitOfWork:        mockUnitOfWork,
+		Clock:             func() time.Time { return now },
+	}
+	defer controller.Finish()
+
+	// inTransaction runs the work as the unit of work does, recording that
+	// it ran inside it.
+	var inTransaction bool
+	executeInTransaction := func() {
+		mockUnitOfWork.EXPECT().Execute(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				inTransaction = true
+				defer func() { inTransaction = false }()
+				return fn(ctx)
+			})
+	}
+
+	first, second := uuid.New(), uuid.New()
+	delinquent := models.Account{ID: uuid.New(), Status: enums.INADIMPLENTE}
+	regularized := models.Account{ID: uuid.New(), Status: enums.ADIMPLENTE}
+
+	t.Run("Should store the status events of each batch in its own transaction", func(t *testing.T) {
+		executeInTransaction()
+		executeInTransaction()
+		gomock.InOrder(
+			mockAccountRepository.EXPECT().FindPageEnd(gomock.Any(), uuid.Nil, 2).Return(&models.AccountPage{LastID: first, Total: 2}, nil),
+			mockAccountRepository.EXPECT().UpdateOverdueStatuses(gomock.Any(), uuid.Nil, first, cutoff).Return([]models.Account{delinquent, regularized}, nil),
+			mockAccountProducer.EXPECT().BulkStatusUpdated(gomock.Any(), []models.Account{delinquent, regularized}).
+				DoAndReturn(func(context.Context, []models.Account) error {
+					assert.True(t, inTransaction)
+					return nil
+				}),
+			mockAccountRepository.EXPECT().FindPageEnd(gomock.Any(), first, 2).Return(&models.AccountPage{LastID: second, Total: 2}, nil),
+			mockAccountRepository.EXPECT().UpdateOverdueStatuses(gomock.Any(), first, second, cutoff).Return([]models.Account{}, nil),
+			mockAccountProducer.EXPECT().BulkStatusUpdated(gomock.Any(), []models.Account{}).Return(nil),
+			mockAccountRepository.EXPECT().FindPageEnd(gomock.Any(), second, 2).Return(nil, nil),
+		)
+
+		report, err := usecase.ProcessOverdueAccounts(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &models.OverdueReport{
+			Cutoff: cutoff, Batches: 2, Scanned: 4, Delinquent: 1, Regularized: 1, FailedBatches: []models.OverdueBatchFailure{},
+		}, report)
+	})
+
+	t.Run("Should report the batch whose status events cannot be stored and process the next ones", func(t *testing.T) {
+		executeInTransaction()
+		executeInTransaction()
+		gomock.InOrder(
+			mockAccountRepository.EXPECT().FindPageEnd(gomock.Any(), uuid.Nil, 2).Return(&models.AccountPage{LastID: first, Total: 2}, nil),
+			mockAccountRepository.EXPECT().UpdateOverdueStatuses(gomock.Any(), uuid.Nil, first, cutoff).Return([]models.Account{delinquent}, nil),
+			mockAccountProducer.EXPECT().BulkStatusUpdated(gomock.Any(), []models.Account{delinquent}).Return(errors.New("mock error")),
+			mockAccountRepository.EXPECT().FindPageEnd(gomock.Any(), first, 2).Return(&models.AccountPage{LastID: second, Total: 1}, nil),
+			mockAccountRepository.EXPECT().UpdateOverdueStatuses(gomock.Any(), first, second, cutoff).Return([]models.Account{regularized}, nil),
+			mockAccountProducer.EXPECT().BulkStatusUpdated(gomock.Any(), []models.Account{regularized}).Return(nil),
+		)
+
+		report, err := usecase.ProcessOverdueAccounts(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &models.OverdueReport{
+			Cutoff: cutoff, Batches: 2, Scanned: 3, Regularized: 1, Errors: 1,
+			FailedBatches: []models.OverdueBatchFailure{{After: uuid.Nil, LastID: first, Error: "mock error"}},
+		}, report)
+	})
+
+	t.Run("Should return error when the batches cannot be paged", func(t *testing.T) {
+		mockAccountRepository.EXPECT().FindPageEnd(gomock.Any(), uuid.Nil, 2).Return(nil, errors.New("mock error"))
+		mockAccountRepository.EXPECT().UpdateOverdueStatuses(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		report, err := usecase.ProcessOverdueAccounts(ctx)
+
+		assert.EqualError(t, err, "mock error")
+		assert.Nil(t, report)
+	})
+}
+
+func TestInvoiceUsecase_GeneratePix(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC)
+	at := func(moment time.Time) types.NullDateTime {
+		return types.NullDateTime{Time: moment, Valid: true}
+	}
+	invoice := models.Invoice{ID: uuid.New(), Installment: 1, DueDate: now.AddDate(0, 0, 10), Value: 300_00, GrossValue: 300_00}
+	txid := models.PixChargeTxID(invoice.ID)
+	location := "pix.example.com/qr/v2/cobv/1"
+
+	registered := func(amount models.Money, createdAt time.Time) models.PixRegistration {
+		return models.PixRegistration{
+			TxID:      txid,
+			Location:  location,
+			Amount:    amount,
+			CreatedAt: at(createdAt),
+			ExpiresAt: at(createdAt.Add(models.PixChargeExpiration)),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		pix      models.PixRegistration
+		paidAt   types.NullDateTime
+		register func(client *clientsmock.MockPixClient)
+		expected *models.PixRegistration
+		err      string
+	}{
+		{
+			name: "Should create the charge on the first request",
+			register: func(client *clientsmock.MockPixClient) {
+				client.EXPECT().CreateCharge(gomock.Any(), txid, models.Money(300_00), "pix@example.com", models.PixChargeExpiration).Return(location, nil)
+			},
+			expected: &models.PixRegistration{
+				TxID: txid, Location: location, Amount: 300_00, CreatedAt: at(now), ExpiresAt: at(now.Add(models.PixChargeExpiration)),
+			},
+		},
+		{
+			name:     "Should reuse the charge while its amount is unchanged and it is not about to expire",
+			pix:      registered(300_00, now.Add(-time.Hour)),
+			register: func(client *clientsmock.MockPixClient) {},
+		},
+		{
+			name: "Should amend the amount keeping the expiration",
+			pix:  registered(250_00, now.Add(-time.Hour)),
+			register: func(client *clientsmock.MockPixClient) {
+				client.EXPECT().UpdateCharge(gomock.Any(), txid, models.Money(300_00), models.PixChargeExpiration).Return(location, nil)
+			},
+			expected: &models.PixRegistration{
+				TxID: txid, Location: location, Amount: 300_00, CreatedAt: at(now.Add(-time.Hour)), ExpiresAt: at(now.Add(23 * time.Hour)),
+			},
+		},
+		{
+			name: "Should extend the charge about to expire",
+			pix:  registered(300_00, now.Add(-23*time.Hour-30*time.Minute)),
+			register: func(client *clientsmock.MockPixClient) {
+				client.EXPECT().UpdateCharge(gomock.Any(), txid, models.Money(300_00), 47*time.Hour+30*time.Minute).Return(location, nil)
+			},
+			expected: &models.PixRegistration{
+				TxID: txid, Location: location, Amount: 300_00, CreatedAt: at(now.Add(-23*time.Hour - 30*time.Minute)), ExpiresAt: at(now.Add(models.PixChargeExpiration)),
+			},
+		},
+		{
+			name: "Should extend the charge already expired",
+			pix:  registered(300_00, now.AddDate(0, 0, -3)),
+			register: func(client *clientsmock.MockPixClient) {
+				client.EXPECT().UpdateCharge(gomock.Any(), txid, models.Money(300_00), 96*time.Hour).Return(location, nil)
+			},
+			expected: &models.PixRegistration{
+				TxID: txid, Location: location, Amount: 300_00, CreatedAt: at(now.AddDate(0, 0, -3)), ExpiresAt: at(now.Add(models.PixChargeExpiration)),
+			},
+		},
+		{
+			name:     "Should return ErrInvoiceAlreadyPaid without registering the charge",
+			paidAt:   at(now.AddDate(0, 0, -1)),
+			register: func(client *clientsmock.MockPixClient) {},
+			err:      exceptions.ErrInvoiceAlreadyPaid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			mockInvoiceRepository := repositoriesmock.NewMockInvoiceRepository(controller)
+			mockDiscountRepository := repositoriesmock.NewMockDiscountRepository(controller)
+			mockPixClient := clientsmock.NewMockPixClient(controller)
+			usecase := usecases.InvoiceUsecase{
+				InvoiceRepository:  mockInvoiceRepository,
+				DiscountRepository: mockDiscountRepository,
+				PixMerchant:        models.PixMerchant{Key: "pix@example.com", Name: "Colibri School", City: "Sao Paulo"},
+				PixClient:          mockPixClient,
+				QRCodeGenerator:    qrcode.NewPNGQRCodeGenerator(),
+				UnitOfWork:         transaction.NewMockTransaction(),
+				Clock:              func() time.Time { return now },
+			}
+
+			stored := invoice
+			stored.Pix = tt.pix
+			if tt.paidAt.Valid {
+				stored.PaidValue, stored.PaidAt = stored.Value, tt.paidAt
+			}
+			gomock.InOrder(
+				mockInvoiceRepository.EXPECT().FindByIdForUpdate(gomock.Any(), invoice.ID).Return(&stored, nil),
+				mockInvoiceRepository.EXPECT().FindById(gomock.Any(), invoice.ID).Return(&stored, nil),
+			)
+			mockDiscountRepository.EXPECT().FindByInvoices(gomock.Any(), []uuid.UUID{invoice.ID}).Return([]models.InvoiceDiscount{}, nil)
+			tt.register(mockPixClient)
+			if tt.expected != nil {
+				mockInvoiceRepository.EXPECT().UpdatePix(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, updated *models.Invoice) error {
+						assert.Equal(t, *tt.expected, updated.Pix)
+						return nil
+					})
+			}
+
+			charge, err := usecase.GeneratePix(ctx, invoice.ID)
+
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				assert.Nil(t, charge)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, txid, charge.TxID)
+			assert.Equal(t, models.Money(300_00), charge.Amount)
+			assert.Contains(t, charge.Payload, location)
+			assert.NotEmpty(t, charge.QRCode)
+		})
+	}
+}
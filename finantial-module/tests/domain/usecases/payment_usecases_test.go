@@ -0,0 +1,193 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	transactionsmock "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	usecasesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases/mock"
+	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentUsecase_Create(t *testing.T) {
+	ctx := context.Background()
+	paidAt := time.Date(2024, time.March, 8, 10, 0, 0, 0, time.UTC)
+
+	controller := gomock.NewController(t)
+	mockInvoiceUsecases := usecasesmock.NewMockInvoiceUsecases(controller)
+	mockInvoiceRepository := repositoriesmock.NewMockInvoiceRepository(controller)
+	mockPaymentRepository := repositoriesmock.NewMockPaymentRepository(controller)
+	mockUnitOfWork := transactionsmock.NewMockUnitOfWork(controller)
+	usecase := usecases.PaymentUsecase{
+		InvoiceUsecases:   mockInvoiceUsecases,
+		InvoiceRepository: mockInvoiceRepository,
+		Repository:        mockPaymentRepository,
+		LateChargePolicy:  models.LateChargePolicy{FeePercentage: 200, MonthlyInterestPercentage: 100},
+		UnitOfWork:        mockUnitOfWork,
+	}
+	defer controller.Finish()
+
+	// inTransaction runs the work as the unit of work does, recording that
+	// it ran inside it.
+	var inTransaction bool
+	executeInTransaction := func() {
+		mockUnitOfWork.EXPECT().Execute(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				inTransaction = true
+				defer func() { inTransaction = false }()
+				return fn(ctx)
+			})
+	}
+
+	newInvoice := func() *models.Invoice {
+		return &models.Invoice{
+			ID:      uuid.New(),
+			Account: models.Account{ID: uuid.New(), Status: enums.ADIMPLENTE},
+			DueDate: time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+			Value:   300_00,
+		}
+	}
+
+	t.Run("Should return ErrPaymentInvalid without locking the invoice when the payment has no value", func(t *testing.T) {
+		mockUnitOfWork.EXPECT().Execute(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockInvoiceRepository.EXPECT().FindByIdForUpdate(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Create(ctx, uuid.New(), &models.Payment{Method: enums.PIX, PaidAt: paidAt})
+
+		assert.EqualError(t, err, exceptions.ErrPaymentInvalid)
+	})
+
+	t.Run("Should return ErrPaymentInvalid when the payment method is unknown", func(t *testing.T) {
+		mockUnitOfWork.EXPECT().Execute(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Create(ctx, uuid.New(), &models.Payment{Value: 100_00, Method: "CHEQUE", PaidAt: paidAt})
+
+		assert.EqualError(t, err, exceptions.ErrPaymentInvalid)
+	})
+
+	t.Run("Should return ErrInvoiceNotFound when the invoice does not exist", func(t *testing.T) {
+		executeInTransaction()
+		id := uuid.New()
+		mockInvoiceRepository.EXPECT().FindByIdForUpdate(gomock.Any(), id).Return(nil, nil)
+		mockPaymentRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Create(ctx, id, &models.Payment{Value: 100_00, Method: enums.PIX, PaidAt: paidAt})
+
+		assert.EqualError(t, err, exceptions.ErrInvoiceNotFound)
+	})
+
+	t.Run("Should return ErrInvoiceAlreadyPaid when the locked invoice was paid meanwhile", func(t *testing.T) {
+		executeInTransaction()
+		invoice := newInvoice()
+		invoice.PaidValue = invoice.Value
+		invoice.PaidAt = types.NullDateTime{Time: paidAt, Valid: true}
+		mockInvoiceRepository.EXPECT().FindByIdForUpdate(gomock.Any(), invoice.ID).Return(invoice, nil)
+		mockPaymentRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockInvoiceRepository.EXPECT().UpdatePayment(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Create(ctx, invoice.ID, &models.Payment{Value: 100_00, Method: enums.PIX, PaidAt: paidAt})
+
+		assert.EqualError(t, err, exceptions.ErrInvoiceAlreadyPaid)
+	})
+
+	t.Run("Should return ErrPaymentExceedsOpenValue when the payment exceeds the amount due", func(t *testing.T) {
+		executeInTransaction()
+		invoice := newInvoice()
+		mockInvoiceRepository.EXPECT().FindByIdForUpdate(gomock.Any(), invoice.ID).Return(invoice, nil)
+		mockPaymentRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockInvoiceRepository.EXPECT().UpdatePayment(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Create(ctx, invoice.ID, &models.Payment{Value: 300_01, Method: enums.PIX, PaidAt: paidAt})
+
+		assert.EqualError(t, err, exceptions.ErrPaymentExceedsOpenValue)
+	})
+
+	t.Run("Should return error when the payment cannot be stored", func(t *testing.T) {
+		executeInTransaction()
+		invoice := newInvoice()
+		mockInvoiceRepository.EXPECT().FindByIdForUpdate(gomock.Any(), invoice.ID).Return(invoice, nil)
+		mockPaymentRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New("mock error"))
+		mockInvoiceRepository.EXPECT().UpdatePayment(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Create(ctx, invoice.ID, &models.Payment{Value: 100_00, Method: enums.PIX, PaidAt: paidAt})
+
+		assert.EqualError(t, err, "mock error")
+	})
+
+	t.Run("Should settle the invoice locked for the payment and refresh its account", func(t *testing.T) {
+		executeInTransaction()
+		invoice := newInvoice()
+		payment := &models.Payment{Value: 300_00, Method: enums.PIX, PaidAt: paidAt}
+		gomock.InOrder(
+			mockInvoiceRepository.EXPECT().FindByIdForUpdate(gomock.Any(), invoice.ID).
+				DoAndReturn(func(context.Context, uuid.UUID) (*models.Invoice, error) {
+					assert.True(t, inTransaction)
+					return invoice, nil
+				}),
+			mockPaymentRepository.EXPECT().Insert(gomock.Any(), payment).Return(nil),
+			mockInvoiceRepository.EXPECT().UpdatePayment(gomock.Any(), invoice).
+				DoAndReturn(func(context.Context, *models.Invoice) error {
+					assert.True(t, inTransaction)
+					return nil
+				}),
+			mockInvoiceUsecases.EXPECT().RefreshAccountStatus(gomock.Any(), &invoice.Account).Return(nil),
+		)
+
+		err := usecase.Create(ctx, invoice.ID, payment)
+
+		assert.NoError(t, err)
+		assert.Equal(t, invoice.ID, payment.InvoiceID)
+		assert.NotEqual(t, uuid.Nil, payment.ID)
+		assert.Equal(t, models.Money(0), payment.Overpaid)
+		assert.Equal(t, models.Money(300_00), invoice.PaidValue)
+		assert.True(t, invoice.IsPaid())
+	})
+}
+
+func TestPaymentUsecase_Collect(t *testing.T) {
+	ctx := context.Background()
+	paidAt := time.Date(2024, time.March, 8, 10, 0, 0, 0, time.UTC)
+
+	controller := gomock.NewController(t)
+	mockInvoiceUsecases := usecasesmock.NewMockInvoiceUsecases(controller)
+	mockInvoiceRepository := repositoriesmock.NewMockInvoiceRepository(controller)
+	mockPaymentRepository := repositoriesmock.NewMockPaymentRepository(controller)
+	usecase := usecases.PaymentUsecase{
+		InvoiceUsecases:   mockInvoiceUsecases,
+		InvoiceRepository: mockInvoiceRepository,
+		Repository:        mockPaymentRepository,
+		UnitOfWork:        transaction.NewMockTransaction(),
+	}
+	defer controller.Finish()
+
+	t.Run("Should record as overpaid what the collected payment has above the amount due", func(t *testing.T) {
+		invoice := &models.Invoice{
+			ID:      uuid.New(),
+			Account: models.Account{ID: uuid.New(), Status: enums.ADIMPLENTE},
+			DueDate: time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+			Value:   300_00,
+		}
+		payment := &models.Payment{Value: 310_00, Method: enums.BOLETO, PaidAt: paidAt}
+		mockInvoiceRepository.EXPECT().FindByIdForUpdate(gomock.Any(), invoice.ID).Return(invoice, nil)
+		mockPaymentRepository.EXPECT().Insert(gomock.Any(), payment).Return(nil)
+		mockInvoiceRepository.EXPECT().UpdatePayment(gomock.Any(), invoice).Return(nil)
+		mockInvoiceUsecases.EXPECT().RefreshAccountStatus(gomock.Any(), &invoice.Account).Return(nil)
+
+		err := usecase.Collect(ctx, invoice.ID, payment)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.Money(10_00), payment.Overpaid)
+		assert.True(t, invoice.IsPaid())
+	})
+}
@@ -0,0 +1,178 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	usecasesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases/mock"
+	clientsmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/clients/mock"
+	producersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/producers/mock"
+	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconciliationUsecase_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	settled := time.Now().Add(-24 * time.Hour)
+	studentID, courseID := uuid.New(), uuid.New()
+
+	enrollment := func(status string, createdAt time.Time) models.EnrollmentSnapshot {
+		return models.EnrollmentSnapshot{
+			StudentID: studentID, CourseID: courseID, CourseValue: 1200_00, Installments: 12, Status: status, CreatedAt: createdAt,
+		}
+	}
+	account := models.Account{
+		ID: uuid.New(), StudentID: studentID, CourseID: courseID, Installments: 12, Value: 1200_00,
+		Status: enums.ADIMPLENTE, CreatedAt: settled,
+	}
+	accountID := uuid.NullUUID{UUID: account.ID, Valid: true}
+
+	type mocks struct {
+		accountUsecases *usecasesmock.MockAccountUsecases
+		accountProducer *producersmock.MockAccountProducer
+	}
+
+	tests := []struct {
+		name          string
+		heal          bool
+		enrollments   []models.EnrollmentSnapshot
+		accounts      []models.Account
+		expect        func(m mocks)
+		skipped       int
+		healed        int
+		discrepancies []models.Discrepancy
+	}{
+		{
+			name:        "Should create the missing account of an active enrollment",
+			heal:        true,
+			enrollments: []models.EnrollmentSnapshot{enrollment(string(enums.ADIMPLENTE), settled)},
+			accounts:    []models.Account{},
+			expect: func(m mocks) {
+				m.accountUsecases.EXPECT().Create(gomock.Any(), &models.Account{
+					StudentID: studentID, CourseID: courseID, Installments: 12, Value: 1200_00, CreatedAt: settled,
+				}).Return(nil)
+			},
+			healed: 1,
+			discrepancies: []models.Discrepancy{{
+				Type: enums.MISSING_ACCOUNT, StudentID: studentID, CourseID: courseID,
+				EnrollmentStatus: string(enums.ADIMPLENTE), Action: enums.CREATE_ACCOUNT, Healed: true,
+			}},
+		},
+		{
+			name:        "Should cancel the account whose enrollment is missing",
+			heal:        true,
+			enrollments: []models.EnrollmentSnapshot{},
+			accounts:    []models.Account{account},
+			expect: func(m mocks) {
+				m.accountUsecases.EXPECT().CancelByStudentAndCourse(gomock.Any(), studentID, courseID, models.CancellationReconciliation).Return(nil)
+			},
+			healed: 1,
+			discrepancies: []models.Discrepancy{{
+				Type: enums.ORPHAN_ACCOUNT, StudentID: studentID, CourseID: courseID, AccountID: accountID,
+				AccountStatus: enums.ADIMPLENTE, Action: enums.CANCEL_ACCOUNT, Healed: true,
+			}},
+		},
+		{
+			name:        "Should publish the account status again when the enrollment has another one",
+			heal:        true,
+			enrollments: []models.EnrollmentSnapshot{enrollment(string(enums.INADIMPLENTE), settled)},
+			accounts:    []models.Account{account},
+			expect: func(m mocks) {
+				m.accountProducer.EXPECT().StatusUpdated(gomock.Any(), &account).Return(nil)
+			},
+			healed: 1,
+			discrepancies: []models.Discrepancy{{
+				Type: enums.STATUS_MISMATCH, StudentID: studentID, CourseID: courseID, AccountID: accountID,
+				EnrollmentStatus: string(enums.INADIMPLENTE), AccountStatus: enums.ADIMPLENTE,
+				Action: enums.EMIT_ACCOUNT_STATUS_UPDATED, Healed: true,
+			}},
+		},
+		{
+			name:        "Should record the error of a status mismatch that could not be healed",
+			heal:        true,
+			enrollments: []models.EnrollmentSnapshot{enrollment(string(enums.INADIMPLENTE), settled)},
+			accounts:    []models.Account{account},
+			expect: func(m mocks) {
+				m.accountProducer.EXPECT().StatusUpdated(gomock.Any(), &account).Return(errors.New("mock error"))
+			},
+			discrepancies: []models.Discrepancy{{
+				Type: enums.STATUS_MISMATCH, StudentID: studentID, CourseID: courseID, AccountID: accountID,
+				EnrollmentStatus: string(enums.INADIMPLENTE), AccountStatus: enums.ADIMPLENTE,
+				Action: enums.EMIT_ACCOUNT_STATUS_UPDATED, Error: "mock error",
+			}},
+		},
+		{
+			name:        "Should only report the status mismatch in a dry run",
+			enrollments: []models.EnrollmentSnapshot{enrollment(string(enums.INADIMPLENTE), settled)},
+			accounts:    []models.Account{account},
+			expect:      func(m mocks) {},
+			discrepancies: []models.Discrepancy{{
+				Type: enums.STATUS_MISMATCH, StudentID: studentID, CourseID: courseID, AccountID: accountID,
+				EnrollmentStatus: string(enums.INADIMPLENTE), AccountStatus: enums.ADIMPLENTE,
+				Action: enums.EMIT_ACCOUNT_STATUS_UPDATED,
+			}},
+		},
+		{
+			name:          "Should report nothing when the enrollment and its account agree",
+			heal:          true,
+			enrollments:   []models.EnrollmentSnapshot{enrollment(string(enums.ADIMPLENTE), settled)},
+			accounts:      []models.Account{account},
+			expect:        func(m mocks) {},
+			discrepancies: []models.Discrepancy{},
+		},
+		{
+			name:          "Should skip the enrollment created within the grace period",
+			heal:          true,
+			enrollments:   []models.EnrollmentSnapshot{enrollment(string(enums.ADIMPLENTE), time.Now())},
+			accounts:      []models.Account{},
+			expect:        func(m mocks) {},
+			skipped:       1,
+			discrepancies: []models.Discrepancy{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			mockSchoolClient := clientsmock.NewMockSchoolClient(controller)
+			mockAccountRepository := repositoriesmock.NewMockAccountRepository(controller)
+			m := mocks{
+				accountUsecases: usecasesmock.NewMockAccountUsecases(controller),
+				accountProducer: producersmock.NewMockAccountProducer(controller),
+			}
+			usecase := usecases.ReconciliationUsecase{
+				SchoolClient:    mockSchoolClient,
+				Repository:      mockAccountRepository,
+				AccountUsecases: m.accountUsecases,
+				AccountProducer: m.accountProducer,
+				UnitOfWork:      transaction.NewMockTransaction(),
+				PageSize:        10,
+				GracePeriod:     time.Hour,
+			}
+
+			mockSchoolClient.EXPECT().FindEnrollments(gomock.Any(), uuid.Nil, uuid.Nil, 10).Return(tt.enrollments, nil)
+			mockAccountRepository.EXPECT().FindPage(gomock.Any(), nil, 10).Return(tt.accounts, nil)
+			tt.expect(m)
+
+			report, err := usecase.Reconcile(ctx, tt.heal)
+
+			assert.NoError(t, err)
+			assert.Equal(t, !tt.heal, report.DryRun)
+			assert.Equal(t, len(tt.enrollments), report.Enrollments)
+			assert.Equal(t, len(tt.accounts), report.Accounts)
+			assert.Equal(t, tt.skipped, report.Skipped)
+			assert.Equal(t, tt.healed, report.Healed)
+			assert.Equal(t, tt.discrepancies, report.Discrepancies)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/calendar"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases"
+	usecasesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/usecases/mock"
+	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatementUsecase_GetByStudent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.March, 20, 9, 0, 0, 0, time.UTC)
+	studentID := uuid.MustParse("9b2e4c1a-7d3f-4e5a-8b6c-0d1e2f3a4b5c")
+
+	controller := gomock.NewController(t)
+	mockAccountRepository := repositoriesmock.NewMockAccountRepository(controller)
+	mockInvoiceRepository := repositoriesmock.NewMockInvoiceRepository(controller)
+	mockPaymentRepository := repositoriesmock.NewMockPaymentRepository(controller)
+	mockAgreementRepository := repositoriesmock.NewMockAgreementRepository(controller)
+	mockCancellationRepository := repositoriesmock.NewMockCancellationRepository(controller)
+	mockHolidayUsecases := usecasesmock.NewMockHolidayUsecases(controller)
+	usecase := usecases.StatementUsecase{
+		AccountRepository:      mockAccountRepository,
+		InvoiceRepository:      mockInvoiceRepository,
+		PaymentRepository:      mockPaymentRepository,
+		AgreementRepository:    mockAgreementRepository,
+		CancellationRepository: mockCancellationRepository,
+		HolidayUsecases:        mockHolidayUsecases,
+		Clock:                  func() time.Time { return now },
+	}
+	defer controller.Finish()
+
+	account := models.Account{
+		ID:        uuid.MustParse("6f1c2a7e-0d3b-4c5a-9e8f-1a2b3c4d5e6f"),
+		CourseID:  uuid.MustParse("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"),
+		StudentID: studentID, Installments: 2, Value: 600_00, Status: enums.ADIMPLENTE,
+	}
+	paid := models.Invoice{
+		ID: uuid.MustParse("11111111-2222-4333-8444-555555555555"), Account: account, Installment: 1,
+		DueDate: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC), GrossValue: 300_00, Value: 300_00, PaidValue: 300_00,
+		PaidAt: types.NullDateTime{Time: time.Date(2024, time.February, 10, 14, 30, 0, 0, time.UTC), Valid: true},
+	}
+	open := models.Invoice{
+		ID: uuid.MustParse("66666666-7777-4888-9999-aaaaaaaaaaaa"), Account: account, Installment: 2,
+		DueDate: time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC), GrossValue: 300_00, Value: 300_00,
+	}
+	payment := models.Payment{ID: uuid.New(), InvoiceID: paid.ID, Value: 300_00, Method: enums.PIX, PaidAt: paid.PaidAt.Time}
+
+	expectStatement := func() {
+		mockAccountRepository.EXPECT().FindAllByStudent(gomock.Any(), studentID).Return([]models.Account{account}, nil)
+		mockInvoiceRepository.EXPECT().FindAllByStudent(gomock.Any(), studentID).Return([]models.Invoice{paid, open}, nil)
+		mockPaymentRepository.EXPECT().FindAllByStudent(gomock.Any(), studentID).Return([]models.Payment{payment}, nil)
+		mockAgreementRepository.EXPECT().FindAllByAccount(gomock.Any(), account.ID).Return([]models.Agreement{}, nil)
+		mockCancellationRepository.EXPECT().FindByAccount(gomock.Any(), account.ID).Return(nil, nil)
+		mockHolidayUsecases.EXPECT().Calendar(gomock.Any(), now, now).Return(calendar.New(time.UTC, nil), nil)
+	}
+	february := models.StatementPeriod{
+		From: types.NullDateTime{Time: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		To:   types.NullDateTime{Time: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), Valid: true},
+	}
+
+	t.Run("Should write the entries of the period as CSV", func(t *testing.T) {
+		expectStatement()
+
+		statement, err := usecase.GetByStudent(ctx, studentID, february)
+		assert.NoError(t, err)
+
+		var buffer bytes.Buffer
+		err = statement.WriteCSV(&buffer)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "date,type,account_id,course_id,invoice_id,installment,description,amount,balance\n"+
+			"2024-02-10,CHARGE,6f1c2a7e-0d3b-4c5a-9e8f-1a2b3c4d5e6f,0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d,11111111-2222-4333-8444-555555555555,1,Parcela 1/2,300.00,300.00\n"+
+			"2024-02-10,PAYMENT,6f1c2a7e-0d3b-4c5a-9e8f-1a2b3c4d5e6f,0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d,11111111-2222-4333-8444-555555555555,1,Pagamento da Parcela 1/2,-300.00,0.00\n",
+			buffer.String())
+	})
+
+	t.Run("Should marshal the balances, entries and totals of the period as JSON", func(t *testing.T) {
+		expectStatement()
+
+		statement, err := usecase.GetByStudent(ctx, studentID, february)
+		assert.NoError(t, err)
+
+		content, err := json.Marshal(statement)
+		assert.NoError(t, err)
+
+		var body struct {
+			StudentID      string          `json:"studentId"`
+			OpeningBalance json.RawMessage `json:"openingBalance"`
+			ClosingBalance json.RawMessage `json:"closingBalance"`
+			Entries        json.RawMessage `json:"entries"`
+			Totals         json.RawMessage `json:"totals"`
+		}
+		assert.NoError(t, json.Unmarshal(content, &body))
+		assert.Equal(t, studentID.String(), body.StudentID)
+		assert.JSONEq(t, `0.00`, string(body.OpeningBalance))
+		assert.JSONEq(t, `0.00`, string(body.ClosingBalance))
+		assert.JSONEq(t, `[
+			{
+				"date": "2024-02-10T00:00:00Z", "type": "CHARGE",
+				"accountId": "6f1c2a7e-0d3b-4c5a-9e8f-1a2b3c4d5e6f", "courseId": "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+				"invoiceId": "11111111-2222-4333-8444-555555555555", "installment": 1,
+				"description": "Parcela 1/2", "amount": 300.00, "balance": 300.00
+			},
+			{
+				"date": "2024-02-10T00:00:00Z", "type": "PAYMENT",
+				"accountId": "6f1c2a7e-0d3b-4c5a-9e8f-1a2b3c4d5e6f", "courseId": "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+				"invoiceId": "11111111-2222-4333-8444-555555555555", "installment": 1,
+				"description": "Pagamento da Parcela 1/2", "amount": -300.00, "balance": 0.00
+			}
+		]`, string(body.Entries))
+		assert.JSONEq(t, `[
+			{"status": "OPEN", "invoices": 0, "value": 0.00, "paidValue": 0.00},
+			{"status": "OVERDUE", "invoices": 0, "value": 0.00, "paidValue": 0.00},
+			{"status": "PAID", "invoices": 1, "value": 300.00, "paidValue": 300.00},
+			{"status": "CANCELLED", "invoices": 0, "value": 0.00, "paidValue": 0.00}
+		]`, string(body.Totals))
+	})
+
+	t.Run("Should return ErrStatementStudentNotFound when the student has no account", func(t *testing.T) {
+		mockAccountRepository.EXPECT().FindAllByStudent(gomock.Any(), studentID).Return([]models.Account{}, nil)
+		mockInvoiceRepository.EXPECT().FindAllByStudent(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		statement, err := usecase.GetByStudent(ctx, studentID, models.StatementPeriod{})
+
+		assert.EqualError(t, err, exceptions.ErrStatementStudentNotFound)
+		assert.Nil(t, statement)
+	})
+
+	t.Run("Should return ErrStatementInvalidPeriod when the period ends before it starts", func(t *testing.T) {
+		mockAccountRepository.EXPECT().FindAllByStudent(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		statement, err := usecase.GetByStudent(ctx, studentID, models.StatementPeriod{From: february.To, To: february.From})
+
+		assert.EqualError(t, err, exceptions.ErrStatementInvalidPeriod)
+		assert.Nil(t, statement)
+	})
+}
@@ -0,0 +1,33 @@
+package qrcode
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/qrcode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPNGQRCodeGenerator_Generate(t *testing.T) {
+	generator := qrcode.NewPNGQRCodeGenerator()
+
+	t.Run("Should render a square PNG made of whole modules", func(t *testing.T) {
+		result, err := generator.Generate("00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D")
+		assert.NoError(t, err)
+
+		img, err := png.Decode(bytes.NewReader(result))
+		assert.NoError(t, err)
+
+		bounds := img.Bounds()
+		assert.Equal(t, bounds.Dx(), bounds.Dy())
+		assert.Zero(t, bounds.Dx()%8)
+	})
+
+	t.Run("Should return error when the content does not fit in a QR code", func(t *testing.T) {
+		_, err := generator.Generate(strings.Repeat("9", 8000))
+
+		assert.Error(t, err)
+	})
+}
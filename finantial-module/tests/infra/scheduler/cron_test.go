@@ -0,0 +1,106 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/scheduler"
+	"github.com/stretchr/testify/assert"
+)
+
+func location(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	assert.NoError(t, err)
+
+	return loc
+}
+
+func TestParseSchedule(t *testing.T) {
+	for _, spec := range []string{
+		"* * * * *", "0 3 * * *", "*/15 8-18 * * MON-FRI", "0 0 1,15 * *",
+		"30 4 * JAN,jul sun", "0 0 * * 7", "5-10/2 * * * *", "@daily", "@HOURLY", " 0 3 * * * ",
+	} {
+		t.Run("Should parse "+spec, func(t *testing.T) {
+			_, err := scheduler.ParseSchedule(spec, time.UTC)
+
+			assert.NoError(t, err)
+		})
+	}
+
+	for _, spec := range []string{
+		"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * 32 * *",
+		"* * * 13 *", "* * * * 8", "*/0 * * * *", "*/x * * * *", "10-5 * * * *", "a * * * *",
+		"* * * FOO *", "1-2-3 * * * *", "@reboot",
+	} {
+		t.Run("Should reject "+spec, func(t *testing.T) {
+			_, err := scheduler.ParseSchedule(spec, time.UTC)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	saoPaulo := location(t, "America/Sao_Paulo")
+	newYork := location(t, "America/New_York")
+
+	tests := []struct {
+		name     string
+		spec     string
+		location *time.Location
+		from     time.Time
+		expected time.Time
+	}{
+		{"Should be strictly after the given time", "0 3 * * *", time.UTC,
+			time.Date(2024, 3, 5, 3, 0, 0, 0, time.UTC), time.Date(2024, 3, 6, 3, 0, 0, 0, time.UTC)},
+		{"Should drop the seconds of the given time", "* * * * *", time.UTC,
+			time.Date(2024, 3, 5, 3, 0, 59, 0, time.UTC), time.Date(2024, 3, 5, 3, 1, 0, 0, time.UTC)},
+		{"Should run later on the same day", "0 3 * * *", time.UTC,
+			time.Date(2024, 3, 5, 2, 59, 0, 0, time.UTC), time.Date(2024, 3, 5, 3, 0, 0, 0, time.UTC)},
+		{"Should evaluate the schedule in its location", "0 3 * * *", saoPaulo,
+			time.Date(2024, 3, 5, 5, 0, 0, 0, time.UTC), time.Date(2024, 3, 5, 6, 0, 0, 0, time.UTC)},
+		{"Should follow steps", "*/15 * * * *", time.UTC,
+			time.Date(2024, 3, 5, 10, 16, 0, 0, time.UTC), time.Date(2024, 3, 5, 10, 30, 0, 0, time.UTC)},
+		{"Should follow steps over a range", "10-40/20 * * * *", time.UTC,
+			time.Date(2024, 3, 5, 10, 31, 0, 0, time.UTC), time.Date(2024, 3, 5, 11, 10, 0, 0, time.UTC)},
+		{"Should follow steps from a value", "50/5 * * * *", time.UTC,
+			time.Date(2024, 3, 5, 10, 56, 0, 0, time.UTC), time.Date(2024, 3, 5, 11, 50, 0, 0, time.UTC)},
+		{"Should skip to the next weekday in range", "0 9 * * MON-FRI", time.UTC,
+			time.Date(2024, 3, 8, 9, 0, 0, 0, time.UTC), time.Date(2024, 3, 11, 9, 0, 0, 0, time.UTC)},
+		{"Should accept Sunday as 7", "0 0 * * 7", time.UTC,
+			time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{"Should skip months without the day", "0 0 31 * *", time.UTC,
+			time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)},
+		{"Should find leap days", "0 0 29 FEB *", time.UTC,
+			time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"Should match either day field when both are restricted", "0 0 13 * FRI", time.UTC,
+			time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 9, 6, 0, 0, 0, 0, time.UTC)},
+		{"Should match the day of month when both are restricted", "0 0 13 * FRI", time.UTC,
+			time.Date(2024, 9, 12, 0, 0, 0, 0, time.UTC), time.Date(2024, 9, 13, 0, 0, 0, 0, time.UTC)},
+		{"Should match both day fields when the day of week is a wildcard step", "0 0 1 * */2", time.UTC,
+			time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"Should skip a time missing at the spring forward", "30 2 * * *", newYork,
+			time.Date(2024, 3, 9, 12, 0, 0, 0, newYork), time.Date(2024, 3, 11, 2, 30, 0, 0, newYork)},
+		{"Should run hourly jobs across the spring forward", "0 * * * *", newYork,
+			time.Date(2024, 3, 10, 1, 30, 0, 0, newYork), time.Date(2024, 3, 10, 3, 0, 0, 0, newYork)},
+		{"Should run once on the repeated hour of the fall back", "30 1 * * *", newYork,
+			time.Date(2024, 11, 3, 1, 30, 0, 0, newYork), time.Date(2024, 11, 4, 1, 30, 0, 0, newYork)},
+		{"Should run hourly jobs once across the fall back", "0 * * * *", newYork,
+			time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC), time.Date(2024, 11, 3, 2, 0, 0, 0, newYork)},
+		{"Should return the zero time when there is no occurrence", "0 0 30 FEB *", time.UTC,
+			time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := scheduler.ParseSchedule(tt.spec, tt.location)
+			assert.NoError(t, err)
+
+			result := schedule.Next(tt.from)
+
+			assert.True(t, tt.expected.Equal(result), "expected %s, got %s", tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/finantial-module/src/infra/scheduler"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/observer"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeLocker grants each lock to one holder at a time, like the advisory
+// locks of different sessions.
+type fakeLocker struct {
+	mu       sync.Mutex
+	held     map[string]bool
+	released int
+	err      error
+}
+
+func (l *fakeLocker) TryLock(_ context.Context, name string) (func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.err != nil {
+		return nil, l.err
+	}
+
+	if l.held[name] {
+		return nil, nil
+	}
+
+	l.held[name] = true
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.held, name)
+		l.released++
+	}, nil
+}
+
+type fakeJobRunRepository struct {
+	claimed     bool
+	running     int
+	interrupted []string
+	inserted    []models.JobRun
+	finished    chan models.JobRun
+}
+
+func (r *fakeJobRunRepository) FindAll(context.Context, *models.JobRunFilter) ([]models.JobRun, error) {
+	return r.inserted, nil
+}
+
+func (r *fakeJobRunRepository) Insert(_ context.Context, run *models.JobRun) (bool, error) {
+	if r.claimed {
+		return false, nil
+	}
+
+	r.inserted = append(r.inserted, *run)
+	return true, nil
+}
+
+func (r *fakeJobRunRepository) Finish(_ context.Context, run *models.JobRun) error {
+	r.finished <- *run
+	return nil
+}
+
+func (r *fakeJobRunRepository) FailRunning(_ context.Context, jobName string, _ time.Time, reason string) (int, error) {
+	failed := r.running
+	r.running = 0
+	r.interrupted = append(r.interrupted, jobName+": "+reason)
+	return failed, nil
+}
+
+func newScheduler(t *testing.T, run func(ctx context.Context) error) (*scheduler.JobScheduler, *fakeLocker, *fakeJobRunRepository) {
+	t.Helper()
+
+	locker := &fakeLocker{held: map[string]bool{}}
+	repository := &fakeJobRunRepository{finished: make(chan models.JobRun, 1)}
+
+	s := scheduler.NewJobScheduler()
+	s.Locker = locker
+	s.Repository = repository
+	s.Instance = "test-instance"
+	assert.NoError(t, s.Register("test-job", "TEST_JOB_CRON", "off", run))
+
+	return s, locker, repository
+}
+
+func TestJobScheduler_Trigger(t *testing.T) {
+	t.Run("Should claim, run and release the job", func(t *testing.T) {
+		s, locker, repository := newScheduler(t, func(context.Context) error { return nil })
+
+		run, err := s.Trigger(context.Background(), "test-job")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test-job", run.JobName)
+		assert.Equal(t, enums.MANUAL, run.Trigger)
+		assert.Equal(t, "test-instance", run.Instance)
+		assert.False(t, run.ScheduledFor.Valid)
+
+		finished := <-repository.finished
+		assert.Equal(t, enums.SUCCEEDED, finished.Status)
+		assert.True(t, finished.FinishedAt.Valid)
+
+		s.Close()
+		assert.Equal(t, 1, locker.released)
+		assert.Empty(t, locker.held)
+	})
+
+	t.Run("Should fail the runs left running by an interrupted instance before claiming the job", func(t *testing.T) {
+		s, _, repository := newScheduler(t, func(context.Context) error { return nil })
+		repository.running = 1
+
+		_, err := s.Trigger(context.Background(), "test-job")
+		assert.NoError(t, err)
+
+		<-repository.finished
+		assert.Equal(t, []string{"test-job: job run interrupted before it finished"}, repository.interrupted)
+		assert.Equal(t, 0, repository.running)
+		s.Close()
+	})
+
+	t.Run("Should record the error of a failed run", func(t *testing.T) {
+		s, _, repository := newScheduler(t, func(context.Context) error { return errors.New("boom") })
+
+		_, err := s.Trigger(context.Background(), "test-job")
+		assert.NoError(t, err)
+
+		finished := <-repository.finished
+		assert.Equal(t, enums.FAILED, finished.Status)
+		assert.Equal(t, "boom", finished.Error)
+		s.Close()
+	})
+
+	t.Run("Should record a panic as a failure", func(t *testing.T) {
+		s, _, repository := newScheduler(t, func(context.Context) error { panic("boom") })
+
+		_, err := s.Trigger(context.Background(), "test-job")
+		assert.NoError(t, err)
+
+		finished := <-repository.finished
+		assert.Equal(t, enums.FAILED, finished.Status)
+		assert.Equal(t, "job panicked: boom", finished.Error)
+		s.Close()
+	})
+
+	t.Run("Should refuse a run while another holds the lock", func(t *testing.T) {
+		s, locker, repository := newScheduler(t, func(context.Context) error { return nil })
+		release, _ := locker.TryLock(context.Background(), "job:test-job")
+
+		run, err := s.Trigger(context.Background(), "test-job")
+
+		assert.EqualError(t, err, exceptions.ErrJobAlreadyRunning)
+		assert.Nil(t, run)
+		assert.Empty(t, repository.inserted)
+		release()
+		s.Close()
+	})
+
+	t.Run("Should release the lock when the run was already claimed", func(t *testing.T) {
+		s, locker, repository := newScheduler(t, func(context.Context) error { return nil })
+		repository.claimed = true
+
+		run, err := s.Trigger(context.Background(), "test-job")
+
+		assert.EqualError(t, err, exceptions.ErrJobAlreadyRunning)
+		assert.Nil(t, run)
+		assert.Equal(t, 1, locker.released)
+		assert.Empty(t, locker.held)
+		s.Close()
+	})
+
+	t.Run("Should return the lock error", func(t *testing.T) {
+		s, locker, _ := newScheduler(t, func(context.Context) error { return nil })
+		locker.err = errors.New("connection refused")
+
+		_, err := s.Trigger(context.Background(), "test-job")
+
+		assert.EqualError(t, err, "connection refused")
+		s.Close()
+	})
+
+	t.Run("Should return ErrJobNotFound for unknown jobs", func(t *testing.T) {
+		s, _, _ := newScheduler(t, func(context.Context) error { return nil })
+
+		_, err := s.Trigger(context.Background(), "unknown")
+
+		assert.EqualError(t, err, exceptions.ErrJobNotFound)
+		s.Close()
+	})
+}
+
+func TestJobScheduler_Start(t *testing.T) {
+	observer.Initialize()
+
+	t.Run("Should close the interrupted runs of the jobs when starting", func(t *testing.T) {
+		s, locker, repository := newScheduler(t, func(context.Context) error { return nil })
+		repository.running = 1
+
+		s.Start()
+		s.Close()
+
+		assert.Equal(t, []string{"test-job: job run interrupted before it finished"}, repository.interrupted)
+		assert.Equal(t, 0, repository.running)
+		assert.Empty(t, locker.held)
+	})
+
+	t.Run("Should keep the runs of a job running on another instance", func(t *testing.T) {
+		s, locker, repository := newScheduler(t, func(context.Context) error { return nil })
+		repository.running = 1
+		release, _ := locker.TryLock(context.Background(), "job:test-job")
+
+		s.Start()
+		s.Close()
+
+		assert.Empty(t, repository.interrupted)
+		assert.Equal(t, 1, repository.running)
+		release()
+	})
+}
+
+func TestJobScheduler_Register(t *testing.T) {
+	s, _, _ := newScheduler(t, func(context.Context) error { return nil })
+
+	t.Run("Should reject duplicated jobs", func(t *testing.T) {
+		assert.Error(t, s.Register("test-job", "TEST_JOB_CRON", "off", nil))
+	})
+
+	t.Run("Should reject invalid schedules", func(t *testing.T) {
+		assert.Error(t, s.Register("other-job", "TEST_OTHER_JOB_CRON", "61 * * * *", nil))
+	})
+
+	t.Run("Should read the schedule from the environment", func(t *testing.T) {
+		t.Setenv("TEST_ENV_JOB_CRON", "0 3 * * *")
+
+		assert.NoError(t, s.Register("env-job", "TEST_ENV_JOB_CRON", "off", nil))
+
+		jobs := s.Jobs()
+		assert.Equal(t, []string{"env-job", "test-job"}, []string{jobs[0].Name, jobs[1].Name})
+		assert.Equal(t, "0 3 * * *", jobs[0].Spec)
+		assert.True(t, jobs[0].NextRun.Valid)
+		assert.False(t, jobs[1].NextRun.Valid)
+	})
+}
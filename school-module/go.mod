@@ -4,6 +4,8 @@ go 1.24
 
 require (
 	github.com/colibriproject-dev/colibri-sdk-go v0.1.8
+	github.com/colibriproject-dev/colibri-sdk-go-examples/contracts v0.0.0
+	github.com/colibriproject-dev/colibri-sdk-go-examples/eventing v0.0.0
 	github.com/go-playground/validator/v10 v10.15.5
 	github.com/golang/mock v1.6.0
 	github.com/google/uuid v1.6.0
@@ -166,3 +168,7 @@ require (
 	google.golang.org/protobuf v1.36.7 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/colibriproject-dev/colibri-sdk-go-examples/contracts => ../contracts
+
+replace github.com/colibriproject-dev/colibri-sdk-go-examples/eventing => ../eventing
@@ -2,8 +2,11 @@ package main
 
 import (
 	"github.com/colibriproject-dev/colibri-sdk-go"
+	eventconsumers "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/consumers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/application/consumers"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/application/controllers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/application/jobs"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/validator"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/cacheDB"
@@ -26,8 +29,10 @@ func init() {
 // @description Microservice responsible for school management
 func main() {
 	registerCustomValidators()
-	registerConsumers()
-	registerRoutes()
+	queueConsumers := registerConsumers()
+	registerRoutes(queueConsumers)
+	outbox.NewRelay().Start()
+	jobs.NewEnrollmentSagaTimeoutJob().Start()
 
 	restserver.ListenAndServe()
 }
@@ -36,12 +41,22 @@ func registerCustomValidators() {
 	validator.RegisterCustomValidation("oneOfEnrollmentStatus", enums.EnrollmentStatusValidator)
 }
 
-func registerConsumers() {
-	messaging.NewConsumer(consumers.NewFinantialInstallmentConsumer())
+// registerConsumers starts the queue consumers and returns them, without the
+// retry wrapper, to be used when replaying dead letters.
+func registerConsumers() []messaging.QueueConsumer {
+	queueConsumers := []messaging.QueueConsumer{
+		eventconsumers.NewIdempotentConsumer(consumers.NewFinantialInstallmentConsumer()),
+	}
+	for _, consumer := range queueConsumers {
+		messaging.NewConsumer(eventconsumers.NewRetryConsumer(consumer))
+	}
+
+	return queueConsumers
 }
 
-func registerRoutes() {
+func registerRoutes(queueConsumers []messaging.QueueConsumer) {
 	restserver.AddRoutes(controllers.NewCoursesV1Controller().Routes())
 	restserver.AddRoutes(controllers.NewStudentController().Routes())
 	restserver.AddRoutes(controllers.NewEnrollmentsV1Controller().Routes())
+	restserver.AddRoutes(controllers.NewDeadLettersV1Controller(queueConsumers...).Routes())
 }
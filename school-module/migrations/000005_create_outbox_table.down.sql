@@ -0,0 +1,2 @@
+-- DROP outbox TABLE
+DROP TABLE IF EXISTS outbox;
@@ -0,0 +1,22 @@
+-- CREATE outbox TABLE
+CREATE TABLE IF NOT EXISTS outbox (
+    id              UUID      NOT NULL,
+    topic           TEXT      NOT NULL,
+    action          TEXT      NOT NULL,
+    payload         JSONB     NOT NULL,
+    correlation_id  TEXT      NOT NULL DEFAULT '',
+    status          TEXT      NOT NULL DEFAULT 'PENDING',
+    attempts        INT4      NOT NULL DEFAULT 0,
+    last_error      TEXT      NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    locked_until    TIMESTAMP NULL,
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW(),
+    sent_at         TIMESTAMP NULL,
+    CONSTRAINT outbox_pk PRIMARY KEY (id)
+);
+
+-- ADD INDEX TO pending outbox messages
+CREATE INDEX IF NOT EXISTS outbox_pending_idx
+ON outbox
+USING btree (next_attempt_at)
+WHERE status = 'PENDING';
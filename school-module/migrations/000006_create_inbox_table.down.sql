@@ -0,0 +1,2 @@
+-- DROP inbox TABLE
+DROP TABLE IF EXISTS inbox;
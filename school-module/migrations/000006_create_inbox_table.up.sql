@@ -0,0 +1,15 @@
+-- CREATE inbox TABLE
+CREATE TABLE IF NOT EXISTS inbox (
+    consumer     TEXT      NOT NULL,
+    message_id   UUID      NOT NULL,
+    business_key TEXT      NOT NULL DEFAULT '',
+    action       TEXT      NOT NULL,
+    received_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT inbox_pk PRIMARY KEY (consumer, message_id)
+);
+
+-- ADD UNIQUE INDEX TO inbox business keys
+CREATE UNIQUE INDEX IF NOT EXISTS inbox_business_key_un
+ON inbox
+USING btree (consumer, business_key)
+WHERE business_key <> '';
@@ -0,0 +1,2 @@
+-- DROP dead_letters TABLE
+DROP TABLE IF EXISTS dead_letters;
@@ -0,0 +1,23 @@
+-- CREATE dead_letters TABLE
+CREATE TABLE IF NOT EXISTS dead_letters (
+    id             UUID      NOT NULL DEFAULT uuid_generate_v4(),
+    queue          TEXT      NOT NULL,
+    message_id     UUID      NOT NULL,
+    origin         TEXT      NOT NULL DEFAULT '',
+    action         TEXT      NOT NULL,
+    payload        JSONB     NOT NULL,
+    correlation_id TEXT      NOT NULL DEFAULT '',
+    error          TEXT      NOT NULL,
+    attempts       INT4      NOT NULL,
+    status         TEXT      NOT NULL DEFAULT 'PENDING',
+    created_at     TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMP NOT NULL DEFAULT NOW(),
+    resolved_at    TIMESTAMP NULL,
+    CONSTRAINT dead_letters_pk PRIMARY KEY (id),
+    CONSTRAINT dead_letters_message_un UNIQUE (queue, message_id)
+);
+
+-- ADD INDEX TO dead letters by status
+CREATE INDEX IF NOT EXISTS dead_letters_status_idx
+ON dead_letters
+USING btree (status, created_at DESC);
@@ -0,0 +1,2 @@
+-- DROP enrollment_sagas TABLE
+DROP TABLE IF EXISTS enrollment_sagas;
@@ -0,0 +1,32 @@
+-- CREATE enrollment_sagas TABLE
+CREATE TABLE IF NOT EXISTS enrollment_sagas (
+    id          UUID      NOT NULL DEFAULT uuid_generate_v4(),
+    student_id  UUID      NOT NULL,
+    course_id   UUID      NOT NULL,
+    status      TEXT      NOT NULL DEFAULT 'STARTED',
+    account_id  UUID      NULL,
+    reason      TEXT      NOT NULL DEFAULT '',
+    expires_at  TIMESTAMP NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP NULL,
+    CONSTRAINT enrollment_sagas_pk PRIMARY KEY (id),
+    CONSTRAINT enrollment_sagas_enrollments_fk FOREIGN KEY (student_id, course_id) REFERENCES enrollments (student_id, course_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- ADD UNIQUE INDEX TO running sagas, leaving finished ones so the student can re-enroll
+CREATE UNIQUE INDEX IF NOT EXISTS enrollment_sagas_running_un
+ON enrollment_sagas
+USING btree (student_id, course_id)
+WHERE status = 'STARTED';
+
+-- ADD INDEX TO sagas of an enrollment by creation
+CREATE INDEX IF NOT EXISTS enrollment_sagas_enrollment_idx
+ON enrollment_sagas
+USING btree (student_id, course_id, created_at);
+
+-- ADD INDEX TO running sagas by expiration
+CREATE INDEX IF NOT EXISTS enrollment_sagas_expires_at_idx
+ON enrollment_sagas
+USING btree (expires_at)
+WHERE status = 'STARTED';
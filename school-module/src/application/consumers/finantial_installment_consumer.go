@@ -0,0 +1,69 @@
+package consumers
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	eventconsumers "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/consumers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+)
+
+type FinantialInstallmentConsumer struct {
+	*eventconsumers.ActionRouter
+	UpdateEnrollmentStatusUsecase usecases.IUpdateEnrollmentStatusUsecase
+	ConfirmEnrollmentUsecase      usecases.IConfirmEnrollmentUsecase
+	CancelEnrollmentUsecase       usecases.ICancelEnrollmentUsecase
+}
+
+func NewFinantialInstallmentConsumer() *FinantialInstallmentConsumer {
+	c := &FinantialInstallmentConsumer{
+		ActionRouter:                  eventconsumers.NewActionRouter("FINANCIAL_INSTALLMENT_SCHOOL"),
+		UpdateEnrollmentStatusUsecase: usecases.NewUpdateEnrollmentStatusUsecase(),
+		ConfirmEnrollmentUsecase:      usecases.NewConfirmEnrollmentUsecase(),
+		CancelEnrollmentUsecase:       usecases.NewCancelEnrollmentUsecase(),
+	}
+
+	eventconsumers.HandleEvent(c.ActionRouter, c.accountCreated)
+	eventconsumers.HandleEvent(c.ActionRouter, c.accountRejected)
+	eventconsumers.HandleEvent(c.ActionRouter, c.accountStatusUpdated)
+	eventconsumers.HandleEvent(c.ActionRouter, c.accountRenegotiated)
+
+	return c
+}
+
+func (c *FinantialInstallmentConsumer) accountCreated(ctx context.Context, data *contracts.AccountCreatedV1) error {
+	return c.ConfirmEnrollmentUsecase.Execute(ctx, &models.EnrollmentConfirm{
+		StudentID: data.StudentID,
+		CourseID:  data.CourseID,
+		AccountID: data.ID,
+	})
+}
+
+func (c *FinantialInstallmentConsumer) accountRejected(ctx context.Context, data *contracts.AccountRejectedV1) error {
+	return c.CancelEnrollmentUsecase.Execute(ctx, &models.EnrollmentCancel{
+		StudentID:  data.StudentID,
+		CourseID:   data.CourseID,
+		Reason:     data.Reason,
+		SagaStatus: enums.ENROLLMENT_SAGA_REJECTED,
+	})
+}
+
+func (c *FinantialInstallmentConsumer) accountStatusUpdated(ctx context.Context, data *contracts.AccountStatusUpdatedV1) error {
+	return c.UpdateEnrollmentStatusUsecase.Execute(ctx, &models.EnrollmentUpdateStatus{
+		StudentID: data.StudentID,
+		CourseID:  data.CourseID,
+		Status:    enums.EnrollmentStatus(data.Status),
+	})
+}
+
+// accountRenegotiated updates the enrollment with the account status after
+// the overdue invoices were renegotiated.
+func (c *FinantialInstallmentConsumer) accountRenegotiated(ctx context.Context, data *contracts.AccountRenegotiatedV1) error {
+	return c.UpdateEnrollmentStatusUsecase.Execute(ctx, &models.EnrollmentUpdateStatus{
+		StudentID: data.StudentID,
+		CourseID:  data.CourseID,
+		Status:    enums.EnrollmentStatus(data.Status),
+	})
+}
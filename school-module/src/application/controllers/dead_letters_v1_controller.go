@@ -0,0 +1,182 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+	"github.com/google/uuid"
+)
+
+type DeadLettersV1Controller struct {
+	GetAllDeadLetterUsecase  usecases.IGetAllDeadLetterUsecase
+	GetDeadLetterByIdUsecase usecases.IGetDeadLetterByIdUsecase
+	ReplayDeadLetterUsecase  usecases.IReplayDeadLetterUsecase
+	DiscardDeadLetterUsecase usecases.IDiscardDeadLetterUsecase
+}
+
+// NewDeadLettersV1Controller receives the queue consumers used to replay the
+// dead-lettered messages.
+func NewDeadLettersV1Controller(consumers ...messaging.QueueConsumer) *DeadLettersV1Controller {
+	return &DeadLettersV1Controller{
+		GetAllDeadLetterUsecase:  usecases.NewGetAllDeadLetterUsecase(),
+		GetDeadLetterByIdUsecase: usecases.NewGetDeadLetterByIdUsecase(),
+		ReplayDeadLetterUsecase:  usecases.NewReplayDeadLetterUsecase(consumers...),
+		DiscardDeadLetterUsecase: usecases.NewDiscardDeadLetterUsecase(),
+	}
+}
+
+func (c *DeadLettersV1Controller) Routes() []restserver.Route {
+	const basePath = "v1/dead-letters"
+	const basePathWithId = basePath + "/{id}"
+
+	return []restserver.Route{
+		{
+			URI:      basePath,
+			Method:   http.MethodGet,
+			Function: c.GetAllDeadLetter,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      basePathWithId,
+			Method:   http.MethodGet,
+			Function: c.GetDeadLetterById,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      basePathWithId + "/replay",
+			Method:   http.MethodPost,
+			Function: c.ReplayDeadLetter,
+			Prefix:   restserver.PublicApi,
+		},
+		{
+			URI:      basePathWithId,
+			Method:   http.MethodDelete,
+			Function: c.DiscardDeadLetter,
+			Prefix:   restserver.PublicApi,
+		},
+	}
+}
+
+// @Summary Get dead-lettered messages
+// @Tags dead-letters
+// @Accept json
+// @Produce json
+// @Success 200 {array} deadletters.DeadLetter
+// @Failure 400
+// @Failure 500
+// @Param queue query string false "name of queue"
+// @Param status query string false "status of dead letter" Enums(PENDING, REPLAYED, DISCARDED)
+// @Param limit query uint16 false "maximum number of messages" maximum(500) default(50)
+// @Router /public/v1/dead-letters [get]
+func (c *DeadLettersV1Controller) GetAllDeadLetter(wctx restserver.WebContext) {
+	var params models.DeadLetterParams
+	if err := wctx.DecodeQueryParams(&params); err != nil {
+		wctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := c.GetAllDeadLetterUsecase.Execute(wctx.Context(), &params)
+	if err != nil {
+		wctx.ErrorResponse(http.StatusInternalServerError, err)
+		return
+	}
+
+	wctx.JsonResponse(http.StatusOK, result)
+}
+
+// @Summary Get dead-lettered message by id
+// @Tags dead-letters
+// @Accept json
+// @Produce json
+// @Success 200 {object} deadletters.DeadLetter
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Param id path string true "ID of dead letter"
+// @Router /public/v1/dead-letters/{id} [get]
+func (c *DeadLettersV1Controller) GetDeadLetterById(wctx restserver.WebContext) {
+	paramId, err := uuid.Parse(wctx.PathParam("id"))
+	if err != nil {
+		wctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := c.GetDeadLetterByIdUsecase.Execute(wctx.Context(), paramId)
+	if err != nil {
+		deadLetterErrorResponse(wctx, err)
+		return
+	}
+
+	wctx.JsonResponse(http.StatusOK, result)
+}
+
+// @Summary Replay dead-lettered message
+// @Tags dead-letters
+// @Accept json
+// @Produce json
+// @Success 200 {object} deadletters.DeadLetter
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Param id path string true "ID of dead letter"
+// @Router /public/v1/dead-letters/{id}/replay [post]
+func (c *DeadLettersV1Controller) ReplayDeadLetter(wctx restserver.WebContext) {
+	paramId, err := uuid.Parse(wctx.PathParam("id"))
+	if err != nil {
+		wctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := c.ReplayDeadLetterUsecase.Execute(wctx.Context(), paramId)
+	if err != nil {
+		deadLetterErrorResponse(wctx, err)
+		return
+	}
+
+	wctx.JsonResponse(http.StatusOK, result)
+}
+
+// @Summary Discard dead-lettered message
+// @Tags dead-letters
+// @Accept json
+// @Produce json
+// @Success 204
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Param id path string true "ID of dead letter"
+// @Router /public/v1/dead-letters/{id} [delete]
+func (c *DeadLettersV1Controller) DiscardDeadLetter(wctx restserver.WebContext) {
+	paramId, err := uuid.Parse(wctx.PathParam("id"))
+	if err != nil {
+		wctx.ErrorResponse(http.StatusBadRequest, err)
+		return
+	}
+
+	if err := c.DiscardDeadLetterUsecase.Execute(wctx.Context(), paramId); err != nil {
+		deadLetterErrorResponse(wctx, err)
+		return
+	}
+
+	wctx.EmptyResponse(http.StatusNoContent)
+}
+
+func deadLetterErrorResponse(wctx restserver.WebContext, err error) {
+	switch err.Error() {
+	case exceptions.ErrDeadLetterNotFound:
+		wctx.ErrorResponse(http.StatusNotFound, err)
+	case exceptions.ErrDeadLetterAlreadyResolved:
+		wctx.ErrorResponse(http.StatusConflict, err)
+	case exceptions.ErrDeadLetterQueueNotFound, exceptions.ErrDeadLetterReplayFailed:
+		wctx.ErrorResponse(http.StatusUnprocessableEntity, err)
+	default:
+		wctx.ErrorResponse(http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,115 @@
+package jobs
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/observer"
+)
+
+const (
+	defaultEnrollmentSagaCheckInterval = time.Minute
+	defaultEnrollmentSagaBatchSize     = 100
+)
+
+// EnrollmentSagaTimeoutJob periodically cancels the enrollments whose saga
+// expired without a reply from finantial-module. Expired sagas are locked
+// while they are cancelled, so several instances can run the job at the same
+// time.
+type EnrollmentSagaTimeoutJob struct {
+	Usecase   usecases.IExpireEnrollmentSagasUsecase
+	Interval  time.Duration
+	BatchSize int
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewEnrollmentSagaTimeoutJob() *EnrollmentSagaTimeoutJob {
+	return &EnrollmentSagaTimeoutJob{
+		Usecase:   usecases.NewExpireEnrollmentSagasUsecase(),
+		Interval:  durationEnv("ENROLLMENT_SAGA_CHECK_INTERVAL", defaultEnrollmentSagaCheckInterval),
+		BatchSize: intEnv("ENROLLMENT_SAGA_BATCH_SIZE", defaultEnrollmentSagaBatchSize),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start runs the job in background until the application shuts down.
+func (j *EnrollmentSagaTimeoutJob) Start() {
+	observer.Attach(j)
+	go j.loop()
+}
+
+func (j *EnrollmentSagaTimeoutJob) Close() {
+	close(j.stop)
+	<-j.done
+	logging.Info(context.Background()).Msg("Enrollment saga timeout job stopped")
+}
+
+func (j *EnrollmentSagaTimeoutJob) loop() {
+	defer close(j.done)
+
+	ticker := time.NewTicker(j.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-j.stop:
+			return
+		case <-ticker.C:
+		}
+
+		j.Run(context.Background())
+	}
+}
+
+// Run cancels the expired sagas in batches until none is left.
+func (j *EnrollmentSagaTimeoutJob) Run(ctx context.Context) {
+	for {
+		expired, err := j.Usecase.Execute(ctx, j.BatchSize)
+		if err != nil {
+			logging.Error(ctx).Err(err).Msg("Could not expire enrollment sagas")
+			return
+		}
+
+		if expired > 0 {
+			logging.Info(ctx).AddParam("expired", expired).Msg("Enrollment sagas expired")
+		}
+
+		if expired < j.BatchSize || j.stopped() {
+			return
+		}
+	}
+}
+
+func (j *EnrollmentSagaTimeoutJob) stopped() bool {
+	select {
+	case <-j.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+func durationEnv(name string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(name))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+
+	return value
+}
+
+func intEnv(name string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+
+	return value
+}
@@ -0,0 +1,14 @@
+package enums
+
+type EnrollmentSagaStatus string
+
+const (
+	ENROLLMENT_SAGA_STARTED   EnrollmentSagaStatus = "STARTED"
+	ENROLLMENT_SAGA_COMPLETED EnrollmentSagaStatus = "COMPLETED"
+	ENROLLMENT_SAGA_REJECTED  EnrollmentSagaStatus = "REJECTED"
+	ENROLLMENT_SAGA_TIMED_OUT EnrollmentSagaStatus = "TIMED_OUT"
+)
+
+func (obj EnrollmentSagaStatus) String() string {
+	return string(obj)
+}
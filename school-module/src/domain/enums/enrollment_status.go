@@ -9,13 +9,17 @@ import (
 type EnrollmentStatus string
 
 const (
-	ADIMPLENTE   EnrollmentStatus = "ADIMPLENTE"
-	INADIMPLENTE EnrollmentStatus = "INADIMPLENTE"
+	PENDING_FINANCIAL EnrollmentStatus = "PENDING_FINANCIAL"
+	ADIMPLENTE        EnrollmentStatus = "ADIMPLENTE"
+	INADIMPLENTE      EnrollmentStatus = "INADIMPLENTE"
+	CANCELADO         EnrollmentStatus = "CANCELADO"
 )
 
 var enrollmentStatusValues = []string{
+	PENDING_FINANCIAL.String(),
 	ADIMPLENTE.String(),
 	INADIMPLENTE.String(),
+	CANCELADO.String(),
 }
 
 func (obj EnrollmentStatus) String() string {
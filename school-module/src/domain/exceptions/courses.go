@@ -14,4 +14,7 @@ const (
 	ErrOnInsertCourse       string = "errOnInsertCourse"
 	ErrOnUpdateCourse       string = "errOnUpdateCourse"
 	ErrOnDeleteCourse       string = "errOnDeleteCourse"
+	ErrOnSendCourseCreated  string = "errOnSendCourseCreated"
+	ErrOnSendCourseUpdated  string = "errOnSendCourseUpdated"
+	ErrOnSendCourseDeleted  string = "errOnSendCourseDeleted"
 )
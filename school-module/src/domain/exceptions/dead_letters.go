@@ -0,0 +1,14 @@
+package exceptions
+
+const (
+	// Business exceptions
+	ErrDeadLetterNotFound        string = "errDeadLetterNotFound"
+	ErrDeadLetterAlreadyResolved string = "errDeadLetterAlreadyResolved"
+	ErrDeadLetterQueueNotFound   string = "errDeadLetterQueueNotFound"
+	ErrDeadLetterReplayFailed    string = "errDeadLetterReplayFailed"
+
+	// Infra exceptions
+	ErrOnFindAllDeadLetters     string = "errOnFindAllDeadLetters"
+	ErrOnFindDeadLetterById     string = "errOnFindDeadLetterById"
+	ErrOnUpdateDeadLetterStatus string = "errOnUpdateDeadLetterStatus"
+)
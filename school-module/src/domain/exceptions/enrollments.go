@@ -2,16 +2,26 @@ package exceptions
 
 const (
 	// Business exceptions
-	ErrEnrollmentNotFound      string = "errEnrollmentNotFound"
-	ErrEnrollmentAlreadyExists string = "errEnrollmentAlreadyExists"
+	ErrEnrollmentNotFound            string = "errEnrollmentNotFound"
+	ErrEnrollmentAlreadyExists       string = "errEnrollmentAlreadyExists"
+	ErrEnrollmentSagaNotFound        string = "errEnrollmentSagaNotFound"
+	ErrEnrollmentSagaAlreadyFinished string = "errEnrollmentSagaAlreadyFinished"
 
 	// Infra exceptions
 	ErrOnFindAllPaginatedEnrollment             string = "ErrOnFindAllPaginatedEnrollment"
 	ErrOnFindEnrollmentById                     string = "errOnFindEnrollmentById"
+	ErrOnFindAllEnrollmentSnapshots             string = "errOnFindAllEnrollmentSnapshots"
 	ErrOnExistsEnrollmentById                   string = "errOnExistsEnrollmentById"
 	ErrOnExistsEnrollmentByStudentIdAndCourseId string = "errOnExistsEnrollmentByStudentIdAndCourseId"
 	ErrOnInsertEnrollment                       string = "errOnInsertEnrollment"
 	ErrOnUpdateEnrollment                       string = "errOnUpdateEnrollment"
 	ErrOnDeleteEnrollment                       string = "errOnDeleteEnrollment"
 	ErrOnUpdateEnrollmentStatus                 string = "errOnUpdateEnrollmentStatus"
+	ErrOnSendEnrollmentCreated                  string = "errOnSendEnrollmentCreated"
+	ErrOnSendEnrollmentDeleted                  string = "errOnSendEnrollmentDeleted"
+	ErrOnSendEnrollmentCancelled                string = "errOnSendEnrollmentCancelled"
+	ErrOnInsertEnrollmentSaga                   string = "errOnInsertEnrollmentSaga"
+	ErrOnFindEnrollmentSaga                     string = "errOnFindEnrollmentSaga"
+	ErrOnFinishEnrollmentSaga                   string = "errOnFinishEnrollmentSaga"
+	ErrOnFindExpiredEnrollmentSagas             string = "errOnFindExpiredEnrollmentSagas"
 )
@@ -15,4 +15,5 @@ const (
 	ErrOnUpdateStudent            string = "errOnUpdateStudent"
 	ErrOnDeleteStudent            string = "errOnDeleteStudent"
 	ErrOnUploadStudentDocument    string = "errOnUploadStudentDocument"
+	ErrOnSendStudentDeleted       string = "errOnSendStudentDeleted"
 )
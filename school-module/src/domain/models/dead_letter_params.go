@@ -0,0 +1,11 @@
+package models
+
+import (
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+)
+
+type DeadLetterParams struct {
+	Queue  string             `form:"queue"`
+	Status deadletters.Status `form:"status"`
+	Limit  uint16             `form:"limit" validate:"max=500"`
+}
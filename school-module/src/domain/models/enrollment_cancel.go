@@ -0,0 +1,15 @@
+package models
+
+import (
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/google/uuid"
+)
+
+// EnrollmentCancel ends the saga of an enrollment that was not confirmed,
+// with REJECTED or TIMED_OUT as SagaStatus.
+type EnrollmentCancel struct {
+	StudentID  uuid.UUID
+	CourseID   uuid.UUID
+	Reason     string
+	SagaStatus enums.EnrollmentSagaStatus
+}
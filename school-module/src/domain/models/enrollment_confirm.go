@@ -0,0 +1,11 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+type EnrollmentConfirm struct {
+	StudentID uuid.UUID
+	CourseID  uuid.UUID
+	AccountID uuid.UUID
+}
@@ -12,7 +12,8 @@ type EnrollmentCreatedStudent struct {
 }
 
 type EnrollmentCreatedCourse struct {
-	ID uuid.UUID `json:"id"`
+	ID    uuid.UUID `json:"id"`
+	Value float64   `json:"value"`
 }
 
 type EnrollmentCreated struct {
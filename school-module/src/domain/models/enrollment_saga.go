@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+)
+
+// EnrollmentSaga tracks the financial confirmation of an enrollment. It is
+// started with the enrollment and finished when finantial-module replies or
+// when it expires.
+type EnrollmentSaga struct {
+	ID         uuid.UUID                  `json:"id"`
+	StudentID  uuid.UUID                  `json:"studentId"`
+	CourseID   uuid.UUID                  `json:"courseId"`
+	Status     enums.EnrollmentSagaStatus `json:"status"`
+	AccountID  uuid.NullUUID              `json:"accountId"`
+	Reason     string                     `json:"reason"`
+	ExpiresAt  time.Time                  `json:"expiresAt"`
+	CreatedAt  time.Time                  `json:"createdAt"`
+	UpdatedAt  time.Time                  `json:"updatedAt"`
+	FinishedAt types.NullDateTime         `json:"finishedAt"`
+}
@@ -0,0 +1,10 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+type EnrollmentSagaParams struct {
+	StudentID uuid.UUID `form:"studentId" validate:"required"`
+	CourseID  uuid.UUID `form:"courseId" validate:"required"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/google/uuid"
+)
+
+// EnrollmentSnapshot is the state of an enrollment exposed to other modules,
+// so they can reconcile their own copies of it.
+type EnrollmentSnapshot struct {
+	StudentID    uuid.UUID              `json:"studentId"`
+	CourseID     uuid.UUID              `json:"courseId"`
+	CourseValue  float64                `json:"courseValue"`
+	Installments uint8                  `json:"installments"`
+	Status       enums.EnrollmentStatus `json:"status"`
+	CreatedAt    time.Time              `json:"createdAt"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// EnrollmentSnapshotParams pages through the enrollments ordered by student
+// and course, starting after the given pair.
+type EnrollmentSnapshotParams struct {
+	AfterStudentID uuid.UUID `form:"afterStudentId"`
+	AfterCourseID  uuid.UUID `form:"afterCourseId"`
+	Limit          uint16    `form:"limit" validate:"max=1000"`
+}
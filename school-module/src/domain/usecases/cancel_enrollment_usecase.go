@@ -0,0 +1,133 @@
+//go:generate mockgen -source cancel_enrollment_usecase.go -destination mock/cancel_enrollment_usecase_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+)
+
+const (
+	errAnErrorOccurredInCancelEnrollmentUsecaseMsg string = "an error occurred in CancelEnrollmentUsecase"
+)
+
+type ICancelEnrollmentUsecase interface {
+	Execute(ctx context.Context, model *models.EnrollmentCancel) error
+}
+
+type CancelEnrollmentUsecase struct {
+	EnrollmentRepository        repositories.IEnrollmentsRepository
+	EnrollmentSagaRepository    repositories.IEnrollmentSagasRepository
+	EnrollmentCancelledProducer producers.IEnrollmentCancelledProducer
+	UnitOfWork                  transactions.UnitOfWork
+}
+
+func NewCancelEnrollmentUsecase() *CancelEnrollmentUsecase {
+	return &CancelEnrollmentUsecase{
+		EnrollmentRepository:        repositories.NewEnrollmentsDBRepository(),
+		EnrollmentSagaRepository:    repositories.NewEnrollmentSagasDBRepository(),
+		EnrollmentCancelledProducer: producers.NewEnrollmentCancelledProducer(),
+		UnitOfWork:                  transactions.NewSQLUnitOfWork(),
+	}
+}
+
+// Execute cancels the enrollment whose saga is still running. A timed out
+// saga also sends the cancellation, since finantial-module may have opened an
+// account whose confirmation was not received; a rejected one has nothing to
+// undo. Sagas already finished are left as they are.
+func (u *CancelEnrollmentUsecase) Execute(ctx context.Context, model *models.EnrollmentCancel) error {
+	return u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		saga, err := u.findEnrollmentSaga(ctx, model)
+		if err != nil {
+			return err
+		}
+
+		if saga == nil || saga.Status != enums.ENROLLMENT_SAGA_STARTED {
+			logging.Warn(ctx).
+				AddParam("model", model).
+				Msg("Cancellation ignored for an enrollment without running saga")
+			return nil
+		}
+
+		if err := u.cancelEnrollment(ctx, saga, model); err != nil {
+			return err
+		}
+
+		if model.SagaStatus != enums.ENROLLMENT_SAGA_TIMED_OUT {
+			return nil
+		}
+
+		return u.sendCancelledEnrollmentNotification(ctx, model)
+	})
+}
+
+func (u *CancelEnrollmentUsecase) findEnrollmentSaga(ctx context.Context, model *models.EnrollmentCancel) (*models.EnrollmentSaga, error) {
+	saga, err := u.EnrollmentSagaRepository.FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID)
+	if err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "EnrollmentSagaRepository.FindByStudentIdAndCourseIdForUpdate").
+			AddParam("model", model).
+			Msg(errAnErrorOccurredInCancelEnrollmentUsecaseMsg)
+		return nil, errors.New(exceptions.ErrOnFindEnrollmentSaga)
+	}
+
+	return saga, nil
+}
+
+func (u *CancelEnrollmentUsecase) cancelEnrollment(ctx context.Context, saga *models.EnrollmentSaga, model *models.EnrollmentCancel) error {
+	status := &models.EnrollmentUpdateStatus{
+		StudentID: model.StudentID,
+		CourseID:  model.CourseID,
+		Status:    enums.CANCELADO,
+	}
+
+	if err := u.EnrollmentRepository.UpdateStatus(ctx, status); err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "EnrollmentRepository.UpdateStatus").
+			AddParam("model", status).
+			Msg(errAnErrorOccurredInCancelEnrollmentUsecaseMsg)
+		return errors.New(exceptions.ErrOnUpdateEnrollmentStatus)
+	}
+
+	saga.Status = model.SagaStatus
+	saga.Reason = model.Reason
+	finished, err := u.EnrollmentSagaRepository.Finish(ctx, saga)
+	if err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "EnrollmentSagaRepository.Finish").
+			AddParam("model", saga).
+			Msg(errAnErrorOccurredInCancelEnrollmentUsecaseMsg)
+		return errors.New(exceptions.ErrOnFinishEnrollmentSaga)
+	}
+
+	// A saga finished since it was read is not overwritten: rolling back
+	// retries the message against the saga as it was finished.
+	if !finished {
+		return errors.New(exceptions.ErrEnrollmentSagaAlreadyFinished)
+	}
+
+	return nil
+}
+
+func (u *CancelEnrollmentUsecase) sendCancelledEnrollmentNotification(ctx context.Context, model *models.EnrollmentCancel) error {
+	if err := u.EnrollmentCancelledProducer.Send(ctx, model); err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "EnrollmentCancelledProducer.Send").
+			AddParam("model", model).
+			Msg(errAnErrorOccurredInCancelEnrollmentUsecaseMsg)
+		return errors.New(exceptions.ErrOnSendEnrollmentCancelled)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+//go:generate mockgen -source confirm_enrollment_usecase.go -destination mock/confirm_enrollment_usecase_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/google/uuid"
+)
+
+const (
+	errAnErrorOccurredInConfirmEnrollmentUsecaseMsg string = "an error occurred in ConfirmEnrollmentUsecase"
+)
+
+type IConfirmEnrollmentUsecase interface {
+	Execute(ctx context.Context, model *models.EnrollmentConfirm) error
+}
+
+type ConfirmEnrollmentUsecase struct {
+	EnrollmentRepository        repositories.IEnrollmentsRepository
+	EnrollmentSagaRepository    repositories.IEnrollmentSagasRepository
+	EnrollmentCancelledProducer producers.IEnrollmentCancelledProducer
+	UnitOfWork                  transactions.UnitOfWork
+}
+
+func NewConfirmEnrollmentUsecase() *ConfirmEnrollmentUsecase {
+	return &ConfirmEnrollmentUsecase{
+		EnrollmentRepository:        repositories.NewEnrollmentsDBRepository(),
+		EnrollmentSagaRepository:    repositories.NewEnrollmentSagasDBRepository(),
+		EnrollmentCancelledProducer: producers.NewEnrollmentCancelledProducer(),
+		UnitOfWork:                  transactions.NewSQLUnitOfWork(),
+	}
+}
+
+// Execute activates the enrollment whose account was opened. When the saga
+// was already cancelled, the account arrived too late and the cancellation is
+// sent again so finantial-module undoes it.
+func (u *ConfirmEnrollmentUsecase) Execute(ctx context.Context, model *models.EnrollmentConfirm) error {
+	return u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		saga, err := u.findEnrollmentSaga(ctx, model)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case saga == nil:
+			logging.Warn(ctx).
+				AddParam("model", model).
+				Msg("Account created for an enrollment without saga")
+			return nil
+		case saga.Status == enums.ENROLLMENT_SAGA_STARTED:
+			return u.completeEnrollmentSaga(ctx, saga, model.AccountID)
+		case saga.Status == enums.ENROLLMENT_SAGA_REJECTED, saga.Status == enums.ENROLLMENT_SAGA_TIMED_OUT:
+			return u.sendCancelledEnrollmentNotification(ctx, saga)
+		default:
+			return nil
+		}
+	})
+}
+
+func (u *ConfirmEnrollmentUsecase) findEnrollmentSaga(ctx context.Context, model *models.EnrollmentConfirm) (*models.EnrollmentSaga, error) {
+	saga, err := u.EnrollmentSagaRepository.FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID)
+	if err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "EnrollmentSagaRepository.FindByStudentIdAndCourseIdForUpdate").
+			AddParam("model", model).
+			Msg(errAnErrorOccurredInConfirmEnrollmentUsecaseMsg)
+		return nil, errors.New(exceptions.ErrOnFindEnrollmentSaga)
+	}
+
+	return saga, nil
+}
+
+func (u *ConfirmEnrollmentUsecase) completeEnrollmentSaga(ctx context.Context, saga *models.EnrollmentSaga, accountID uuid.UUID) error {
+	status := &models.EnrollmentUpdateStatus{
+		StudentID: saga.StudentID,
+		CourseID:  saga.CourseID,
+		Status:    enums.ADIMPLENTE,
+	}
+
+	if err := u.EnrollmentRepository.UpdateStatus(ctx, status); err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "EnrollmentRepository.UpdateStatus").
+			AddParam("model", status).
+			Msg(errAnErrorOccurredInConfirmEnrollmentUsecaseMsg)
+		return errors.New(exceptions.ErrOnUpdateEnrollmentStatus)
+	}
+
+	saga.Status = enums.ENROLLMENT_SAGA_COMPLETED
+	saga.AccountID = uuid.NullUUID{UUID: accountID, Valid: true}
+	finished, err := u.EnrollmentSagaRepository.Finish(ctx, saga)
+	if err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "EnrollmentSagaRepository.Finish").
+			AddParam("model", saga).
+			Msg(errAnErrorOccurredInConfirmEnrollmentUsecaseMsg)
+		return errors.New(exceptions.ErrOnFinishEnrollmentSaga)
+	}
+
+	// A saga finished since it was read is not overwritten: rolling back
+	// retries the message against the saga as it was finished.
+	if !finished {
+		return errors.New(exceptions.ErrEnrollmentSagaAlreadyFinished)
+	}
+
+	return nil
+}
+
+func (u *ConfirmEnrollmentUsecase) sendCancelledEnrollmentNotification(ctx context.Context, saga *models.EnrollmentSaga) error {
+	model := &models.EnrollmentCancel{
+		StudentID:  saga.StudentID,
+		CourseID:   saga.CourseID,
+		Reason:     saga.Reason,
+		SagaStatus: saga.Status,
+	}
+
+	if err := u.EnrollmentCancelledProducer.Send(ctx, model); err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "EnrollmentCancelledProducer.Send").
+			AddParam("model", model).
+			Msg(errAnErrorOccurredInConfirmEnrollmentUsecaseMsg)
+		return errors.New(exceptions.ErrOnSendEnrollmentCancelled)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers"
@@ -21,23 +22,38 @@ type ICreateCourseUsecase interface {
 }
 
 type CreateCourseUsecase struct {
-	CourseRepository repositories.ICoursesRepository
-	createdProducer  producers.ICourseCreatedProducer
+	CourseRepository      repositories.ICoursesRepository
+	CourseCreatedProducer producers.ICourseCreatedProducer
+	UnitOfWork            transactions.UnitOfWork
 }
 
 func NewCreateCourseUsecase() *CreateCourseUsecase {
 	return &CreateCourseUsecase{
-		CourseRepository: repositories.NewCoursesDBRepository(),
-		createdProducer:  producers.NewCourseCreatedProducer(),
+		CourseRepository:      repositories.NewCoursesDBRepository(),
+		CourseCreatedProducer: producers.NewCourseCreatedProducer(),
+		UnitOfWork:            transactions.NewSQLUnitOfWork(),
 	}
 }
 
 func (u *CreateCourseUsecase) Execute(ctx context.Context, model *models.CourseCreate) (*models.Course, error) {
-	if err := u.existsCourseByName(ctx, model); err != nil {
+	var result *models.Course
+	err := u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		if err := u.existsCourseByName(ctx, model); err != nil {
+			return err
+		}
+
+		var err error
+		if result, err = u.insertCourse(ctx, model); err != nil {
+			return err
+		}
+
+		return u.sendCreatedCourseNotification(ctx, result)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return u.insertCourse(ctx, model)
+	return result, nil
 }
 
 func (u *CreateCourseUsecase) existsCourseByName(ctx context.Context, model *models.CourseCreate) error {
@@ -73,12 +89,18 @@ func (u *CreateCourseUsecase) insertCourse(ctx context.Context, model *models.Co
 		AddParam("model", result).
 		Msg("course created")
 
-	if err := u.createdProducer.Send(ctx, result); err != nil {
+	return result, nil
+}
+
+func (u *CreateCourseUsecase) sendCreatedCourseNotification(ctx context.Context, course *models.Course) error {
+	if err := u.CourseCreatedProducer.Send(ctx, course); err != nil {
 		logging.Error(ctx).
 			Err(err).
 			AddParam("step", "CourseCreatedProducer.Send").
-			AddParam("model", result)
+			AddParam("model", course).
+			Msg(errAnErrorOccurredInCreateCourseUsecaseMsg)
+		return errors.New(exceptions.ErrOnSendCourseCreated)
 	}
 
-	return result, nil
+	return nil
 }
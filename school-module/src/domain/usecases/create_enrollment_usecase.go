@@ -4,7 +4,10 @@ package usecases
 import (
 	"context"
 	"errors"
+	"os"
+	"time"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
@@ -15,6 +18,8 @@ import (
 
 const (
 	errAnErrorOccurredInCreateEnrollmentUsecaseMsg string = "an error occurred in CreateEnrollmentUsecase"
+
+	defaultEnrollmentSagaTimeout = 15 * time.Minute
 )
 
 type ICreateEnrollmentUsecase interface {
@@ -25,7 +30,10 @@ type CreateEnrollmentUsecase struct {
 	CourseRepository          repositories.ICoursesRepository
 	StudentRepository         repositories.IStudentsRepository
 	EnrollmentRepository      repositories.IEnrollmentsRepository
+	EnrollmentSagaRepository  repositories.IEnrollmentSagasRepository
 	EnrollmentCreatedProducer producers.IEnrollmentCreatedProducer
+	UnitOfWork                transactions.UnitOfWork
+	SagaTimeout               time.Duration
 }
 
 func NewCreateEnrollmentUsecase() *CreateEnrollmentUsecase {
@@ -33,33 +41,52 @@ func NewCreateEnrollmentUsecase() *CreateEnrollmentUsecase {
 		CourseRepository:          repositories.NewCoursesDBRepository(),
 		StudentRepository:         repositories.NewStudentsDBRepository(),
 		EnrollmentRepository:      repositories.NewEnrollmentsDBRepository(),
+		EnrollmentSagaRepository:  repositories.NewEnrollmentSagasDBRepository(),
 		EnrollmentCreatedProducer: producers.NewEnrollmentCreatedProducer(),
+		UnitOfWork:                transactions.NewSQLUnitOfWork(),
+		SagaTimeout:               enrollmentSagaTimeout(),
 	}
 }
 
+func enrollmentSagaTimeout() time.Duration {
+	value, err := time.ParseDuration(os.Getenv("ENROLLMENT_SAGA_TIMEOUT"))
+	if err != nil || value <= 0 {
+		return defaultEnrollmentSagaTimeout
+	}
+
+	return value
+}
+
+// Execute creates the enrollment pending of financial confirmation and starts
+// its saga. The enrollment is activated or cancelled when finantial-module
+// replies to the created event, or cancelled once the saga expires.
 func (u *CreateEnrollmentUsecase) Execute(ctx context.Context, model *models.EnrollmentCreate) error {
-	model.Status = enums.ADIMPLENTE
+	model.Status = enums.PENDING_FINANCIAL
 
-	if err := u.existsEnrollmentByStudentIdAndCourseId(ctx, model); err != nil {
-		return err
-	}
+	return u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		if err := u.existsEnrollmentByStudentIdAndCourseId(ctx, model); err != nil {
+			return err
+		}
 
-	if err := u.existsCourseById(ctx, model); err != nil {
-		return err
-	}
+		if err := u.existsCourseById(ctx, model); err != nil {
+			return err
+		}
 
-	if err := u.existsStudentById(ctx, model); err != nil {
-		return err
-	}
+		if err := u.existsStudentById(ctx, model); err != nil {
+			return err
+		}
 
-	result, err := u.insertEnrollment(ctx, model)
-	if err != nil {
-		return err
-	}
+		result, err := u.insertEnrollment(ctx, model)
+		if err != nil {
+			return err
+		}
 
-	u.sendCreatedEnrollmentNotification(ctx, result)
+		if err := u.startEnrollmentSaga(ctx, model); err != nil {
+			return err
+		}
 
-	return nil
+		return u.sendCreatedEnrollmentNotification(ctx, result)
+	})
 }
 
 func (u *CreateEnrollmentUsecase) existsEnrollmentByStudentIdAndCourseId(ctx context.Context, model *models.EnrollmentCreate) error {
@@ -127,15 +154,43 @@ func (u *CreateEnrollmentUsecase) insertEnrollment(ctx context.Context, model *m
 		return nil, errors.New(exceptions.ErrOnInsertEnrollment)
 	}
 
+	// Nothing is returned when an enrollment that was not cancelled was
+	// inserted after ExistsByStudentIdAndCourseId.
+	if result == nil {
+		return nil, errors.New(exceptions.ErrEnrollmentAlreadyExists)
+	}
+
 	return result, nil
 }
 
-func (u *CreateEnrollmentUsecase) sendCreatedEnrollmentNotification(ctx context.Context, enrollmentCreated *models.EnrollmentCreated) {
+func (u *CreateEnrollmentUsecase) startEnrollmentSaga(ctx context.Context, model *models.EnrollmentCreate) error {
+	saga := &models.EnrollmentSaga{
+		StudentID: model.StudentID,
+		CourseID:  model.CourseID,
+		ExpiresAt: time.Now().Add(u.SagaTimeout),
+	}
+
+	if err := u.EnrollmentSagaRepository.Insert(ctx, saga); err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "EnrollmentSagaRepository.Insert").
+			AddParam("model", saga).
+			Msg(errAnErrorOccurredInCreateEnrollmentUsecaseMsg)
+		return errors.New(exceptions.ErrOnInsertEnrollmentSaga)
+	}
+
+	return nil
+}
+
+func (u *CreateEnrollmentUsecase) sendCreatedEnrollmentNotification(ctx context.Context, enrollmentCreated *models.EnrollmentCreated) error {
 	if err := u.EnrollmentCreatedProducer.Send(ctx, enrollmentCreated); err != nil {
 		logging.Error(ctx).
 			Err(err).
 			AddParam("step", "EnrollmentCreatedProducer.Send").
 			AddParam("model", enrollmentCreated).
 			Msg(errAnErrorOccurredInCreateEnrollmentUsecaseMsg)
+		return errors.New(exceptions.ErrOnSendEnrollmentCreated)
 	}
+
+	return nil
 }
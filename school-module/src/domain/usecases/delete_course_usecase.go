@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers"
@@ -24,27 +25,29 @@ type IDeleteCourseUsecase interface {
 type DeleteCourseUsecase struct {
 	CourseRepository      repositories.ICoursesRepository
 	CourseDeletedProducer producers.ICourseDeletedProducer
+	UnitOfWork            transactions.UnitOfWork
 }
 
 func NewDeleteCourseUsecase() *DeleteCourseUsecase {
 	return &DeleteCourseUsecase{
 		CourseRepository:      repositories.NewCoursesDBRepository(),
 		CourseDeletedProducer: producers.NewCourseDeletedProducer(),
+		UnitOfWork:            transactions.NewSQLUnitOfWork(),
 	}
 }
 
 func (u *DeleteCourseUsecase) Execute(ctx context.Context, id uuid.UUID) error {
-	if err := u.existsCourseById(ctx, id); err != nil {
-		return err
-	}
-
-	if err := u.deleteCourseById(ctx, id); err != nil {
-		return err
-	}
+	return u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		if err := u.existsCourseById(ctx, id); err != nil {
+			return err
+		}
 
-	u.sendDeletedCourseNotification(ctx, id)
+		if err := u.deleteCourseById(ctx, id); err != nil {
+			return err
+		}
 
-	return nil
+		return u.sendDeletedCourseNotification(ctx, id)
+	})
 }
 
 func (u *DeleteCourseUsecase) existsCourseById(ctx context.Context, id uuid.UUID) error {
@@ -78,12 +81,15 @@ func (u *DeleteCourseUsecase) deleteCourseById(ctx context.Context, id uuid.UUID
 	return nil
 }
 
-func (u *DeleteCourseUsecase) sendDeletedCourseNotification(ctx context.Context, id uuid.UUID) {
+func (u *DeleteCourseUsecase) sendDeletedCourseNotification(ctx context.Context, id uuid.UUID) error {
 	if err := u.CourseDeletedProducer.Send(ctx, &models.CourseDelete{ID: id}); err != nil {
 		logging.Error(ctx).
 			Err(err).
 			AddParam("step", "CourseDeletedProducer.Send").
 			AddParam("id", id).
 			Msg(errAnErrorOccurredInDeleteCourseUsecaseMsg)
+		return errors.New(exceptions.ErrOnSendCourseDeleted)
 	}
+
+	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers"
@@ -23,27 +24,29 @@ type IDeleteEnrollmentUsecase interface {
 type DeleteEnrollmentUsecase struct {
 	EnrollmentRepository      repositories.IEnrollmentsRepository
 	EnrollmentDeletedProducer producers.IEnrollmentDeletedProducer
+	UnitOfWork                transactions.UnitOfWork
 }
 
 func NewDeleteEnrollmentUsecase() *DeleteEnrollmentUsecase {
 	return &DeleteEnrollmentUsecase{
 		EnrollmentRepository:      repositories.NewEnrollmentsDBRepository(),
 		EnrollmentDeletedProducer: producers.NewEnrollmentDeletedProducer(),
+		UnitOfWork:                transactions.NewSQLUnitOfWork(),
 	}
 }
 
 func (u *DeleteEnrollmentUsecase) Execute(ctx context.Context, params *models.EnrollmentDelete) error {
-	if err := u.existsEnrollmentByStudentIdAndCourseId(ctx, params); err != nil {
-		return err
-	}
-
-	if err := u.deleteEnrollment(ctx, params); err != nil {
-		return err
-	}
+	return u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		if err := u.existsEnrollmentByStudentIdAndCourseId(ctx, params); err != nil {
+			return err
+		}
 
-	u.sendDeletedEnrollmentNotification(ctx, params)
+		if err := u.deleteEnrollment(ctx, params); err != nil {
+			return err
+		}
 
-	return nil
+		return u.sendDeletedEnrollmentNotification(ctx, params)
+	})
 }
 
 func (u *DeleteEnrollmentUsecase) existsEnrollmentByStudentIdAndCourseId(ctx context.Context, params *models.EnrollmentDelete) error {
@@ -77,12 +80,15 @@ func (u *DeleteEnrollmentUsecase) deleteEnrollment(ctx context.Context, params *
 	return nil
 }
 
-func (u *DeleteEnrollmentUsecase) sendDeletedEnrollmentNotification(ctx context.Context, params *models.EnrollmentDelete) {
+func (u *DeleteEnrollmentUsecase) sendDeletedEnrollmentNotification(ctx context.Context, params *models.EnrollmentDelete) error {
 	if err := u.EnrollmentDeletedProducer.Send(ctx, params); err != nil {
 		logging.Error(ctx).
 			Err(err).
 			AddParam("step", "EnrollmentDeletedProducer.Send").
 			AddParam("params", params).
 			Msg(errAnErrorOccurredInDeleteEnrollmentUsecaseMsg)
+		return errors.New(exceptions.ErrOnSendEnrollmentDeleted)
 	}
+
+	return nil
 }
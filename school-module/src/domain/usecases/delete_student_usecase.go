@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers"
@@ -24,27 +25,29 @@ type IDeleteStudentUsecase interface {
 type DeleteStudentUsecase struct {
 	Repository             repositories.IStudentsRepository
 	StudentDeletedProducer producers.IStudentDeletedProducer
+	UnitOfWork             transactions.UnitOfWork
 }
 
 func NewDeleteStudentUsecase() *DeleteStudentUsecase {
 	return &DeleteStudentUsecase{
 		Repository:             repositories.NewStudentsDBRepository(),
 		StudentDeletedProducer: producers.NewStudentDeletedProducer(),
+		UnitOfWork:             transactions.NewSQLUnitOfWork(),
 	}
 }
 
 func (u *DeleteStudentUsecase) Execute(ctx context.Context, id uuid.UUID) error {
-	if err := u.existsStudentById(ctx, id); err != nil {
-		return err
-	}
-
-	if err := u.deleteStudentById(ctx, id); err != nil {
-		return err
-	}
+	return u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		if err := u.existsStudentById(ctx, id); err != nil {
+			return err
+		}
 
-	u.sendDeletedStudentNotification(ctx, id)
+		if err := u.deleteStudentById(ctx, id); err != nil {
+			return err
+		}
 
-	return nil
+		return u.sendDeletedStudentNotification(ctx, id)
+	})
 }
 
 func (u *DeleteStudentUsecase) existsStudentById(ctx context.Context, id uuid.UUID) error {
@@ -78,12 +81,15 @@ func (u *DeleteStudentUsecase) deleteStudentById(ctx context.Context, id uuid.UU
 	return nil
 }
 
-func (u *DeleteStudentUsecase) sendDeletedStudentNotification(ctx context.Context, id uuid.UUID) {
+func (u *DeleteStudentUsecase) sendDeletedStudentNotification(ctx context.Context, id uuid.UUID) error {
 	if err := u.StudentDeletedProducer.Send(ctx, &models.StudentDelete{ID: id}); err != nil {
 		logging.Error(ctx).
 			Err(err).
 			AddParam("step", "StudentDeletedProducer.Send").
 			AddParam("id", id).
 			Msg(errAnErrorOccurredInDeleteStudentUsecaseMsg)
+		return errors.New(exceptions.ErrOnSendStudentDeleted)
 	}
+
+	return nil
 }
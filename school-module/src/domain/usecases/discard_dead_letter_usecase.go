@@ -0,0 +1,56 @@
+//go:generate mockgen -source discard_dead_letter_usecase.go -destination mock/discard_dead_letter_usecase_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/google/uuid"
+)
+
+const (
+	errAnErrorOccurredInDiscardDeadLetterUsecaseMsg string = "an error occurred in DiscardDeadLetterUsecase"
+)
+
+type IDiscardDeadLetterUsecase interface {
+	Execute(ctx context.Context, id uuid.UUID) error
+}
+
+type DiscardDeadLetterUsecase struct {
+	DeadLetterRepository deadletters.Repository
+}
+
+func NewDiscardDeadLetterUsecase() *DiscardDeadLetterUsecase {
+	return &DiscardDeadLetterUsecase{
+		DeadLetterRepository: deadletters.NewDBRepository(),
+	}
+}
+
+func (u *DiscardDeadLetterUsecase) Execute(ctx context.Context, id uuid.UUID) error {
+	model, err := findDeadLetterById(ctx, u.DeadLetterRepository, id, errAnErrorOccurredInDiscardDeadLetterUsecaseMsg)
+	if err != nil {
+		return err
+	}
+
+	if model.Status != deadletters.PENDING {
+		return errors.New(exceptions.ErrDeadLetterAlreadyResolved)
+	}
+
+	model.Status = deadletters.DISCARDED
+	model.ResolvedAt = types.NullDateTime{Time: time.Now(), Valid: true}
+	if err := u.DeadLetterRepository.UpdateStatus(ctx, model); err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "DeadLetterRepository.UpdateStatus").
+			AddParam("id", id).
+			Msg(errAnErrorOccurredInDiscardDeadLetterUsecaseMsg)
+		return errors.New(exceptions.ErrOnUpdateDeadLetterStatus)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+//go:generate mockgen -source expire_enrollment_sagas_usecase.go -destination mock/expire_enrollment_sagas_usecase_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+)
+
+const (
+	errAnErrorOccurredInExpireEnrollmentSagasUsecaseMsg string = "an error occurred in ExpireEnrollmentSagasUsecase"
+
+	enrollmentSagaTimeoutReason = "timeout"
+)
+
+type IExpireEnrollmentSagasUsecase interface {
+	Execute(ctx context.Context, limit int) (int, error)
+}
+
+type ExpireEnrollmentSagasUsecase struct {
+	EnrollmentSagaRepository repositories.IEnrollmentSagasRepository
+	CancelEnrollmentUsecase  ICancelEnrollmentUsecase
+	UnitOfWork               transactions.UnitOfWork
+}
+
+func NewExpireEnrollmentSagasUsecase() *ExpireEnrollmentSagasUsecase {
+	return &ExpireEnrollmentSagasUsecase{
+		EnrollmentSagaRepository: repositories.NewEnrollmentSagasDBRepository(),
+		CancelEnrollmentUsecase:  NewCancelEnrollmentUsecase(),
+		UnitOfWork:               transactions.NewSQLUnitOfWork(),
+	}
+}
+
+// Execute cancels up to limit sagas that expired without a reply from
+// finantial-module and returns how many were cancelled.
+func (u *ExpireEnrollmentSagasUsecase) Execute(ctx context.Context, limit int) (int, error) {
+	expired := 0
+	err := u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		sagas, err := u.EnrollmentSagaRepository.FindExpired(ctx, limit)
+		if err != nil {
+			logging.Error(ctx).
+				Err(err).
+				AddParam("step", "EnrollmentSagaRepository.FindExpired").
+				AddParam("limit", limit).
+				Msg(errAnErrorOccurredInExpireEnrollmentSagasUsecaseMsg)
+			return errors.New(exceptions.ErrOnFindExpiredEnrollmentSagas)
+		}
+
+		for _, saga := range sagas {
+			if err := u.CancelEnrollmentUsecase.Execute(ctx, &models.EnrollmentCancel{
+				StudentID:  saga.StudentID,
+				CourseID:   saga.CourseID,
+				Reason:     enrollmentSagaTimeoutReason,
+				SagaStatus: enums.ENROLLMENT_SAGA_TIMED_OUT,
+			}); err != nil {
+				return err
+			}
+			expired++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return expired, nil
+}
@@ -0,0 +1,51 @@
+//go:generate mockgen -source get_all_dead_letter_usecase.go -destination mock/get_all_dead_letter_usecase_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+)
+
+const (
+	errAnErrorOccurredInGetAllDeadLetterUsecaseMsg string = "an error occurred in GetAllDeadLetterUsecase"
+
+	defaultDeadLettersLimit uint16 = 50
+)
+
+type IGetAllDeadLetterUsecase interface {
+	Execute(ctx context.Context, params *models.DeadLetterParams) ([]deadletters.DeadLetter, error)
+}
+
+type GetAllDeadLetterUsecase struct {
+	DeadLetterRepository deadletters.Repository
+}
+
+func NewGetAllDeadLetterUsecase() *GetAllDeadLetterUsecase {
+	return &GetAllDeadLetterUsecase{
+		DeadLetterRepository: deadletters.NewDBRepository(),
+	}
+}
+
+func (u *GetAllDeadLetterUsecase) Execute(ctx context.Context, params *models.DeadLetterParams) ([]deadletters.DeadLetter, error) {
+	if params.Limit == 0 {
+		params.Limit = defaultDeadLettersLimit
+	}
+
+	filter := &deadletters.Filter{Queue: params.Queue, Status: params.Status, Limit: int(params.Limit)}
+	result, err := u.DeadLetterRepository.FindAll(ctx, filter)
+	if err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "DeadLetterRepository.FindAll").
+			AddParam("params", params).
+			Msg(errAnErrorOccurredInGetAllDeadLetterUsecaseMsg)
+		return nil, errors.New(exceptions.ErrOnFindAllDeadLetters)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,50 @@
+//go:generate mockgen -source get_all_enrollment_snapshot_usecase.go -destination mock/get_all_enrollment_snapshot_usecase_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+)
+
+const (
+	errAnErrorOccurredInGetAllEnrollmentSnapshotUsecaseMsg string = "an error occurred in GetAllEnrollmentSnapshotUsecase"
+
+	defaultEnrollmentSnapshotsLimit uint16 = 500
+)
+
+type IGetAllEnrollmentSnapshotUsecase interface {
+	Execute(ctx context.Context, params *models.EnrollmentSnapshotParams) ([]models.EnrollmentSnapshot, error)
+}
+
+type GetAllEnrollmentSnapshotUsecase struct {
+	EnrollmentRepository repositories.IEnrollmentsRepository
+}
+
+func NewGetAllEnrollmentSnapshotUsecase() *GetAllEnrollmentSnapshotUsecase {
+	return &GetAllEnrollmentSnapshotUsecase{
+		EnrollmentRepository: repositories.NewEnrollmentsDBRepository(),
+	}
+}
+
+func (u *GetAllEnrollmentSnapshotUsecase) Execute(ctx context.Context, params *models.EnrollmentSnapshotParams) ([]models.EnrollmentSnapshot, error) {
+	if params.Limit == 0 {
+		params.Limit = defaultEnrollmentSnapshotsLimit
+	}
+
+	result, err := u.EnrollmentRepository.FindAllSnapshots(ctx, params)
+	if err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "EnrollmentRepository.FindAllSnapshots").
+			AddParam("params", params).
+			Msg(errAnErrorOccurredInGetAllEnrollmentSnapshotUsecaseMsg)
+		return nil, errors.New(exceptions.ErrOnFindAllEnrollmentSnapshots)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,52 @@
+//go:generate mockgen -source get_dead_letter_by_id_usecase.go -destination mock/get_dead_letter_by_id_usecase_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/google/uuid"
+)
+
+const (
+	errAnErrorOccurredInGetDeadLetterByIdUsecaseMsg string = "an error occurred in GetDeadLetterByIdUsecase"
+)
+
+type IGetDeadLetterByIdUsecase interface {
+	Execute(ctx context.Context, id uuid.UUID) (*deadletters.DeadLetter, error)
+}
+
+type GetDeadLetterByIdUsecase struct {
+	DeadLetterRepository deadletters.Repository
+}
+
+func NewGetDeadLetterByIdUsecase() *GetDeadLetterByIdUsecase {
+	return &GetDeadLetterByIdUsecase{
+		DeadLetterRepository: deadletters.NewDBRepository(),
+	}
+}
+
+func (u *GetDeadLetterByIdUsecase) Execute(ctx context.Context, id uuid.UUID) (*deadletters.DeadLetter, error) {
+	return findDeadLetterById(ctx, u.DeadLetterRepository, id, errAnErrorOccurredInGetDeadLetterByIdUsecaseMsg)
+}
+
+func findDeadLetterById(ctx context.Context, repository deadletters.Repository, id uuid.UUID, errMsg string) (*deadletters.DeadLetter, error) {
+	result, err := repository.FindByID(ctx, id)
+	if err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "DeadLetterRepository.FindByID").
+			AddParam("id", id).
+			Msg(errMsg)
+		return nil, errors.New(exceptions.ErrOnFindDeadLetterById)
+	}
+
+	if result == nil {
+		return nil, errors.New(exceptions.ErrDeadLetterNotFound)
+	}
+
+	return result, nil
+}
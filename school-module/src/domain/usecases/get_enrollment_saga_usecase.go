@@ -0,0 +1,48 @@
+//go:generate mockgen -source get_enrollment_saga_usecase.go -destination mock/get_enrollment_saga_usecase_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+)
+
+const (
+	errAnErrorOccurredInGetEnrollmentSagaUsecaseMsg string = "an error occurred in GetEnrollmentSagaUsecase"
+)
+
+type IGetEnrollmentSagaUsecase interface {
+	Execute(ctx context.Context, params *models.EnrollmentSagaParams) (*models.EnrollmentSaga, error)
+}
+
+type GetEnrollmentSagaUsecase struct {
+	EnrollmentSagaRepository repositories.IEnrollmentSagasRepository
+}
+
+func NewGetEnrollmentSagaUsecase() *GetEnrollmentSagaUsecase {
+	return &GetEnrollmentSagaUsecase{
+		EnrollmentSagaRepository: repositories.NewEnrollmentSagasDBRepository(),
+	}
+}
+
+func (u *GetEnrollmentSagaUsecase) Execute(ctx context.Context, params *models.EnrollmentSagaParams) (*models.EnrollmentSaga, error) {
+	result, err := u.EnrollmentSagaRepository.FindByStudentIdAndCourseId(ctx, params.StudentID, params.CourseID)
+	if err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "EnrollmentSagaRepository.FindByStudentIdAndCourseId").
+			AddParam("params", params).
+			Msg(errAnErrorOccurredInGetEnrollmentSagaUsecaseMsg)
+		return nil, errors.New(exceptions.ErrOnFindEnrollmentSaga)
+	}
+
+	if result == nil {
+		return nil, errors.New(exceptions.ErrEnrollmentSagaNotFound)
+	}
+
+	return result, nil
+}
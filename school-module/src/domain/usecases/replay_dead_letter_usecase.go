@@ -0,0 +1,104 @@
+//go:generate mockgen -source replay_dead_letter_usecase.go -destination mock/replay_dead_letter_usecase_mock.go -package usecasesmock
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/types"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/google/uuid"
+)
+
+const (
+	errAnErrorOccurredInReplayDeadLetterUsecaseMsg string = "an error occurred in ReplayDeadLetterUsecase"
+)
+
+type IReplayDeadLetterUsecase interface {
+	Execute(ctx context.Context, id uuid.UUID) (*deadletters.DeadLetter, error)
+}
+
+// ReplayDeadLetterUsecase consumes a dead-lettered message once more, with
+// the consumer of the queue it came from. When it fails again the dead
+// letter stays pending with the new error.
+type ReplayDeadLetterUsecase struct {
+	DeadLetterRepository deadletters.Repository
+	Consumers            map[string]messaging.QueueConsumer
+}
+
+func NewReplayDeadLetterUsecase(consumers ...messaging.QueueConsumer) *ReplayDeadLetterUsecase {
+	byQueue := make(map[string]messaging.QueueConsumer, len(consumers))
+	for _, consumer := range consumers {
+		byQueue[consumer.QueueName()] = consumer
+	}
+
+	return &ReplayDeadLetterUsecase{
+		DeadLetterRepository: deadletters.NewDBRepository(),
+		Consumers:            byQueue,
+	}
+}
+
+func (u *ReplayDeadLetterUsecase) Execute(ctx context.Context, id uuid.UUID) (*deadletters.DeadLetter, error) {
+	model, err := findDeadLetterById(ctx, u.DeadLetterRepository, id, errAnErrorOccurredInReplayDeadLetterUsecaseMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	if model.Status != deadletters.PENDING {
+		return nil, errors.New(exceptions.ErrDeadLetterAlreadyResolved)
+	}
+
+	consumer, ok := u.Consumers[model.Queue]
+	if !ok {
+		return nil, errors.New(exceptions.ErrDeadLetterQueueNotFound)
+	}
+
+	model.Attempts++
+	if err := consumer.Consume(replayContext(ctx, model), model.ToProviderMessage()); err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "Consumer.Consume").
+			AddParam("id", id).
+			AddParam("queue", model.Queue).
+			Msg(errAnErrorOccurredInReplayDeadLetterUsecaseMsg)
+
+		model.Error = err.Error()
+		if err := u.updateStatus(ctx, model); err != nil {
+			return nil, err
+		}
+		return nil, errors.New(exceptions.ErrDeadLetterReplayFailed)
+	}
+
+	model.Status = deadletters.REPLAYED
+	model.ResolvedAt = types.NullDateTime{Time: time.Now(), Valid: true}
+	if err := u.updateStatus(ctx, model); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+func (u *ReplayDeadLetterUsecase) updateStatus(ctx context.Context, model *deadletters.DeadLetter) error {
+	if err := u.DeadLetterRepository.UpdateStatus(ctx, model); err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "DeadLetterRepository.UpdateStatus").
+			AddParam("id", model.ID).
+			Msg(errAnErrorOccurredInReplayDeadLetterUsecaseMsg)
+		return errors.New(exceptions.ErrOnUpdateDeadLetterStatus)
+	}
+
+	return nil
+}
+
+func replayContext(ctx context.Context, model *deadletters.DeadLetter) context.Context {
+	if model.CorrelationID == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, logging.CorrelationIDParam, model.CorrelationID)
+}
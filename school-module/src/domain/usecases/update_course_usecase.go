@@ -5,8 +5,10 @@ import (
 	"context"
 	"errors"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/transactions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
 )
@@ -20,25 +22,35 @@ type IUpdateCourseUsecase interface {
 }
 
 type UpdateCourseUsecase struct {
-	CourseRepository repositories.ICoursesRepository
+	CourseRepository      repositories.ICoursesRepository
+	CourseUpdatedProducer producers.ICourseUpdatedProducer
+	UnitOfWork            transactions.UnitOfWork
 }
 
 func NewUpdateCourseUsecase() *UpdateCourseUsecase {
 	return &UpdateCourseUsecase{
-		CourseRepository: repositories.NewCoursesDBRepository(),
+		CourseRepository:      repositories.NewCoursesDBRepository(),
+		CourseUpdatedProducer: producers.NewCourseUpdatedProducer(),
+		UnitOfWork:            transactions.NewSQLUnitOfWork(),
 	}
 }
 
 func (u *UpdateCourseUsecase) Execute(ctx context.Context, model *models.CourseUpdate) error {
-	if err := u.existsCourseById(ctx, model); err != nil {
-		return err
-	}
+	return u.UnitOfWork.Execute(ctx, func(ctx context.Context) error {
+		if err := u.existsCourseById(ctx, model); err != nil {
+			return err
+		}
 
-	if err := u.findCourseByName(ctx, model); err != nil {
-		return err
-	}
+		if err := u.findCourseByName(ctx, model); err != nil {
+			return err
+		}
+
+		if err := u.UpdateCourse(ctx, model); err != nil {
+			return err
+		}
 
-	return u.UpdateCourse(ctx, model)
+		return u.sendUpdatedCourseNotification(ctx, model)
+	})
 }
 
 func (u *UpdateCourseUsecase) existsCourseById(ctx context.Context, model *models.CourseUpdate) error {
@@ -89,3 +101,16 @@ func (u *UpdateCourseUsecase) UpdateCourse(ctx context.Context, model *models.Co
 
 	return nil
 }
+
+func (u *UpdateCourseUsecase) sendUpdatedCourseNotification(ctx context.Context, model *models.CourseUpdate) error {
+	if err := u.CourseUpdatedProducer.Send(ctx, model); err != nil {
+		logging.Error(ctx).
+			Err(err).
+			AddParam("step", "CourseUpdatedProducer.Send").
+			AddParam("model", model).
+			Msg(errAnErrorOccurredInUpdateCourseUsecaseMsg)
+		return errors.New(exceptions.ErrOnSendCourseUpdated)
+	}
+
+	return nil
+}
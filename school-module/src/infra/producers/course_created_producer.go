@@ -3,9 +3,12 @@ package producers
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
-	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
 )
 
 type ICourseCreatedProducer interface {
@@ -13,13 +16,18 @@ type ICourseCreatedProducer interface {
 }
 
 type CourseCreatedProducer struct {
-	producer *messaging.Producer
+	producer *outbox.Producer
 }
 
 func NewCourseCreatedProducer() ICourseCreatedProducer {
-	return &CourseCreatedProducer{messaging.NewProducer("SCHOOL_COURSE")}
+	return &CourseCreatedProducer{newOutboxProducer("SCHOOL_COURSE")}
 }
 
 func (p *CourseCreatedProducer) Send(ctx context.Context, model *models.Course) error {
-	return p.producer.Publish(ctx, "CREATE_COURSE", model)
+	return p.producer.Publish(ctx, contracts.CourseCreatedV1{
+		ID:        model.ID,
+		Name:      model.Name,
+		Value:     json.Number(strconv.FormatFloat(model.Value, 'f', 2, 64)),
+		CreatedAt: model.CreatedAt,
+	})
 }
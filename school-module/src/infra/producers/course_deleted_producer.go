@@ -4,8 +4,9 @@ package producers
 import (
 	"context"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
-	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
 )
 
 type ICourseDeletedProducer interface {
@@ -13,13 +14,13 @@ type ICourseDeletedProducer interface {
 }
 
 type CourseDeletedProducer struct {
-	producer *messaging.Producer
+	producer *outbox.Producer
 }
 
 func NewCourseDeletedProducer() *CourseDeletedProducer {
-	return &CourseDeletedProducer{messaging.NewProducer("SCHOOL_COURSE")}
+	return &CourseDeletedProducer{newOutboxProducer("SCHOOL_COURSE")}
 }
 
 func (p *CourseDeletedProducer) Send(ctx context.Context, model *models.CourseDelete) error {
-	return p.producer.Publish(ctx, "DELETE_COURSE", model)
+	return p.producer.Publish(ctx, contracts.CourseDeletedV1{ID: model.ID})
 }
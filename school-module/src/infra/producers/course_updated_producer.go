@@ -0,0 +1,34 @@
+//go:generate mockgen -source course_updated_producer.go -destination mock/course_updated_producer_mock.go -package producersmock
+package producers
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+)
+
+type ICourseUpdatedProducer interface {
+	Send(ctx context.Context, model *models.CourseUpdate) error
+}
+
+type CourseUpdatedProducer struct {
+	producer *outbox.Producer
+}
+
+func NewCourseUpdatedProducer() *CourseUpdatedProducer {
+	return &CourseUpdatedProducer{newOutboxProducer("SCHOOL_COURSE")}
+}
+
+func (p *CourseUpdatedProducer) Send(ctx context.Context, model *models.CourseUpdate) error {
+	return p.producer.Publish(ctx, contracts.CourseUpdatedV1{
+		ID:        model.ID,
+		Name:      model.Name,
+		Value:     json.Number(strconv.FormatFloat(model.Value, 'f', 2, 64)),
+		UpdatedAt: time.Now().UTC(),
+	})
+}
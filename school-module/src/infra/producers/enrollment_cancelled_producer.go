@@ -0,0 +1,30 @@
+//go:generate mockgen -source enrollment_cancelled_producer.go -destination mock/enrollment_cancelled_producer_mock.go -package producersmock
+package producers
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+)
+
+type IEnrollmentCancelledProducer interface {
+	Send(ctx context.Context, model *models.EnrollmentCancel) error
+}
+
+type EnrollmentCancelledProducer struct {
+	producer *outbox.Producer
+}
+
+func NewEnrollmentCancelledProducer() *EnrollmentCancelledProducer {
+	return &EnrollmentCancelledProducer{newOutboxProducer("SCHOOL_ENROLLMENT")}
+}
+
+func (p *EnrollmentCancelledProducer) Send(ctx context.Context, model *models.EnrollmentCancel) error {
+	return p.producer.Publish(ctx, contracts.EnrollmentCancelledV1{
+		StudentID: model.StudentID,
+		CourseID:  model.CourseID,
+		Reason:    model.Reason,
+	})
+}
@@ -3,10 +3,13 @@ package producers
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/logging"
-	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
 )
 
 type IEnrollmentCreatedProducer interface {
@@ -14,14 +17,23 @@ type IEnrollmentCreatedProducer interface {
 }
 
 type EnrollmentCreatedProducer struct {
-	producer *messaging.Producer
+	producer *outbox.Producer
 }
 
 func NewEnrollmentCreatedProducer() *EnrollmentCreatedProducer {
-	return &EnrollmentCreatedProducer{messaging.NewProducer("SCHOOL_ENROLLMENT")}
+	return &EnrollmentCreatedProducer{newOutboxProducer("SCHOOL_ENROLLMENT")}
 }
 
 func (p *EnrollmentCreatedProducer) Send(ctx context.Context, model *models.EnrollmentCreated) error {
 	logging.Info(ctx).Msg("Sending enrollment created message")
-	return p.producer.Publish(ctx, "CREATE_ENROLLMENT", model)
+	return p.producer.Publish(ctx, contracts.EnrollmentCreatedV2{
+		Student: contracts.EnrollmentStudentV1{ID: model.Student.ID},
+		Course: contracts.EnrollmentCourseV2{
+			ID:    model.Course.ID,
+			Value: json.Number(strconv.FormatFloat(model.Course.Value, 'f', 2, 64)),
+		},
+		Installments: model.Installments,
+		Status:       model.Status.String(),
+		CreatedAt:    model.CreatedAt,
+	})
 }
@@ -4,8 +4,9 @@ package producers
 import (
 	"context"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
-	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
 )
 
 type IEnrollmentDeletedProducer interface {
@@ -13,13 +14,16 @@ type IEnrollmentDeletedProducer interface {
 }
 
 type EnrollmentDeletedProducer struct {
-	producer *messaging.Producer
+	producer *outbox.Producer
 }
 
 func NewEnrollmentDeletedProducer() *EnrollmentDeletedProducer {
-	return &EnrollmentDeletedProducer{messaging.NewProducer("SCHOOL_ENROLLMENT")}
+	return &EnrollmentDeletedProducer{newOutboxProducer("SCHOOL_ENROLLMENT")}
 }
 
 func (p *EnrollmentDeletedProducer) Send(ctx context.Context, model *models.EnrollmentDelete) error {
-	return p.producer.Publish(ctx, "DELETE_ENROLLMENT", model)
+	return p.producer.Publish(ctx, contracts.EnrollmentDeletedV1{
+		StudentID: model.StudentID,
+		CourseID:  model.CourseID,
+	})
 }
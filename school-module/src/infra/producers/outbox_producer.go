@@ -0,0 +1,13 @@
+//go:generate go run github.com/colibriproject-dev/colibri-sdk-go-examples/contracts/cmd/schemagen -out ../../../contracts/schemas dev.colibri.school.course.created.v1 dev.colibri.school.course.updated.v1 dev.colibri.school.course.deleted.v1 dev.colibri.school.student.deleted.v1 dev.colibri.school.enrollment.created.v2 dev.colibri.school.enrollment.deleted.v1 dev.colibri.school.enrollment.cancelled.v1
+package producers
+
+import (
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
+)
+
+// newOutboxProducer stores the events of the module in the outbox, to be
+// published to the topic by the relay.
+func newOutboxProducer(topic string) *outbox.Producer {
+	return outbox.NewProducer(contracts.SchoolSource, topic)
+}
@@ -4,8 +4,9 @@ package producers
 import (
 	"context"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
-	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
 )
 
 type IStudentDeletedProducer interface {
@@ -13,13 +14,13 @@ type IStudentDeletedProducer interface {
 }
 
 type StudentDeletedProducer struct {
-	producer *messaging.Producer
+	producer *outbox.Producer
 }
 
 func NewStudentDeletedProducer() *StudentDeletedProducer {
-	return &StudentDeletedProducer{messaging.NewProducer("SCHOOL_STUDENT")}
+	return &StudentDeletedProducer{newOutboxProducer("SCHOOL_STUDENT")}
 }
 
 func (p *StudentDeletedProducer) Send(ctx context.Context, model *models.StudentDelete) error {
-	return p.producer.Publish(ctx, "DELETE_STUDENT", model)
+	return p.producer.Publish(ctx, contracts.StudentDeletedV1{ID: model.ID})
 }
@@ -0,0 +1,86 @@
+//go:generate mockgen -source enrollment_sagas_repository.go -destination mock/enrollment_sagas_repository_mock.go -package repositoriesmock
+package repositories
+
+import (
+	"context"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
+	"github.com/google/uuid"
+)
+
+const (
+	enrollmentSagaBaseQuery = `
+		SELECT id, student_id, course_id, status, account_id, reason, expires_at, created_at, updated_at, finished_at
+		FROM enrollment_sagas`
+
+	findEnrollmentSagaByStudentIdAndCourseIdQuery = enrollmentSagaBaseQuery + `
+		WHERE student_id = $1
+		AND course_id = $2
+		ORDER BY created_at DESC
+		LIMIT 1`
+
+	findEnrollmentSagaByStudentIdAndCourseIdForUpdateQuery = findEnrollmentSagaByStudentIdAndCourseIdQuery + `
+		FOR UPDATE`
+
+	findExpiredEnrollmentSagasQuery = enrollmentSagaBaseQuery + `
+		WHERE status = 'STARTED'
+		AND expires_at <= NOW()
+		ORDER BY expires_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`
+
+	insertEnrollmentSagaQuery = `
+		INSERT INTO enrollment_sagas (student_id, course_id, expires_at)
+		VALUES ($1, $2, $3)`
+
+	finishEnrollmentSagaQuery = `
+		UPDATE enrollment_sagas
+		SET status = $2, account_id = $3, reason = $4, finished_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+		AND status = 'STARTED'
+		RETURNING TRUE`
+)
+
+type IEnrollmentSagasRepository interface {
+	// FindByStudentIdAndCourseId returns the latest saga of the enrollment,
+	// which is started again when the student re-enrolls.
+	FindByStudentIdAndCourseId(ctx context.Context, studentID, courseID uuid.UUID) (*models.EnrollmentSaga, error)
+	// FindByStudentIdAndCourseIdForUpdate is FindByStudentIdAndCourseId
+	// locking the saga until the surrounding transaction ends, so replies from
+	// finantial-module and the expiration job never finish it twice.
+	FindByStudentIdAndCourseIdForUpdate(ctx context.Context, studentID, courseID uuid.UUID) (*models.EnrollmentSaga, error)
+	// FindExpired locks the returned sagas until the surrounding transaction
+	// ends, so concurrent jobs never compensate the same saga.
+	FindExpired(ctx context.Context, limit int) ([]models.EnrollmentSaga, error)
+	Insert(ctx context.Context, model *models.EnrollmentSaga) error
+	// Finish reports whether the saga was still running.
+	Finish(ctx context.Context, model *models.EnrollmentSaga) (bool, error)
+}
+
+type EnrollmentSagasDBRepository struct{}
+
+func NewEnrollmentSagasDBRepository() *EnrollmentSagasDBRepository {
+	return &EnrollmentSagasDBRepository{}
+}
+
+func (r *EnrollmentSagasDBRepository) FindByStudentIdAndCourseId(ctx context.Context, studentID, courseID uuid.UUID) (*models.EnrollmentSaga, error) {
+	return sqlDB.NewQuery[models.EnrollmentSaga](ctx, findEnrollmentSagaByStudentIdAndCourseIdQuery, studentID, courseID).One()
+}
+
+func (r *EnrollmentSagasDBRepository) FindByStudentIdAndCourseIdForUpdate(ctx context.Context, studentID, courseID uuid.UUID) (*models.EnrollmentSaga, error) {
+	return sqlDB.NewQuery[models.EnrollmentSaga](ctx, findEnrollmentSagaByStudentIdAndCourseIdForUpdateQuery, studentID, courseID).One()
+}
+
+func (r *EnrollmentSagasDBRepository) FindExpired(ctx context.Context, limit int) ([]models.EnrollmentSaga, error) {
+	return sqlDB.NewQuery[models.EnrollmentSaga](ctx, findExpiredEnrollmentSagasQuery, limit).Many()
+}
+
+func (r *EnrollmentSagasDBRepository) Insert(ctx context.Context, model *models.EnrollmentSaga) error {
+	return sqlDB.NewStatement(ctx, insertEnrollmentSagaQuery, model.StudentID, model.CourseID, model.ExpiresAt).Execute()
+}
+
+func (r *EnrollmentSagasDBRepository) Finish(ctx context.Context, model *models.EnrollmentSaga) (bool, error) {
+	finished, err := sqlDB.NewQuery[bool](ctx, finishEnrollmentSagaQuery, model.ID, model.Status, model.AccountID, model.Reason).One()
+	return finished != nil, err
+}
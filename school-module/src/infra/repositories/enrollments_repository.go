@@ -32,13 +32,29 @@ const (
 	existsEnrollmentByStudentIdAndCourseIdQuery = `
 		SELECT EXISTS (
 			SELECT 1 FROM enrollments e
-			WHERE e.student_id = $1 AND e.course_id = $2
+			WHERE e.student_id = $1 AND e.course_id = $2 AND e.status <> 'CANCELADO'
 		)`
 
 	insertEnrollmentQuery = `
-		INSERT INTO enrollments(student_id, course_id, installments, status)
-		VALUES ($1, $2, $3, $4)
-		RETURNING student_id, course_id, installments, status, created_at`
+		WITH e AS (
+			INSERT INTO enrollments(student_id, course_id, installments, status)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (student_id, course_id) DO UPDATE
+			SET installments = EXCLUDED.installments, status = EXCLUDED.status, created_at = NOW()
+			WHERE enrollments.status = 'CANCELADO'
+			RETURNING student_id, course_id, installments, status, created_at
+		)
+		SELECT e.student_id, e.course_id, c.value, e.installments, e.status, e.created_at
+		FROM e
+		JOIN courses c ON e.course_id = c.id`
+
+	findAllEnrollmentSnapshotsQuery = `
+		SELECT e.student_id, e.course_id, c.value, e.installments, e.status, e.created_at
+		FROM enrollments e
+		JOIN courses c ON e.course_id = c.id
+		WHERE (e.student_id, e.course_id) > ($1, $2)
+		ORDER BY e.student_id, e.course_id
+		LIMIT $3`
 
 	deleteEnrollmentQuery       = `DELETE FROM enrollments WHERE student_id = $1 AND course_id = $2`
 	updateEnrollmentStatusQuery = `UPDATE enrollments SET status = $3 WHERE student_id = $1 AND course_id = $2`
@@ -47,7 +63,12 @@ const (
 type IEnrollmentsRepository interface {
 	FindAllPaginated(ctx context.Context, params *models.EnrollmentPageParams) (models.EnrollmentPage, error)
 	FindByStudentIdAndCourseId(ctx context.Context, studentID, courseID uuid.UUID) (*models.Enrollment, error)
+	FindAllSnapshots(ctx context.Context, params *models.EnrollmentSnapshotParams) ([]models.EnrollmentSnapshot, error)
+	// ExistsByStudentIdAndCourseId tells whether the student has an enrollment
+	// in the course that was not cancelled.
 	ExistsByStudentIdAndCourseId(ctx context.Context, studentID, courseID uuid.UUID) (*bool, error)
+	// Insert creates the enrollment, reusing the one of a student who
+	// re-enrolls in a course after it was cancelled.
 	Insert(ctx context.Context, model *models.EnrollmentCreate) (*models.EnrollmentCreated, error)
 	Delete(ctx context.Context, studentID, courseID uuid.UUID) error
 	UpdateStatus(ctx context.Context, model *models.EnrollmentUpdateStatus) error
@@ -73,6 +94,15 @@ func (r *EnrollmentsDBRepository) FindByStudentIdAndCourseId(ctx context.Context
 	return sqlDB.NewQuery[models.Enrollment](ctx, findEnrollmentByStudentIdAndCourseIdQuery, studentID, courseID).One()
 }
 
+func (r *EnrollmentsDBRepository) FindAllSnapshots(ctx context.Context, params *models.EnrollmentSnapshotParams) ([]models.EnrollmentSnapshot, error) {
+	return sqlDB.NewQuery[models.EnrollmentSnapshot](ctx,
+		findAllEnrollmentSnapshotsQuery,
+		params.AfterStudentID,
+		params.AfterCourseID,
+		params.Limit,
+	).Many()
+}
+
 func (r *EnrollmentsDBRepository) ExistsByStudentIdAndCourseId(ctx context.Context, studentID, courseID uuid.UUID) (*bool, error) {
 	return sqlDB.NewQuery[bool](ctx, existsEnrollmentByStudentIdAndCourseIdQuery, studentID, courseID).One()
 }
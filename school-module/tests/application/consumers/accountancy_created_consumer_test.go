@@ -0,0 +1,197 @@
+package consumers
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	eventconsumers "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/consumers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/application/consumers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	usecasesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFinantialInstallmentConsumer(t *testing.T) {
+	t.Run("Should return new accountancy created consumer", func(t *testing.T) {
+		result := consumers.NewFinantialInstallmentConsumer()
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.QueueName())
+	})
+}
+
+func TestAccountancyCreatedConsumer(t *testing.T) {
+	data := contracts.AccountStatusUpdatedV1{
+		ID:           uuid.New(),
+		StudentID:    uuid.New(),
+		CourseID:     uuid.New(),
+		Installments: uint8(rand.Intn(12) + 1),
+		Value:        "1500.00",
+		Status:       enums.INADIMPLENTE.String(),
+		CreatedAt:    time.Now(),
+	}
+	providerMessageMock := newEventMessage(t, contracts.FinantialSource, data)
+
+	controller := gomock.NewController(t)
+	mockUpdateEnrollmentStatusUsecase := usecasesmock.NewMockIUpdateEnrollmentStatusUsecase(controller)
+	consumer := consumers.NewFinantialInstallmentConsumer()
+	consumer.UpdateEnrollmentStatusUsecase = mockUpdateEnrollmentStatusUsecase
+	defer controller.Finish()
+
+	t.Run("Should return error when occurred error in DecodeMessage", func(t *testing.T) {
+		err := consumer.Consume(ctx, &messaging.ProviderMessage{Action: contracts.AccountStatusUpdatedType, Message: ""})
+		assert.Error(t, err)
+	})
+
+	t.Run("Should return permanent error when event data is invalid", func(t *testing.T) {
+		mockUpdateEnrollmentStatusUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).MaxTimes(0)
+		invalid := data
+		invalid.Status = "PENDENTE"
+
+		err := consumer.Consume(ctx, newEventMessage(t, contracts.FinantialSource, invalid))
+		assert.True(t, eventconsumers.IsPermanent(err))
+	})
+
+	t.Run("Should return ErrUnknownMessageAction when action has no handler", func(t *testing.T) {
+		mockUpdateEnrollmentStatusUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := consumer.Consume(ctx, &messaging.ProviderMessage{Action: "UNKNOWN", Message: providerMessageMock.Message})
+		assert.EqualError(t, err, eventconsumers.ErrUnknownMessageAction)
+	})
+
+	t.Run("Should return error when occurred error in UpdateStatus", func(t *testing.T) {
+		expected := errors.New("mock error in UpdateStatus")
+		mockUpdateEnrollmentStatusUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(expected)
+
+		err := consumer.Consume(ctx, providerMessageMock)
+		assert.Error(t, expected, err)
+	})
+
+	t.Run("Should consume message and update enrollment status", func(t *testing.T) {
+		mockUpdateEnrollmentStatusUsecase.EXPECT().Execute(gomock.Any(), &models.EnrollmentUpdateStatus{
+			StudentID: data.StudentID,
+			CourseID:  data.CourseID,
+			Status:    enums.INADIMPLENTE,
+		}).Return(nil)
+
+		err := consumer.Consume(ctx, providerMessageMock)
+		assert.NoError(t, err)
+	})
+}
+
+func TestFinantialInstallmentConsumer_AccountCreated(t *testing.T) {
+	data := contracts.AccountCreatedV1{
+		ID:           uuid.New(),
+		StudentID:    uuid.New(),
+		CourseID:     uuid.New(),
+		Installments: uint8(rand.Intn(12) + 1),
+		Value:        "1500.00",
+		CreatedAt:    time.Now(),
+	}
+	providerMessageMock := newEventMessage(t, contracts.FinantialSource, data)
+
+	controller := gomock.NewController(t)
+	mockConfirmEnrollmentUsecase := usecasesmock.NewMockIConfirmEnrollmentUsecase(controller)
+	consumer := consumers.NewFinantialInstallmentConsumer()
+	consumer.ConfirmEnrollmentUsecase = mockConfirmEnrollmentUsecase
+	defer controller.Finish()
+
+	t.Run("Should return error when occurred error in Confirm", func(t *testing.T) {
+		expected := errors.New("mock error in Confirm")
+		mockConfirmEnrollmentUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(expected)
+
+		err := consumer.Consume(ctx, providerMessageMock)
+		assert.ErrorIs(t, err, expected)
+	})
+
+	t.Run("Should consume message and confirm enrollment", func(t *testing.T) {
+		mockConfirmEnrollmentUsecase.EXPECT().Execute(gomock.Any(), &models.EnrollmentConfirm{
+			StudentID: data.StudentID,
+			CourseID:  data.CourseID,
+			AccountID: data.ID,
+		}).Return(nil)
+
+		err := consumer.Consume(ctx, providerMessageMock)
+		assert.NoError(t, err)
+	})
+}
+
+func TestFinantialInstallmentConsumer_AccountRejected(t *testing.T) {
+	data := contracts.AccountRejectedV1{
+		StudentID: uuid.New(),
+		CourseID:  uuid.New(),
+		Reason:    "errCoursePriceNotFound",
+	}
+	providerMessageMock := newEventMessage(t, contracts.FinantialSource, data)
+
+	controller := gomock.NewController(t)
+	mockCancelEnrollmentUsecase := usecasesmock.NewMockICancelEnrollmentUsecase(controller)
+	consumer := consumers.NewFinantialInstallmentConsumer()
+	consumer.CancelEnrollmentUsecase = mockCancelEnrollmentUsecase
+	defer controller.Finish()
+
+	t.Run("Should return error when occurred error in Cancel", func(t *testing.T) {
+		expected := errors.New("mock error in Cancel")
+		mockCancelEnrollmentUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(expected)
+
+		err := consumer.Consume(ctx, providerMessageMock)
+		assert.ErrorIs(t, err, expected)
+	})
+
+	t.Run("Should consume message and cancel enrollment as rejected", func(t *testing.T) {
+		mockCancelEnrollmentUsecase.EXPECT().Execute(gomock.Any(), &models.EnrollmentCancel{
+			StudentID:  data.StudentID,
+			CourseID:   data.CourseID,
+			Reason:     data.Reason,
+			SagaStatus: enums.ENROLLMENT_SAGA_REJECTED,
+		}).Return(nil)
+
+		err := consumer.Consume(ctx, providerMessageMock)
+		assert.NoError(t, err)
+	})
+}
+
+func TestFinantialInstallmentConsumer_AccountRenegotiated(t *testing.T) {
+	data := contracts.AccountRenegotiatedV1{
+		ID:           uuid.New(),
+		StudentID:    uuid.New(),
+		CourseID:     uuid.New(),
+		AgreementID:  uuid.New(),
+		Value:        "1234.56",
+		Installments: uint8(rand.Intn(12) + 1),
+		Status:       enums.ADIMPLENTE.String(),
+		CreatedAt:    time.Now(),
+	}
+	providerMessageMock := newEventMessage(t, contracts.FinantialSource, data)
+
+	controller := gomock.NewController(t)
+	mockUpdateEnrollmentStatusUsecase := usecasesmock.NewMockIUpdateEnrollmentStatusUsecase(controller)
+	consumer := consumers.NewFinantialInstallmentConsumer()
+	consumer.UpdateEnrollmentStatusUsecase = mockUpdateEnrollmentStatusUsecase
+	defer controller.Finish()
+
+	t.Run("Should return error when occurred error in UpdateStatus", func(t *testing.T) {
+		expected := errors.New("mock error in UpdateStatus")
+		mockUpdateEnrollmentStatusUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(expected)
+
+		err := consumer.Consume(ctx, providerMessageMock)
+		assert.ErrorIs(t, err, expected)
+	})
+
+	t.Run("Should consume message and update enrollment status", func(t *testing.T) {
+		mockUpdateEnrollmentStatusUsecase.EXPECT().Execute(gomock.Any(), &models.EnrollmentUpdateStatus{
+			StudentID: data.StudentID,
+			CourseID:  data.CourseID,
+			Status:    enums.ADIMPLENTE,
+		}).Return(nil)
+
+		err := consumer.Consume(ctx, providerMessageMock)
+		assert.NoError(t, err)
+	})
+}
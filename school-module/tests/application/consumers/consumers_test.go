@@ -0,0 +1,35 @@
+package consumers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/test"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/validator"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/google/uuid"
+)
+
+var (
+	ctx = context.Background()
+)
+
+// newEventMessage wraps data in an event as the outbox relay publishes it.
+func newEventMessage(t *testing.T, source string, data contracts.Data) *messaging.ProviderMessage {
+	event, err := contracts.NewEvent(source, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &messaging.ProviderMessage{ID: uuid.New(), Action: event.Type, Message: event}
+}
+
+func TestMain(m *testing.M) {
+	test.InitializeBaseTest()
+
+	validator.RegisterCustomValidation("oneOfEnrollmentStatus", enums.EnrollmentStatusValidator)
+
+	m.Run()
+}
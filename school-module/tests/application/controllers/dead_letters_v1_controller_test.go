@@ -0,0 +1,183 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/application/controllers"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	usecasesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/web/restserver"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadLettersV1Controller(t *testing.T) {
+	t.Run("Should return new dead letters v1 controller", func(t *testing.T) {
+		result := controllers.NewDeadLettersV1Controller()
+
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.GetAllDeadLetterUsecase)
+		assert.NotNil(t, result.GetDeadLetterByIdUsecase)
+		assert.NotNil(t, result.ReplayDeadLetterUsecase)
+		assert.NotNil(t, result.DiscardDeadLetterUsecase)
+		assert.NotNil(t, result.Routes())
+	})
+}
+
+func TestDeadLettersV1Controller_GetAllDeadLetter(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockGetAllDeadLetterUsecase := usecasesmock.NewMockIGetAllDeadLetterUsecase(controller)
+	restController := controllers.DeadLettersV1Controller{GetAllDeadLetterUsecase: mockGetAllDeadLetterUsecase}
+	defer controller.Finish()
+
+	const path string = "/public/v1/dead-letters"
+
+	t.Run("Should return StatusBadRequest when limit is above maximum", func(t *testing.T) {
+		response := restserver.NewRequestTest(&restserver.RequestTest{
+			Method: http.MethodGet,
+			Path:   path,
+			Url:    path + "?limit=501",
+		}, restController.GetAllDeadLetter)
+
+		assert.EqualValues(t, http.StatusBadRequest, response.StatusCode())
+	})
+
+	t.Run("Should return StatusInternalServerError when error returned in GetAllDeadLetterUsecase", func(t *testing.T) {
+		mockErr := errors.New(exceptions.ErrOnFindAllDeadLetters)
+		mockGetAllDeadLetterUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, mockErr)
+
+		response := restserver.NewRequestTest(&restserver.RequestTest{
+			Method: http.MethodGet,
+			Path:   path,
+			Url:    path,
+		}, restController.GetAllDeadLetter)
+
+		var result restserver.Error
+		assert.EqualValues(t, http.StatusInternalServerError, response.StatusCode())
+		assert.NoError(t, response.DecodeBody(&result))
+		assert.EqualValues(t, mockErr.Error(), result.Error)
+	})
+
+	t.Run("Should return dead letters filtered by queue and status", func(t *testing.T) {
+		expected := []deadletters.DeadLetter{{ID: uuid.New(), Queue: "FINANCIAL_INSTALLMENT_SCHOOL", Status: deadletters.PENDING}}
+		mockGetAllDeadLetterUsecase.EXPECT().
+			Execute(gomock.Any(), &models.DeadLetterParams{Queue: "FINANCIAL_INSTALLMENT_SCHOOL", Status: deadletters.PENDING, Limit: 10}).
+			Return(expected, nil)
+
+		response := restserver.NewRequestTest(&restserver.RequestTest{
+			Method: http.MethodGet,
+			Path:   path,
+			Url:    path + "?queue=FINANCIAL_INSTALLMENT_SCHOOL&status=PENDING&limit=10",
+		}, restController.GetAllDeadLetter)
+
+		var result []deadletters.DeadLetter
+		assert.EqualValues(t, http.StatusOK, response.StatusCode())
+		assert.NoError(t, response.DecodeBody(&result))
+		assert.Len(t, result, 1)
+		assert.Equal(t, expected[0].ID, result[0].ID)
+	})
+}
+
+func TestDeadLettersV1Controller_ReplayDeadLetter(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockReplayDeadLetterUsecase := usecasesmock.NewMockIReplayDeadLetterUsecase(controller)
+	restController := controllers.DeadLettersV1Controller{ReplayDeadLetterUsecase: mockReplayDeadLetterUsecase}
+	defer controller.Finish()
+
+	const path string = "/public/v1/dead-letters/{id}/replay"
+	const id string = "8f9fa978-7df0-4474-b1d4-6be55e0dbd0d"
+	const url string = "/public/v1/dead-letters/" + id + "/replay"
+
+	t.Run("Should return StatusBadRequest when i try replay invalid id path param", func(t *testing.T) {
+		response := restserver.NewRequestTest(&restserver.RequestTest{
+			Method: http.MethodPost,
+			Path:   path,
+			Url:    "/public/v1/dead-letters/abc/replay",
+		}, restController.ReplayDeadLetter)
+
+		assert.EqualValues(t, http.StatusBadRequest, response.StatusCode())
+	})
+
+	for _, tc := range []struct {
+		err    string
+		status int
+	}{
+		{exceptions.ErrDeadLetterNotFound, http.StatusNotFound},
+		{exceptions.ErrDeadLetterAlreadyResolved, http.StatusConflict},
+		{exceptions.ErrDeadLetterQueueNotFound, http.StatusUnprocessableEntity},
+		{exceptions.ErrDeadLetterReplayFailed, http.StatusUnprocessableEntity},
+		{exceptions.ErrOnFindDeadLetterById, http.StatusInternalServerError},
+	} {
+		t.Run("Should return "+http.StatusText(tc.status)+" when "+tc.err+" returned in ReplayDeadLetterUsecase", func(t *testing.T) {
+			mockReplayDeadLetterUsecase.EXPECT().Execute(gomock.Any(), uuid.MustParse(id)).Return(nil, errors.New(tc.err))
+
+			response := restserver.NewRequestTest(&restserver.RequestTest{
+				Method: http.MethodPost,
+				Path:   path,
+				Url:    url,
+			}, restController.ReplayDeadLetter)
+
+			var result restserver.Error
+			assert.EqualValues(t, tc.status, response.StatusCode())
+			assert.NoError(t, response.DecodeBody(&result))
+			assert.EqualValues(t, tc.err, result.Error)
+		})
+	}
+
+	t.Run("Should replay dead letter and return StatusOK", func(t *testing.T) {
+		expected := &deadletters.DeadLetter{ID: uuid.MustParse(id), Status: deadletters.REPLAYED}
+		mockReplayDeadLetterUsecase.EXPECT().Execute(gomock.Any(), uuid.MustParse(id)).Return(expected, nil)
+
+		response := restserver.NewRequestTest(&restserver.RequestTest{
+			Method: http.MethodPost,
+			Path:   path,
+			Url:    url,
+		}, restController.ReplayDeadLetter)
+
+		var result deadletters.DeadLetter
+		assert.EqualValues(t, http.StatusOK, response.StatusCode())
+		assert.NoError(t, response.DecodeBody(&result))
+		assert.Equal(t, deadletters.REPLAYED, result.Status)
+	})
+}
+
+func TestDeadLettersV1Controller_DiscardDeadLetter(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockDiscardDeadLetterUsecase := usecasesmock.NewMockIDiscardDeadLetterUsecase(controller)
+	restController := controllers.DeadLettersV1Controller{DiscardDeadLetterUsecase: mockDiscardDeadLetterUsecase}
+	defer controller.Finish()
+
+	const path string = "/public/v1/dead-letters/{id}"
+	const id string = "8f9fa978-7df0-4474-b1d4-6be55e0dbd0d"
+	const url string = "/public/v1/dead-letters/" + id
+
+	t.Run("Should return StatusConflict when ErrDeadLetterAlreadyResolved returned in DiscardDeadLetterUsecase", func(t *testing.T) {
+		mockErr := errors.New(exceptions.ErrDeadLetterAlreadyResolved)
+		mockDiscardDeadLetterUsecase.EXPECT().Execute(gomock.Any(), uuid.MustParse(id)).Return(mockErr)
+
+		response := restserver.NewRequestTest(&restserver.RequestTest{
+			Method: http.MethodDelete,
+			Path:   path,
+			Url:    url,
+		}, restController.DiscardDeadLetter)
+
+		assert.EqualValues(t, http.StatusConflict, response.StatusCode())
+	})
+
+	t.Run("Should discard dead letter and return StatusNoContent", func(t *testing.T) {
+		mockDiscardDeadLetterUsecase.EXPECT().Execute(gomock.Any(), uuid.MustParse(id)).Return(nil)
+
+		response := restserver.NewRequestTest(&restserver.RequestTest{
+			Method: http.MethodDelete,
+			Path:   path,
+			Url:    url,
+		}, restController.DiscardDeadLetter)
+
+		assert.EqualValues(t, http.StatusNoContent, response.StatusCode())
+	})
+}
@@ -16,8 +16,10 @@ func TestEnrollmentStatusValidator(t *testing.T) {
 		input    string
 		expected bool
 	}{
+		{string(enums.PENDING_FINANCIAL), true},
 		{string(enums.ADIMPLENTE), true},
 		{string(enums.INADIMPLENTE), true},
+		{string(enums.CANCELADO), true},
 		{"INVALID_VALUE", false},
 	}
 
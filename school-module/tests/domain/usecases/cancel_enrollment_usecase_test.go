@@ -0,0 +1,177 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	producersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers/mock"
+	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancelEnrollmentUsecase(t *testing.T) {
+	t.Run("Should return new cancel enrollment usecase", func(t *testing.T) {
+		result := usecases.NewCancelEnrollmentUsecase()
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.EnrollmentRepository)
+		assert.NotNil(t, result.EnrollmentSagaRepository)
+		assert.NotNil(t, result.EnrollmentCancelledProducer)
+		assert.NotNil(t, result.UnitOfWork)
+	})
+}
+
+func TestCancelEnrollmentUsecase_Execute(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockEnrollmentRepository := repositoriesmock.NewMockIEnrollmentsRepository(controller)
+	mockEnrollmentSagaRepository := repositoriesmock.NewMockIEnrollmentSagasRepository(controller)
+	mockEnrollmentCancelledProducer := producersmock.NewMockIEnrollmentCancelledProducer(controller)
+	usecase := usecases.CancelEnrollmentUsecase{
+		EnrollmentRepository:        mockEnrollmentRepository,
+		EnrollmentSagaRepository:    mockEnrollmentSagaRepository,
+		EnrollmentCancelledProducer: mockEnrollmentCancelledProducer,
+		UnitOfWork:                  transaction.NewMockTransaction(),
+	}
+	defer controller.Finish()
+
+	model := &models.EnrollmentCancel{
+		StudentID:  uuid.New(),
+		CourseID:   uuid.New(),
+		Reason:     "timeout",
+		SagaStatus: enums.ENROLLMENT_SAGA_TIMED_OUT,
+	}
+
+	newSaga := func(status enums.EnrollmentSagaStatus) *models.EnrollmentSaga {
+		return &models.EnrollmentSaga{
+			ID:        uuid.New(),
+			StudentID: model.StudentID,
+			CourseID:  model.CourseID,
+			Status:    status,
+		}
+	}
+
+	updateStatus := &models.EnrollmentUpdateStatus{
+		StudentID: model.StudentID,
+		CourseID:  model.CourseID,
+		Status:    enums.CANCELADO,
+	}
+
+	t.Run("Should return ErrOnFindEnrollmentSaga when occurred error in FindByStudentIdAndCourseIdForUpdate", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnFindEnrollmentSaga)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(nil, errors.New("mock error in FindByStudentIdAndCourseIdForUpdate"))
+		mockEnrollmentRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Finish(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should ignore enrollment without saga", func(t *testing.T) {
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(nil, nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Finish(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should ignore saga already finished", func(t *testing.T) {
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(newSaga(enums.ENROLLMENT_SAGA_COMPLETED), nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Finish(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should return ErrOnUpdateEnrollmentStatus when occurred error in UpdateStatus", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnUpdateEnrollmentStatus)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(newSaga(enums.ENROLLMENT_SAGA_STARTED), nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(ctx, updateStatus).Return(errors.New("mock error in UpdateStatus"))
+		mockEnrollmentSagaRepository.EXPECT().Finish(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should return ErrOnFinishEnrollmentSaga when occurred error in Finish", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnFinishEnrollmentSaga)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(newSaga(enums.ENROLLMENT_SAGA_STARTED), nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(ctx, updateStatus).Return(nil)
+		mockEnrollmentSagaRepository.EXPECT().Finish(ctx, gomock.Any()).Return(false, errors.New("mock error in Finish"))
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should return ErrEnrollmentSagaAlreadyFinished when saga was finished since it was read", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrEnrollmentSagaAlreadyFinished)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(newSaga(enums.ENROLLMENT_SAGA_STARTED), nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(ctx, updateStatus).Return(nil)
+		mockEnrollmentSagaRepository.EXPECT().Finish(ctx, gomock.Any()).Return(false, nil)
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should return ErrOnSendEnrollmentCancelled when occurred error in Send", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnSendEnrollmentCancelled)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(newSaga(enums.ENROLLMENT_SAGA_STARTED), nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(ctx, updateStatus).Return(nil)
+		mockEnrollmentSagaRepository.EXPECT().Finish(ctx, gomock.Any()).Return(true, nil)
+		mockEnrollmentCancelledProducer.EXPECT().Send(ctx, model).Return(errors.New("mock error in Send"))
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should cancel enrollment, finish its saga and send enrollment cancelled when saga timed out", func(t *testing.T) {
+		saga := newSaga(enums.ENROLLMENT_SAGA_STARTED)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(saga, nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(ctx, updateStatus).Return(nil)
+		mockEnrollmentSagaRepository.EXPECT().Finish(ctx, saga).Return(true, nil)
+		mockEnrollmentCancelledProducer.EXPECT().Send(ctx, model).Return(nil)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.ENROLLMENT_SAGA_TIMED_OUT, saga.Status)
+		assert.Equal(t, model.Reason, saga.Reason)
+	})
+
+	t.Run("Should cancel enrollment without sending enrollment cancelled when account was rejected", func(t *testing.T) {
+		rejected := *model
+		rejected.Reason = "errCoursePriceNotFound"
+		rejected.SagaStatus = enums.ENROLLMENT_SAGA_REJECTED
+		saga := newSaga(enums.ENROLLMENT_SAGA_STARTED)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(saga, nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(ctx, updateStatus).Return(nil)
+		mockEnrollmentSagaRepository.EXPECT().Finish(ctx, saga).Return(true, nil)
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, &rejected)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.ENROLLMENT_SAGA_REJECTED, saga.Status)
+		assert.Equal(t, rejected.Reason, saga.Reason)
+	})
+}
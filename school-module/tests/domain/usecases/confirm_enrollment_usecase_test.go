@@ -0,0 +1,176 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	producersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers/mock"
+	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfirmEnrollmentUsecase(t *testing.T) {
+	t.Run("Should return new confirm enrollment usecase", func(t *testing.T) {
+		result := usecases.NewConfirmEnrollmentUsecase()
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.EnrollmentRepository)
+		assert.NotNil(t, result.EnrollmentSagaRepository)
+		assert.NotNil(t, result.EnrollmentCancelledProducer)
+		assert.NotNil(t, result.UnitOfWork)
+	})
+}
+
+func TestConfirmEnrollmentUsecase_Execute(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockEnrollmentRepository := repositoriesmock.NewMockIEnrollmentsRepository(controller)
+	mockEnrollmentSagaRepository := repositoriesmock.NewMockIEnrollmentSagasRepository(controller)
+	mockEnrollmentCancelledProducer := producersmock.NewMockIEnrollmentCancelledProducer(controller)
+	usecase := usecases.ConfirmEnrollmentUsecase{
+		EnrollmentRepository:        mockEnrollmentRepository,
+		EnrollmentSagaRepository:    mockEnrollmentSagaRepository,
+		EnrollmentCancelledProducer: mockEnrollmentCancelledProducer,
+		UnitOfWork:                  transaction.NewMockTransaction(),
+	}
+	defer controller.Finish()
+
+	model := &models.EnrollmentConfirm{
+		StudentID: uuid.New(),
+		CourseID:  uuid.New(),
+		AccountID: uuid.New(),
+	}
+
+	newSaga := func(status enums.EnrollmentSagaStatus, reason string) *models.EnrollmentSaga {
+		return &models.EnrollmentSaga{
+			ID:        uuid.New(),
+			StudentID: model.StudentID,
+			CourseID:  model.CourseID,
+			Status:    status,
+			Reason:    reason,
+		}
+	}
+
+	updateStatus := &models.EnrollmentUpdateStatus{
+		StudentID: model.StudentID,
+		CourseID:  model.CourseID,
+		Status:    enums.ADIMPLENTE,
+	}
+
+	t.Run("Should return ErrOnFindEnrollmentSaga when occurred error in FindByStudentIdAndCourseIdForUpdate", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnFindEnrollmentSaga)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(nil, errors.New("mock error in FindByStudentIdAndCourseIdForUpdate"))
+		mockEnrollmentRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Finish(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should ignore enrollment without saga", func(t *testing.T) {
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(nil, nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Finish(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should ignore saga already completed", func(t *testing.T) {
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(newSaga(enums.ENROLLMENT_SAGA_COMPLETED, ""), nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Finish(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should return ErrOnUpdateEnrollmentStatus when occurred error in UpdateStatus", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnUpdateEnrollmentStatus)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(newSaga(enums.ENROLLMENT_SAGA_STARTED, ""), nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(ctx, updateStatus).Return(errors.New("mock error in UpdateStatus"))
+		mockEnrollmentSagaRepository.EXPECT().Finish(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should return ErrOnFinishEnrollmentSaga when occurred error in Finish", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnFinishEnrollmentSaga)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(newSaga(enums.ENROLLMENT_SAGA_STARTED, ""), nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(ctx, updateStatus).Return(nil)
+		mockEnrollmentSagaRepository.EXPECT().Finish(ctx, gomock.Any()).Return(false, errors.New("mock error in Finish"))
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should return ErrEnrollmentSagaAlreadyFinished when saga was finished since it was read", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrEnrollmentSagaAlreadyFinished)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(newSaga(enums.ENROLLMENT_SAGA_STARTED, ""), nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(ctx, updateStatus).Return(nil)
+		mockEnrollmentSagaRepository.EXPECT().Finish(ctx, gomock.Any()).Return(false, nil)
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should activate enrollment and complete its saga", func(t *testing.T) {
+		saga := newSaga(enums.ENROLLMENT_SAGA_STARTED, "")
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(saga, nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(ctx, updateStatus).Return(nil)
+		mockEnrollmentSagaRepository.EXPECT().Finish(ctx, saga).Return(true, nil)
+		mockEnrollmentCancelledProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.NoError(t, err)
+		assert.Equal(t, enums.ENROLLMENT_SAGA_COMPLETED, saga.Status)
+		assert.Equal(t, uuid.NullUUID{UUID: model.AccountID, Valid: true}, saga.AccountID)
+	})
+
+	t.Run("Should return ErrOnSendEnrollmentCancelled when occurred error in Send", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnSendEnrollmentCancelled)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(newSaga(enums.ENROLLMENT_SAGA_TIMED_OUT, "timeout"), nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Finish(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentCancelledProducer.EXPECT().Send(ctx, gomock.Any()).Return(errors.New("mock error in Send"))
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should send enrollment cancelled again when saga already timed out", func(t *testing.T) {
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseIdForUpdate(ctx, model.StudentID, model.CourseID).Return(newSaga(enums.ENROLLMENT_SAGA_TIMED_OUT, "timeout"), nil)
+		mockEnrollmentRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Finish(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentCancelledProducer.EXPECT().Send(ctx, &models.EnrollmentCancel{
+			StudentID:  model.StudentID,
+			CourseID:   model.CourseID,
+			Reason:     "timeout",
+			SagaStatus: enums.ENROLLMENT_SAGA_TIMED_OUT,
+		}).Return(nil)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.NoError(t, err)
+	})
+}
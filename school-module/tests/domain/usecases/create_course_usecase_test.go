@@ -8,7 +8,9 @@ import (
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	producersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers/mock"
 	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -19,13 +21,16 @@ func TestCreateCourseUsecase(t *testing.T) {
 		result := usecases.NewCreateCourseUsecase()
 		assert.NotNil(t, result)
 		assert.NotNil(t, result.CourseRepository)
+		assert.NotNil(t, result.CourseCreatedProducer)
+		assert.NotNil(t, result.UnitOfWork)
 	})
 }
 
 func TestCreateCourseUsecase_Execute(t *testing.T) {
 	controller := gomock.NewController(t)
 	mockCoursesRepository := repositoriesmock.NewMockICoursesRepository(controller)
-	usecase := usecases.CreateCourseUsecase{CourseRepository: mockCoursesRepository}
+	mockCourseCreatedProducer := producersmock.NewMockICourseCreatedProducer(controller)
+	usecase := usecases.CreateCourseUsecase{CourseRepository: mockCoursesRepository, CourseCreatedProducer: mockCourseCreatedProducer, UnitOfWork: transaction.NewMockTransaction()}
 	defer controller.Finish()
 
 	model := &models.CourseCreate{
@@ -37,6 +42,7 @@ func TestCreateCourseUsecase_Execute(t *testing.T) {
 		expected := errors.New(exceptions.ErrOnExistsCourseByName)
 		mockCoursesRepository.EXPECT().ExistsByName(ctx, model.Name).Return(nil, errors.New("mock error in ExistsByName")).MaxTimes(1)
 		mockCoursesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockCourseCreatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		result, err := usecase.Execute(ctx, model)
 
@@ -48,6 +54,7 @@ func TestCreateCourseUsecase_Execute(t *testing.T) {
 		expected := errors.New(exceptions.ErrCourseAlreadyExists)
 		mockCoursesRepository.EXPECT().ExistsByName(ctx, model.Name).Return(&exists, nil).MaxTimes(1)
 		mockCoursesRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockCourseCreatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		result, err := usecase.Execute(ctx, model)
 
@@ -59,6 +66,20 @@ func TestCreateCourseUsecase_Execute(t *testing.T) {
 		expected := errors.New(exceptions.ErrOnInsertCourse)
 		mockCoursesRepository.EXPECT().ExistsByName(ctx, model.Name).Return(&notExists, nil).MaxTimes(1)
 		mockCoursesRepository.EXPECT().Insert(ctx, model).Return(nil, errors.New("mock error in Insert"))
+		mockCourseCreatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		result, err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should return ErrOnSendCourseCreated when occurred error in Send", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnSendCourseCreated)
+		course := &models.Course{ID: uuid.New(), Name: model.Name, Value: model.Value, CreatedAt: time.Now()}
+		mockCoursesRepository.EXPECT().ExistsByName(ctx, model.Name).Return(&notExists, nil).MaxTimes(1)
+		mockCoursesRepository.EXPECT().Insert(ctx, model).Return(course, nil)
+		mockCourseCreatedProducer.EXPECT().Send(ctx, course).Return(errors.New("mock error in Send"))
 
 		result, err := usecase.Execute(ctx, model)
 
@@ -75,6 +96,7 @@ func TestCreateCourseUsecase_Execute(t *testing.T) {
 		}
 		mockCoursesRepository.EXPECT().ExistsByName(ctx, model.Name).Return(&notExists, nil).MaxTimes(1)
 		mockCoursesRepository.EXPECT().Insert(ctx, model).Return(expected, nil)
+		mockCourseCreatedProducer.EXPECT().Send(ctx, expected).Return(nil)
 
 		result, err := usecase.Execute(ctx, model)
 
@@ -3,6 +3,7 @@ package usecases
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
@@ -10,6 +11,7 @@ import (
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
 	producersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers/mock"
 	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -20,7 +22,10 @@ func TestCreateEnrollmentUsecase(t *testing.T) {
 		result := usecases.NewCreateEnrollmentUsecase()
 		assert.NotNil(t, result)
 		assert.NotNil(t, result.EnrollmentRepository)
+		assert.NotNil(t, result.EnrollmentSagaRepository)
 		assert.NotNil(t, result.EnrollmentCreatedProducer)
+		assert.NotNil(t, result.UnitOfWork)
+		assert.Equal(t, 15*time.Minute, result.SagaTimeout)
 	})
 }
 
@@ -29,12 +34,16 @@ func TestCreateEnrollmentUsecase_Execute(t *testing.T) {
 	mockEnrollmentRepository := repositoriesmock.NewMockIEnrollmentsRepository(controller)
 	mockCourseRepository := repositoriesmock.NewMockICoursesRepository(controller)
 	mockStudentRepository := repositoriesmock.NewMockIStudentsRepository(controller)
+	mockEnrollmentSagaRepository := repositoriesmock.NewMockIEnrollmentSagasRepository(controller)
 	mockEnrollmentCreatedProducer := producersmock.NewMockIEnrollmentCreatedProducer(controller)
 	usecase := usecases.CreateEnrollmentUsecase{
 		EnrollmentRepository:      mockEnrollmentRepository,
 		CourseRepository:          mockCourseRepository,
 		StudentRepository:         mockStudentRepository,
+		EnrollmentSagaRepository:  mockEnrollmentSagaRepository,
 		EnrollmentCreatedProducer: mockEnrollmentCreatedProducer,
+		UnitOfWork:                transaction.NewMockTransaction(),
+		SagaTimeout:               time.Minute,
 	}
 	defer controller.Finish()
 
@@ -42,7 +51,7 @@ func TestCreateEnrollmentUsecase_Execute(t *testing.T) {
 		StudentID:    uuid.New(),
 		CourseID:     uuid.New(),
 		Installments: 1,
-		Status:       enums.ADIMPLENTE,
+		Status:       enums.PENDING_FINANCIAL,
 	}
 
 	enrollmentCreated := &models.EnrollmentCreated{
@@ -58,6 +67,7 @@ func TestCreateEnrollmentUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockStudentRepository.EXPECT().ExistsById(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockEnrollmentRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockEnrollmentCreatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		err := usecase.Execute(ctx, model)
@@ -71,6 +81,7 @@ func TestCreateEnrollmentUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockStudentRepository.EXPECT().ExistsById(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockEnrollmentRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockEnrollmentCreatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		err := usecase.Execute(ctx, model)
@@ -84,6 +95,7 @@ func TestCreateEnrollmentUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.CourseID).Return(nil, errors.New("mock error in ExistsById")).MaxTimes(1)
 		mockStudentRepository.EXPECT().ExistsById(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockEnrollmentRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockEnrollmentCreatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		err := usecase.Execute(ctx, model)
@@ -97,6 +109,7 @@ func TestCreateEnrollmentUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.CourseID).Return(&notExists, nil).MaxTimes(1)
 		mockStudentRepository.EXPECT().ExistsById(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockEnrollmentRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockEnrollmentCreatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		err := usecase.Execute(ctx, model)
@@ -110,6 +123,7 @@ func TestCreateEnrollmentUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.CourseID).Return(&exists, nil).MaxTimes(1)
 		mockStudentRepository.EXPECT().ExistsById(ctx, model.StudentID).Return(nil, errors.New("mock error in ExistsById")).MaxTimes(1)
 		mockEnrollmentRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockEnrollmentCreatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		err := usecase.Execute(ctx, model)
@@ -123,6 +137,7 @@ func TestCreateEnrollmentUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.CourseID).Return(&exists, nil).MaxTimes(1)
 		mockStudentRepository.EXPECT().ExistsById(ctx, model.StudentID).Return(&notExists, nil).MaxTimes(1)
 		mockEnrollmentRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentSagaRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockEnrollmentCreatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		err := usecase.Execute(ctx, model)
@@ -136,6 +151,7 @@ func TestCreateEnrollmentUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.CourseID).Return(&exists, nil).MaxTimes(1)
 		mockStudentRepository.EXPECT().ExistsById(ctx, model.StudentID).Return(&exists, nil).MaxTimes(1)
 		mockEnrollmentRepository.EXPECT().Insert(ctx, model).Return(nil, errors.New("mock error in Insert")).MaxTimes(1)
+		mockEnrollmentSagaRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockEnrollmentCreatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		err := usecase.Execute(ctx, model)
@@ -143,15 +159,66 @@ func TestCreateEnrollmentUsecase_Execute(t *testing.T) {
 		assert.EqualError(t, expected, err.Error())
 	})
 
-	t.Run("Should create enrollment and send enrollment created notification", func(t *testing.T) {
+	t.Run("Should return ErrEnrollmentAlreadyExists when returns nil in Insert", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrEnrollmentAlreadyExists)
+		mockEnrollmentRepository.EXPECT().ExistsByStudentIdAndCourseId(ctx, model.StudentID, model.CourseID).Return(&notExists, nil).MaxTimes(1)
+		mockCourseRepository.EXPECT().ExistsById(ctx, model.CourseID).Return(&exists, nil).MaxTimes(1)
+		mockStudentRepository.EXPECT().ExistsById(ctx, model.StudentID).Return(&exists, nil).MaxTimes(1)
+		mockEnrollmentRepository.EXPECT().Insert(ctx, model).Return(nil, nil).MaxTimes(1)
+		mockEnrollmentSagaRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockEnrollmentCreatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should return ErrOnInsertEnrollmentSaga when occurred error in saga Insert", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnInsertEnrollmentSaga)
+		mockEnrollmentRepository.EXPECT().ExistsByStudentIdAndCourseId(ctx, model.StudentID, model.CourseID).Return(&notExists, nil).MaxTimes(1)
+		mockCourseRepository.EXPECT().ExistsById(ctx, model.CourseID).Return(&exists, nil).MaxTimes(1)
+		mockStudentRepository.EXPECT().ExistsById(ctx, model.StudentID).Return(&exists, nil).MaxTimes(1)
+		mockEnrollmentRepository.EXPECT().Insert(ctx, model).Return(enrollmentCreated, nil).MaxTimes(1)
+		mockEnrollmentSagaRepository.EXPECT().Insert(ctx, gomock.Any()).Return(errors.New("mock error in Insert")).MaxTimes(1)
+		mockEnrollmentCreatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should return ErrOnSendEnrollmentCreated when occurred error in Send", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnSendEnrollmentCreated)
+		mockEnrollmentRepository.EXPECT().ExistsByStudentIdAndCourseId(ctx, model.StudentID, model.CourseID).Return(&notExists, nil).MaxTimes(1)
+		mockCourseRepository.EXPECT().ExistsById(ctx, model.CourseID).Return(&exists, nil).MaxTimes(1)
+		mockStudentRepository.EXPECT().ExistsById(ctx, model.StudentID).Return(&exists, nil).MaxTimes(1)
+		mockEnrollmentRepository.EXPECT().Insert(ctx, model).Return(enrollmentCreated, nil).MaxTimes(1)
+		mockEnrollmentSagaRepository.EXPECT().Insert(ctx, gomock.Any()).Return(nil).MaxTimes(1)
+		mockEnrollmentCreatedProducer.EXPECT().Send(ctx, enrollmentCreated).Return(errors.New("mock error in Send")).MaxTimes(1)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should create pending enrollment, start its saga and send enrollment created notification", func(t *testing.T) {
+		var saga *models.EnrollmentSaga
 		mockEnrollmentRepository.EXPECT().ExistsByStudentIdAndCourseId(ctx, model.StudentID, model.CourseID).Return(&notExists, nil).MaxTimes(1)
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.CourseID).Return(&exists, nil).MaxTimes(1)
 		mockStudentRepository.EXPECT().ExistsById(ctx, model.StudentID).Return(&exists, nil).MaxTimes(1)
 		mockEnrollmentRepository.EXPECT().Insert(ctx, model).Return(enrollmentCreated, nil).MaxTimes(1)
+		mockEnrollmentSagaRepository.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(func(_ any, model *models.EnrollmentSaga) error {
+			saga = model
+			return nil
+		}).MaxTimes(1)
 		mockEnrollmentCreatedProducer.EXPECT().Send(ctx, enrollmentCreated).Return(nil).MaxTimes(1)
 
 		err := usecase.Execute(ctx, model)
 
 		assert.NoError(t, err)
+		assert.Equal(t, enums.PENDING_FINANCIAL, model.Status)
+		assert.Equal(t, model.StudentID, saga.StudentID)
+		assert.Equal(t, model.CourseID, saga.CourseID)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), saga.ExpiresAt, 5*time.Second)
 	})
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
 	producersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers/mock"
 	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -20,6 +21,7 @@ func TestDeleteCourseUsecase(t *testing.T) {
 		assert.NotNil(t, result)
 		assert.NotNil(t, result.CourseRepository)
 		assert.NotNil(t, result.CourseDeletedProducer)
+		assert.NotNil(t, result.UnitOfWork)
 	})
 }
 
@@ -27,7 +29,7 @@ func TestDeleteCourseUsecase_Execute(t *testing.T) {
 	controller := gomock.NewController(t)
 	mockCourseRepository := repositoriesmock.NewMockICoursesRepository(controller)
 	mockCourseDeletedProducer := producersmock.NewMockICourseDeletedProducer(controller)
-	usecase := usecases.DeleteCourseUsecase{CourseRepository: mockCourseRepository, CourseDeletedProducer: mockCourseDeletedProducer}
+	usecase := usecases.DeleteCourseUsecase{CourseRepository: mockCourseRepository, CourseDeletedProducer: mockCourseDeletedProducer, UnitOfWork: transaction.NewMockTransaction()}
 	defer controller.Finish()
 
 	id := uuid.New()
@@ -76,6 +78,17 @@ func TestDeleteCourseUsecase_Execute(t *testing.T) {
 		assert.EqualError(t, expected, err.Error())
 	})
 
+	t.Run("Should return ErrOnSendCourseDeleted when occurred error in Send", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnSendCourseDeleted)
+		mockCourseRepository.EXPECT().ExistsById(ctx, id).Return(&exists, nil).MaxTimes(1)
+		mockCourseRepository.EXPECT().Delete(ctx, id).Return(nil).MaxTimes(1)
+		mockCourseDeletedProducer.EXPECT().Send(ctx, &models.CourseDelete{ID: id}).Return(errors.New("mock error in Send")).MaxTimes(1)
+
+		err := usecase.Execute(ctx, id)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
 	t.Run("Should delete course and send deleted course notification", func(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, id).Return(&exists, nil).MaxTimes(1)
 		mockCourseRepository.EXPECT().Delete(ctx, id).Return(nil).MaxTimes(1)
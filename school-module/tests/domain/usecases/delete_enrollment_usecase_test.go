@@ -9,6 +9,7 @@ import (
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
 	producersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers/mock"
 	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -20,6 +21,7 @@ func TestDeleteEnrollmentUsecase(t *testing.T) {
 		assert.NotNil(t, result)
 		assert.NotNil(t, result.EnrollmentRepository)
 		assert.NotNil(t, result.EnrollmentDeletedProducer)
+		assert.NotNil(t, result.UnitOfWork)
 	})
 }
 
@@ -30,6 +32,7 @@ func TestDeleteEnrollmentUsecase_Execute(t *testing.T) {
 	usecase := usecases.DeleteEnrollmentUsecase{
 		EnrollmentRepository:      mockEnrollmentRepository,
 		EnrollmentDeletedProducer: mockEnrollmentDeletedProducer,
+		UnitOfWork:                transaction.NewMockTransaction(),
 	}
 	defer controller.Finish()
 
@@ -71,6 +74,17 @@ func TestDeleteEnrollmentUsecase_Execute(t *testing.T) {
 		assert.EqualError(t, expected, err.Error())
 	})
 
+	t.Run("Should return ErrOnSendEnrollmentDeleted when occurred error in Send", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnSendEnrollmentDeleted)
+		mockEnrollmentRepository.EXPECT().ExistsByStudentIdAndCourseId(ctx, params.StudentID, params.CourseID).Return(&exists, nil).MaxTimes(1)
+		mockEnrollmentRepository.EXPECT().Delete(ctx, params.StudentID, params.CourseID).Return(nil)
+		mockEnrollmentDeletedProducer.EXPECT().Send(ctx, params).Return(errors.New("mock error in Send"))
+
+		err := usecase.Execute(ctx, params)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
 	t.Run("Should delete enrollment and send notification successfully", func(t *testing.T) {
 		mockEnrollmentRepository.EXPECT().ExistsByStudentIdAndCourseId(ctx, params.StudentID, params.CourseID).Return(&exists, nil).MaxTimes(1)
 		mockEnrollmentRepository.EXPECT().Delete(ctx, params.StudentID, params.CourseID).Return(nil)
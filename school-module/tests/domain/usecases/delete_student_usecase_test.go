@@ -9,6 +9,7 @@ import (
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
 	producersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers/mock"
 	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -20,6 +21,7 @@ func TestDeleteStudentUsecase(t *testing.T) {
 		assert.NotNil(t, result)
 		assert.NotNil(t, result.Repository)
 		assert.NotNil(t, result.StudentDeletedProducer)
+		assert.NotNil(t, result.UnitOfWork)
 	})
 }
 
@@ -30,6 +32,7 @@ func TestDeleteStudentUsecase_Execute(t *testing.T) {
 	usecase := usecases.DeleteStudentUsecase{
 		Repository:             mockStudentRepository,
 		StudentDeletedProducer: mockStudentDeletedProducer,
+		UnitOfWork:             transaction.NewMockTransaction(),
 	}
 	defer controller.Finish()
 
@@ -68,6 +71,17 @@ func TestDeleteStudentUsecase_Execute(t *testing.T) {
 		assert.EqualError(t, expected, err.Error())
 	})
 
+	t.Run("Should return ErrOnSendStudentDeleted when occurred error in Send", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnSendStudentDeleted)
+		mockStudentRepository.EXPECT().ExistsById(ctx, id).Return(&exists, nil).MaxTimes(1)
+		mockStudentRepository.EXPECT().Delete(ctx, id).Return(nil)
+		mockStudentDeletedProducer.EXPECT().Send(ctx, &models.StudentDelete{ID: id}).Return(errors.New("mock error in Send"))
+
+		err := usecase.Execute(ctx, id)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
 	t.Run("Should delete student and send notification successfully", func(t *testing.T) {
 		mockStudentRepository.EXPECT().ExistsById(ctx, id).Return(&exists, nil).MaxTimes(1)
 		mockStudentRepository.EXPECT().Delete(ctx, id).Return(nil)
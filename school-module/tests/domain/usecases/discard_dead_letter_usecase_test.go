@@ -0,0 +1,71 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	deadlettersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscardDeadLetterUsecase(t *testing.T) {
+	t.Run("Should return new discard dead letter usecase", func(t *testing.T) {
+		result := usecases.NewDiscardDeadLetterUsecase()
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.DeadLetterRepository)
+	})
+}
+
+func TestDiscardDeadLetterUsecase_Execute(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockDeadLettersRepository := deadlettersmock.NewMockRepository(controller)
+	usecase := usecases.DiscardDeadLetterUsecase{DeadLetterRepository: mockDeadLettersRepository}
+	defer controller.Finish()
+
+	id := uuid.New()
+
+	t.Run("Should return ErrOnFindDeadLetterById when occurred error in FindByID", func(t *testing.T) {
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(nil, errors.New("mock error in FindByID"))
+
+		err := usecase.Execute(ctx, id)
+
+		assert.EqualError(t, err, exceptions.ErrOnFindDeadLetterById)
+	})
+
+	t.Run("Should return ErrDeadLetterAlreadyResolved when dead letter is not pending", func(t *testing.T) {
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(&deadletters.DeadLetter{ID: id, Status: deadletters.REPLAYED}, nil)
+
+		err := usecase.Execute(ctx, id)
+
+		assert.EqualError(t, err, exceptions.ErrDeadLetterAlreadyResolved)
+	})
+
+	t.Run("Should return ErrOnUpdateDeadLetterStatus when occurred error in UpdateStatus", func(t *testing.T) {
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(&deadletters.DeadLetter{ID: id, Status: deadletters.PENDING}, nil)
+		mockDeadLettersRepository.EXPECT().UpdateStatus(ctx, gomock.Any()).Return(errors.New("mock error in UpdateStatus"))
+
+		err := usecase.Execute(ctx, id)
+
+		assert.EqualError(t, err, exceptions.ErrOnUpdateDeadLetterStatus)
+	})
+
+	t.Run("Should mark dead letter as discarded", func(t *testing.T) {
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(&deadletters.DeadLetter{ID: id, Status: deadletters.PENDING}, nil)
+		mockDeadLettersRepository.EXPECT().UpdateStatus(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, model *deadletters.DeadLetter) error {
+				assert.Equal(t, deadletters.DISCARDED, model.Status)
+				assert.True(t, model.ResolvedAt.Valid)
+				return nil
+			})
+
+		err := usecase.Execute(ctx, id)
+
+		assert.NoError(t, err)
+	})
+}
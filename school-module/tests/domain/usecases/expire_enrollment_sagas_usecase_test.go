@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	usecasesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases/mock"
+	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpireEnrollmentSagasUsecase(t *testing.T) {
+	t.Run("Should return new expire enrollment sagas usecase", func(t *testing.T) {
+		result := usecases.NewExpireEnrollmentSagasUsecase()
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.EnrollmentSagaRepository)
+		assert.NotNil(t, result.CancelEnrollmentUsecase)
+		assert.NotNil(t, result.UnitOfWork)
+	})
+}
+
+func TestExpireEnrollmentSagasUsecase_Execute(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockEnrollmentSagaRepository := repositoriesmock.NewMockIEnrollmentSagasRepository(controller)
+	mockCancelEnrollmentUsecase := usecasesmock.NewMockICancelEnrollmentUsecase(controller)
+	usecase := usecases.ExpireEnrollmentSagasUsecase{
+		EnrollmentSagaRepository: mockEnrollmentSagaRepository,
+		CancelEnrollmentUsecase:  mockCancelEnrollmentUsecase,
+		UnitOfWork:               transaction.NewMockTransaction(),
+	}
+	defer controller.Finish()
+
+	sagas := []models.EnrollmentSaga{
+		{ID: uuid.New(), StudentID: uuid.New(), CourseID: uuid.New(), Status: enums.ENROLLMENT_SAGA_STARTED},
+		{ID: uuid.New(), StudentID: uuid.New(), CourseID: uuid.New(), Status: enums.ENROLLMENT_SAGA_STARTED},
+	}
+
+	cancelOf := func(saga models.EnrollmentSaga) *models.EnrollmentCancel {
+		return &models.EnrollmentCancel{
+			StudentID:  saga.StudentID,
+			CourseID:   saga.CourseID,
+			Reason:     "timeout",
+			SagaStatus: enums.ENROLLMENT_SAGA_TIMED_OUT,
+		}
+	}
+
+	t.Run("Should return ErrOnFindExpiredEnrollmentSagas when occurred error in FindExpired", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnFindExpiredEnrollmentSagas)
+		mockEnrollmentSagaRepository.EXPECT().FindExpired(ctx, 10).Return(nil, errors.New("mock error in FindExpired"))
+		mockCancelEnrollmentUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		result, err := usecase.Execute(ctx, 10)
+
+		assert.EqualError(t, expected, err.Error())
+		assert.Zero(t, result)
+	})
+
+	t.Run("Should return error when occurred error in Cancel", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnFinishEnrollmentSaga)
+		mockEnrollmentSagaRepository.EXPECT().FindExpired(ctx, 10).Return(sagas, nil)
+		mockCancelEnrollmentUsecase.EXPECT().Execute(ctx, cancelOf(sagas[0])).Return(expected)
+
+		result, err := usecase.Execute(ctx, 10)
+
+		assert.ErrorIs(t, err, expected)
+		assert.Zero(t, result)
+	})
+
+	t.Run("Should cancel each expired saga as timed out", func(t *testing.T) {
+		mockEnrollmentSagaRepository.EXPECT().FindExpired(ctx, 10).Return(sagas, nil)
+		mockCancelEnrollmentUsecase.EXPECT().Execute(ctx, cancelOf(sagas[0])).Return(nil)
+		mockCancelEnrollmentUsecase.EXPECT().Execute(ctx, cancelOf(sagas[1])).Return(nil)
+
+		result, err := usecase.Execute(ctx, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	deadlettersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllDeadLetterUsecase(t *testing.T) {
+	t.Run("Should return new get all dead letter usecase", func(t *testing.T) {
+		result := usecases.NewGetAllDeadLetterUsecase()
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.DeadLetterRepository)
+	})
+}
+
+func TestGetAllDeadLetterUsecase_Execute(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockDeadLettersRepository := deadlettersmock.NewMockRepository(controller)
+	usecase := usecases.GetAllDeadLetterUsecase{DeadLetterRepository: mockDeadLettersRepository}
+	defer controller.Finish()
+
+	t.Run("Should return ErrOnFindAllDeadLetters when occurred error in FindAll", func(t *testing.T) {
+		params := &models.DeadLetterParams{Limit: 10}
+		mockDeadLettersRepository.EXPECT().FindAll(ctx, &deadletters.Filter{Limit: 10}).Return(nil, errors.New("mock error in FindAll"))
+
+		result, err := usecase.Execute(ctx, params)
+
+		assert.EqualError(t, err, exceptions.ErrOnFindAllDeadLetters)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should use default limit when limit is empty", func(t *testing.T) {
+		params := &models.DeadLetterParams{Status: deadletters.PENDING}
+		expected := []deadletters.DeadLetter{{ID: uuid.New(), Status: deadletters.PENDING}}
+		mockDeadLettersRepository.EXPECT().FindAll(ctx, &deadletters.Filter{Status: deadletters.PENDING, Limit: 50}).Return(expected, nil)
+
+		result, err := usecase.Execute(ctx, params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+}
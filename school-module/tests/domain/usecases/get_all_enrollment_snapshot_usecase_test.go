@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllEnrollmentSnapshotUsecase(t *testing.T) {
+	t.Run("Should return new get all enrollment snapshot usecase", func(t *testing.T) {
+		result := usecases.NewGetAllEnrollmentSnapshotUsecase()
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.EnrollmentRepository)
+	})
+}
+
+func TestGetAllEnrollmentSnapshotUsecase_Execute(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockEnrollmentRepository := repositoriesmock.NewMockIEnrollmentsRepository(controller)
+	usecase := usecases.GetAllEnrollmentSnapshotUsecase{EnrollmentRepository: mockEnrollmentRepository}
+	defer controller.Finish()
+
+	t.Run("Should return ErrOnFindAllEnrollmentSnapshots when occurred error in FindAllSnapshots", func(t *testing.T) {
+		params := &models.EnrollmentSnapshotParams{Limit: 10}
+		mockEnrollmentRepository.EXPECT().FindAllSnapshots(ctx, params).Return(nil, errors.New("mock error in FindAllSnapshots"))
+
+		result, err := usecase.Execute(ctx, params)
+
+		assert.EqualError(t, err, exceptions.ErrOnFindAllEnrollmentSnapshots)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should use default limit when limit is empty", func(t *testing.T) {
+		params := &models.EnrollmentSnapshotParams{AfterStudentID: uuid.New(), AfterCourseID: uuid.New()}
+		expected := []models.EnrollmentSnapshot{{StudentID: uuid.New(), CourseID: uuid.New(), Status: enums.ADIMPLENTE}}
+		mockEnrollmentRepository.EXPECT().FindAllSnapshots(ctx, &models.EnrollmentSnapshotParams{
+			AfterStudentID: params.AfterStudentID,
+			AfterCourseID:  params.AfterCourseID,
+			Limit:          500,
+		}).Return(expected, nil)
+
+		result, err := usecase.Execute(ctx, params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+}
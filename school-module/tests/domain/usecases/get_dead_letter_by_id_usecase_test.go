@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	deadlettersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDeadLetterByIdUsecase(t *testing.T) {
+	t.Run("Should return new get dead letter by id usecase", func(t *testing.T) {
+		result := usecases.NewGetDeadLetterByIdUsecase()
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.DeadLetterRepository)
+	})
+}
+
+func TestGetDeadLetterByIdUsecase_Execute(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockDeadLettersRepository := deadlettersmock.NewMockRepository(controller)
+	usecase := usecases.GetDeadLetterByIdUsecase{DeadLetterRepository: mockDeadLettersRepository}
+	defer controller.Finish()
+
+	id := uuid.New()
+
+	t.Run("Should return ErrOnFindDeadLetterById when occurred error in FindByID", func(t *testing.T) {
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(nil, errors.New("mock error in FindByID"))
+
+		result, err := usecase.Execute(ctx, id)
+
+		assert.EqualError(t, err, exceptions.ErrOnFindDeadLetterById)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should return ErrDeadLetterNotFound when return nil in FindByID", func(t *testing.T) {
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(nil, nil)
+
+		result, err := usecase.Execute(ctx, id)
+
+		assert.EqualError(t, err, exceptions.ErrDeadLetterNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should return dead letter", func(t *testing.T) {
+		expected := &deadletters.DeadLetter{ID: id, Queue: "FINANCIAL_INSTALLMENT_SCHOOL"}
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(expected, nil)
+
+		result, err := usecase.Execute(ctx, id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+}
@@ -0,0 +1,70 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEnrollmentSagaUsecase(t *testing.T) {
+	t.Run("Should return new get enrollment saga usecase", func(t *testing.T) {
+		result := usecases.NewGetEnrollmentSagaUsecase()
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.EnrollmentSagaRepository)
+	})
+}
+
+func TestGetEnrollmentSagaUsecase_Execute(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockEnrollmentSagaRepository := repositoriesmock.NewMockIEnrollmentSagasRepository(controller)
+	usecase := usecases.GetEnrollmentSagaUsecase{EnrollmentSagaRepository: mockEnrollmentSagaRepository}
+	defer controller.Finish()
+
+	params := &models.EnrollmentSagaParams{
+		StudentID: uuid.New(),
+		CourseID:  uuid.New(),
+	}
+
+	t.Run("Should return ErrOnFindEnrollmentSaga when occurred error in FindByStudentIdAndCourseId", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnFindEnrollmentSaga)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseId(ctx, params.StudentID, params.CourseID).Return(nil, errors.New("mock error in FindByStudentIdAndCourseId"))
+
+		result, err := usecase.Execute(ctx, params)
+
+		assert.EqualError(t, expected, err.Error())
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should return ErrEnrollmentSagaNotFound when saga does not exist", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrEnrollmentSagaNotFound)
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseId(ctx, params.StudentID, params.CourseID).Return(nil, nil)
+
+		result, err := usecase.Execute(ctx, params)
+
+		assert.EqualError(t, expected, err.Error())
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should return enrollment saga", func(t *testing.T) {
+		expected := &models.EnrollmentSaga{
+			ID:        uuid.New(),
+			StudentID: params.StudentID,
+			CourseID:  params.CourseID,
+			Status:    enums.ENROLLMENT_SAGA_STARTED,
+		}
+		mockEnrollmentSagaRepository.EXPECT().FindByStudentIdAndCourseId(ctx, params.StudentID, params.CourseID).Return(expected, nil)
+
+		result, err := usecase.Execute(ctx, params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+}
@@ -0,0 +1,143 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters"
+	deadlettersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/deadletters/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type replayConsumer struct {
+	received []*messaging.ProviderMessage
+	err      error
+}
+
+func (c *replayConsumer) Consume(ctx context.Context, providerMessage *messaging.ProviderMessage) error {
+	c.received = append(c.received, providerMessage)
+	return c.err
+}
+
+func (c *replayConsumer) QueueName() string {
+	return "FINANCIAL_INSTALLMENT_SCHOOL"
+}
+
+func TestReplayDeadLetterUsecase(t *testing.T) {
+	t.Run("Should return new replay dead letter usecase with consumers by queue", func(t *testing.T) {
+		consumer := &replayConsumer{}
+		result := usecases.NewReplayDeadLetterUsecase(consumer)
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.DeadLetterRepository)
+		assert.Equal(t, consumer, result.Consumers["FINANCIAL_INSTALLMENT_SCHOOL"])
+	})
+}
+
+func TestReplayDeadLetterUsecase_Execute(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockDeadLettersRepository := deadlettersmock.NewMockRepository(controller)
+	defer controller.Finish()
+
+	id := uuid.New()
+	newDeadLetter := func(queue string, status deadletters.Status) *deadletters.DeadLetter {
+		return &deadletters.DeadLetter{
+			ID:        id,
+			Queue:     queue,
+			MessageID: uuid.New(),
+			Action:    "UPDATE_ACCOUNT_STATUS",
+			Payload:   `{"status":"ADIMPLENTE"}`,
+			Error:     "mock error in consume",
+			Attempts:  5,
+			Status:    status,
+		}
+	}
+	newUsecase := func(consumer messaging.QueueConsumer) *usecases.ReplayDeadLetterUsecase {
+		return &usecases.ReplayDeadLetterUsecase{
+			DeadLetterRepository: mockDeadLettersRepository,
+			Consumers:            map[string]messaging.QueueConsumer{consumer.QueueName(): consumer},
+		}
+	}
+
+	t.Run("Should return ErrDeadLetterNotFound when return nil in FindByID", func(t *testing.T) {
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(nil, nil)
+
+		result, err := newUsecase(&replayConsumer{}).Execute(ctx, id)
+
+		assert.EqualError(t, err, exceptions.ErrDeadLetterNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should return ErrDeadLetterAlreadyResolved when dead letter is not pending", func(t *testing.T) {
+		consumer := &replayConsumer{}
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(newDeadLetter("FINANCIAL_INSTALLMENT_SCHOOL", deadletters.DISCARDED), nil)
+
+		result, err := newUsecase(consumer).Execute(ctx, id)
+
+		assert.EqualError(t, err, exceptions.ErrDeadLetterAlreadyResolved)
+		assert.Nil(t, result)
+		assert.Empty(t, consumer.received)
+	})
+
+	t.Run("Should return ErrDeadLetterQueueNotFound when queue has no consumer", func(t *testing.T) {
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(newDeadLetter("UNKNOWN_QUEUE", deadletters.PENDING), nil)
+
+		result, err := newUsecase(&replayConsumer{}).Execute(ctx, id)
+
+		assert.EqualError(t, err, exceptions.ErrDeadLetterQueueNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should keep dead letter pending with the new error when replay fails", func(t *testing.T) {
+		consumer := &replayConsumer{err: errors.New("mock error in replay")}
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(newDeadLetter("FINANCIAL_INSTALLMENT_SCHOOL", deadletters.PENDING), nil)
+		mockDeadLettersRepository.EXPECT().UpdateStatus(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, model *deadletters.DeadLetter) error {
+				assert.Equal(t, deadletters.PENDING, model.Status)
+				assert.Equal(t, "mock error in replay", model.Error)
+				assert.Equal(t, 6, model.Attempts)
+				assert.False(t, model.ResolvedAt.Valid)
+				return nil
+			})
+
+		result, err := newUsecase(consumer).Execute(ctx, id)
+
+		assert.EqualError(t, err, exceptions.ErrDeadLetterReplayFailed)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should return ErrOnUpdateDeadLetterStatus when occurred error in UpdateStatus", func(t *testing.T) {
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(newDeadLetter("FINANCIAL_INSTALLMENT_SCHOOL", deadletters.PENDING), nil)
+		mockDeadLettersRepository.EXPECT().UpdateStatus(ctx, gomock.Any()).Return(errors.New("mock error in UpdateStatus"))
+
+		result, err := newUsecase(&replayConsumer{}).Execute(ctx, id)
+
+		assert.EqualError(t, err, exceptions.ErrOnUpdateDeadLetterStatus)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should replay original message and mark dead letter as replayed", func(t *testing.T) {
+		consumer := &replayConsumer{}
+		deadLetter := newDeadLetter("FINANCIAL_INSTALLMENT_SCHOOL", deadletters.PENDING)
+		mockDeadLettersRepository.EXPECT().FindByID(ctx, id).Return(deadLetter, nil)
+		mockDeadLettersRepository.EXPECT().UpdateStatus(ctx, deadLetter).Return(nil)
+
+		result, err := newUsecase(consumer).Execute(ctx, id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, deadletters.REPLAYED, result.Status)
+		assert.True(t, result.ResolvedAt.Valid)
+		assert.Len(t, consumer.received, 1)
+		assert.Equal(t, deadLetter.MessageID, consumer.received[0].ID)
+		assert.Equal(t, "UPDATE_ACCOUNT_STATUS", consumer.received[0].Action)
+
+		var payload map[string]string
+		assert.NoError(t, consumer.received[0].DecodeMessage(&payload))
+		assert.Equal(t, map[string]string{"status": "ADIMPLENTE"}, payload)
+	})
+}
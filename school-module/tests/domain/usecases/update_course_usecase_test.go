@@ -7,7 +7,9 @@ import (
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/exceptions"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/usecases"
+	producersmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers/mock"
 	repositoriesmock "github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories/mock"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/transaction"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -18,13 +20,16 @@ func TestUpdateCourseUsecase(t *testing.T) {
 		result := usecases.NewUpdateCourseUsecase()
 		assert.NotNil(t, result)
 		assert.NotNil(t, result.CourseRepository)
+		assert.NotNil(t, result.CourseUpdatedProducer)
+		assert.NotNil(t, result.UnitOfWork)
 	})
 }
 
 func TestUpdateCourseUsecase_Execute(t *testing.T) {
 	controller := gomock.NewController(t)
 	mockCourseRepository := repositoriesmock.NewMockICoursesRepository(controller)
-	usecase := usecases.UpdateCourseUsecase{CourseRepository: mockCourseRepository}
+	mockCourseUpdatedProducer := producersmock.NewMockICourseUpdatedProducer(controller)
+	usecase := usecases.UpdateCourseUsecase{CourseRepository: mockCourseRepository, CourseUpdatedProducer: mockCourseUpdatedProducer, UnitOfWork: transaction.NewMockTransaction()}
 	defer controller.Finish()
 
 	model := &models.CourseUpdate{
@@ -38,6 +43,7 @@ func TestUpdateCourseUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.ID).Return(nil, errors.New("mock error in ExistsById")).MaxTimes(1)
 		mockCourseRepository.EXPECT().FindByName(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockCourseRepository.EXPECT().Update(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockCourseUpdatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		err := usecase.Execute(ctx, model)
 
@@ -49,6 +55,7 @@ func TestUpdateCourseUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.ID).Return(nil, nil).MaxTimes(1)
 		mockCourseRepository.EXPECT().FindByName(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockCourseRepository.EXPECT().Update(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockCourseUpdatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		err := usecase.Execute(ctx, model)
 
@@ -60,6 +67,7 @@ func TestUpdateCourseUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.ID).Return(&notExists, nil).MaxTimes(1)
 		mockCourseRepository.EXPECT().FindByName(gomock.Any(), gomock.Any()).MaxTimes(0)
 		mockCourseRepository.EXPECT().Update(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockCourseUpdatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		err := usecase.Execute(ctx, model)
 
@@ -71,6 +79,7 @@ func TestUpdateCourseUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.ID).Return(&exists, nil).MaxTimes(1)
 		mockCourseRepository.EXPECT().FindByName(ctx, model.Name).Return(nil, errors.New("mock error in FindByName")).MaxTimes(1)
 		mockCourseRepository.EXPECT().Update(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockCourseUpdatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		err := usecase.Execute(ctx, model)
 
@@ -82,6 +91,7 @@ func TestUpdateCourseUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.ID).Return(&exists, nil).MaxTimes(1)
 		mockCourseRepository.EXPECT().FindByName(ctx, model.Name).Return(&models.Course{ID: uuid.New(), Name: model.Name}, nil).MaxTimes(1)
 		mockCourseRepository.EXPECT().Update(gomock.Any(), gomock.Any()).MaxTimes(0)
+		mockCourseUpdatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
 
 		err := usecase.Execute(ctx, model)
 
@@ -93,6 +103,19 @@ func TestUpdateCourseUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.ID).Return(&exists, nil).MaxTimes(1)
 		mockCourseRepository.EXPECT().FindByName(ctx, model.Name).Return(nil, nil).MaxTimes(1)
 		mockCourseRepository.EXPECT().Update(ctx, model).Return(errors.New("mock error in Update")).MaxTimes(1)
+		mockCourseUpdatedProducer.EXPECT().Send(gomock.Any(), gomock.Any()).MaxTimes(0)
+
+		err := usecase.Execute(ctx, model)
+
+		assert.EqualError(t, expected, err.Error())
+	})
+
+	t.Run("Should return ErrOnSendCourseUpdated when occurred error in Send", func(t *testing.T) {
+		expected := errors.New(exceptions.ErrOnSendCourseUpdated)
+		mockCourseRepository.EXPECT().ExistsById(ctx, model.ID).Return(&exists, nil).MaxTimes(1)
+		mockCourseRepository.EXPECT().FindByName(ctx, model.Name).Return(nil, nil).MaxTimes(1)
+		mockCourseRepository.EXPECT().Update(ctx, model).Return(nil).MaxTimes(1)
+		mockCourseUpdatedProducer.EXPECT().Send(ctx, model).Return(errors.New("mock error in Send")).MaxTimes(1)
 
 		err := usecase.Execute(ctx, model)
 
@@ -103,6 +126,7 @@ func TestUpdateCourseUsecase_Execute(t *testing.T) {
 		mockCourseRepository.EXPECT().ExistsById(ctx, model.ID).Return(&exists, nil).MaxTimes(1)
 		mockCourseRepository.EXPECT().FindByName(ctx, model.Name).Return(nil, nil).MaxTimes(1)
 		mockCourseRepository.EXPECT().Update(ctx, model).Return(nil).MaxTimes(1)
+		mockCourseUpdatedProducer.EXPECT().Send(ctx, model).Return(nil).MaxTimes(1)
 
 		err := usecase.Execute(ctx, model)
 
@@ -3,6 +3,7 @@ package producers
 import (
 	"testing"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
@@ -17,12 +18,20 @@ func TestCourseDeletedProducer_Send(t *testing.T) {
 		expected := &models.CourseDelete{ID: uuid.New()}
 
 		producerFn := func() error {
-			return producers.NewCourseDeletedProducer().Send(ctx, expected)
+			if err := producers.NewCourseDeletedProducer().Send(ctx, expected); err != nil {
+				return err
+			}
+
+			return relayOutbox()
 		}
-		resp, err := messaging.NewTestProducer[models.CourseDelete](producerFn, testQueue, 10).Execute()
+		resp, err := messaging.NewTestProducer[contracts.Event](producerFn, testQueue, 10).Execute()
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
-		assert.EqualValues(t, expected, resp)
+		assert.Equal(t, contracts.SchoolSource, resp.Source)
+
+		var data contracts.CourseDeletedV1
+		assert.NoError(t, resp.DecodeData(&data))
+		assert.EqualValues(t, contracts.CourseDeletedV1{ID: expected.ID}, data)
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/contracts"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
 	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/producers"
@@ -21,7 +22,8 @@ func TestEnrollmentCreatedProducer_Send(t *testing.T) {
 				ID: uuid.New(),
 			},
 			Course: models.EnrollmentCreatedCourse{
-				ID: uuid.New(),
+				ID:    uuid.New(),
+				Value: 1500,
 			},
 			Installments: uint8(1),
 			Status:       enums.ADIMPLENTE,
@@ -29,12 +31,26 @@ func TestEnrollmentCreatedProducer_Send(t *testing.T) {
 		}
 
 		producerFn := func() error {
-			return producers.NewEnrollmentCreatedProducer().Send(ctx, expected)
+			if err := producers.NewEnrollmentCreatedProducer().Send(ctx, expected); err != nil {
+				return err
+			}
+
+			return relayOutbox()
 		}
-		resp, err := messaging.NewTestProducer[models.EnrollmentCreated](producerFn, testQueue, 10).Execute()
+		resp, err := messaging.NewTestProducer[contracts.Event](producerFn, testQueue, 10).Execute()
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
-		assert.EqualValues(t, expected, resp)
+		assert.Equal(t, contracts.SchoolSource, resp.Source)
+
+		var data contracts.EnrollmentCreatedV2
+		assert.NoError(t, resp.DecodeData(&data))
+		assert.EqualValues(t, contracts.EnrollmentCreatedV2{
+			Student:      contracts.EnrollmentStudentV1{ID: expected.Student.ID},
+			Course:       contracts.EnrollmentCourseV2{ID: expected.Course.ID, Value: "1500.00"},
+			Installments: expected.Installments,
+			Status:       expected.Status.String(),
+			CreatedAt:    expected.CreatedAt,
+		}, data)
 	})
 }
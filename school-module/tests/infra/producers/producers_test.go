@@ -2,9 +2,12 @@ package producers
 
 import (
 	"context"
+	"os"
 	"testing"
 
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/eventing/outbox"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/base/test"
+	"github.com/colibriproject-dev/colibri-sdk-go/pkg/database/sqlDB"
 	"github.com/colibriproject-dev/colibri-sdk-go/pkg/messaging"
 )
 
@@ -13,8 +16,20 @@ var (
 )
 
 func TestMain(m *testing.M) {
+	os.Setenv("MIGRATION_SOURCE_URL", "../../../migrations")
+	test.InitializeSqlDBTest()
+	test.UsePostgresContainer(context.Background())
+	sqlDB.Initialize()
+
 	test.InitializeTestLocalstack(test.MountAbsolutPath("../../../development-environment/localstack"))
 	messaging.Initialize()
 
 	m.Run()
 }
+
+// relayOutbox publishes the messages stored by the producers, as the outbox
+// relay does in background.
+func relayOutbox() error {
+	_, err := outbox.NewRelay().Flush(ctx)
+	return err
+}
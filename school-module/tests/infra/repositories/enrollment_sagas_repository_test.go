@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/enums"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/domain/models"
+	"github.com/colibriproject-dev/colibri-sdk-go-examples/school-module/src/infra/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var enrollmentSagaRepository = repositories.NewEnrollmentSagasDBRepository()
+
+func TestEnrollmentSagaRepository(t *testing.T) {
+	assert.NoError(t, pc.Dataset(basePath, enrollmentDatasets...))
+
+	running := &models.EnrollmentSaga{
+		StudentID: enrollmentMockData[0].Student.ID,
+		CourseID:  enrollmentMockData[0].Course.ID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	expired := &models.EnrollmentSaga{
+		StudentID: enrollmentMockData[1].Student.ID,
+		CourseID:  enrollmentMockData[1].Course.ID,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+
+	t.Run("Should insert running sagas", func(t *testing.T) {
+		assert.NoError(t, enrollmentSagaRepository.Insert(ctx, running))
+		assert.NoError(t, enrollmentSagaRepository.Insert(ctx, expired))
+	})
+
+	t.Run("Should return error when enrollment already has a saga", func(t *testing.T) {
+		assert.Error(t, enrollmentSagaRepository.Insert(ctx, running))
+	})
+
+	t.Run("Should return nil when enrollment has no saga", func(t *testing.T) {
+		result, err := enrollmentSagaRepository.FindByStudentIdAndCourseId(ctx, enrollmentMockData[2].Student.ID, enrollmentMockData[2].Course.ID)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should return saga of the enrollment", func(t *testing.T) {
+		result, err := enrollmentSagaRepository.FindByStudentIdAndCourseId(ctx, running.StudentID, running.CourseID)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotEqual(t, uuid.Nil, result.ID)
+		assert.Equal(t, enums.ENROLLMENT_SAGA_STARTED, result.Status)
+		assert.False(t, result.AccountID.Valid)
+		assert.False(t, result.FinishedAt.Valid)
+	})
+
+	t.Run("Should return only expired running sagas", func(t *testing.T) {
+		result, err := enrollmentSagaRepository.FindExpired(ctx, 10)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, expired.StudentID, result[0].StudentID)
+		assert.Equal(t, expired.CourseID, result[0].CourseID)
+	})
+
+	t.Run("Should finish saga", func(t *testing.T) {
+		saga, err := enrollmentSagaRepository.FindByStudentIdAndCourseId(ctx, expired.StudentID, expired.CourseID)
+		assert.NoError(t, err)
+
+		saga.Status = enums.ENROLLMENT_SAGA_TIMED_OUT
+		saga.Reason = "timeout"
+		finished, err := enrollmentSagaRepository.Finish(ctx, saga)
+		assert.NoError(t, err)
+		assert.True(t, finished)
+
+		result, err := enrollmentSagaRepository.FindByStudentIdAndCourseId(ctx, expired.StudentID, expired.CourseID)
+		assert.NoError(t, err)
+		assert.Equal(t, enums.ENROLLMENT_SAGA_TIMED_OUT, result.Status)
+		assert.Equal(t, "timeout", result.Reason)
+		assert.True(t, result.FinishedAt.Valid)
+
+		pending, err := enrollmentSagaRepository.FindExpired(ctx, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("Should not finish a saga already finished", func(t *testing.T) {
+		saga, err := enrollmentSagaRepository.FindByStudentIdAndCourseIdForUpdate(ctx, expired.StudentID, expired.CourseID)
+		assert.NoError(t, err)
+
+		saga.Status = enums.ENROLLMENT_SAGA_COMPLETED
+		finished, err := enrollmentSagaRepository.Finish(ctx, saga)
+		assert.NoError(t, err)
+		assert.False(t, finished)
+
+		result, err := enrollmentSagaRepository.FindByStudentIdAndCourseId(ctx, expired.StudentID, expired.CourseID)
+		assert.NoError(t, err)
+		assert.Equal(t, enums.ENROLLMENT_SAGA_TIMED_OUT, result.Status)
+	})
+
+	t.Run("Should start a new saga once the previous one finished and return the latest", func(t *testing.T) {
+		again := &models.EnrollmentSaga{
+			StudentID: expired.StudentID,
+			CourseID:  expired.CourseID,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+		assert.NoError(t, enrollmentSagaRepository.Insert(ctx, again))
+
+		result, err := enrollmentSagaRepository.FindByStudentIdAndCourseId(ctx, expired.StudentID, expired.CourseID)
+		assert.NoError(t, err)
+		assert.Equal(t, enums.ENROLLMENT_SAGA_STARTED, result.Status)
+	})
+
+	t.Run("Should delete saga with its enrollment", func(t *testing.T) {
+		assert.NoError(t, enrollmentRepository.Delete(ctx, running.StudentID, running.CourseID))
+
+		result, err := enrollmentSagaRepository.FindByStudentIdAndCourseId(ctx, running.StudentID, running.CourseID)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
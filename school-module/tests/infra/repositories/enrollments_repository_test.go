@@ -78,7 +78,7 @@ func TestEnrollmentRepository_FindAllPaginated(t *testing.T) {
 func TestEnrollmentRepository_Insert(t *testing.T) {
 	assert.NoError(t, pc.Dataset(basePath, enrollmentDatasets...))
 
-	t.Run("Should return error when enrollment exists into enrollments table", func(t *testing.T) {
+	t.Run("Should return nil when enrollment not cancelled exists into enrollments table", func(t *testing.T) {
 		result, err := enrollmentRepository.Insert(ctx, &models.EnrollmentCreate{
 			StudentID:    enrollmentMockData[0].Student.ID,
 			CourseID:     enrollmentMockData[0].Course.ID,
@@ -86,14 +86,39 @@ func TestEnrollmentRepository_Insert(t *testing.T) {
 			Status:       enrollmentMockData[0].Status,
 		})
 
-		assert.Error(t, err)
+		assert.NoError(t, err)
 		assert.Nil(t, result)
 	})
 
+	t.Run("Should insert again the enrollment cancelled into enrollments table", func(t *testing.T) {
+		assert.NoError(t, enrollmentRepository.UpdateStatus(ctx, &models.EnrollmentUpdateStatus{
+			StudentID: enrollmentMockData[1].Student.ID,
+			CourseID:  enrollmentMockData[1].Course.ID,
+			Status:    enums.CANCELADO,
+		}))
+
+		exists, err := enrollmentRepository.ExistsByStudentIdAndCourseId(ctx, enrollmentMockData[1].Student.ID, enrollmentMockData[1].Course.ID)
+		assert.NoError(t, err)
+		assert.False(t, *exists)
+
+		result, err := enrollmentRepository.Insert(ctx, &models.EnrollmentCreate{
+			StudentID:    enrollmentMockData[1].Student.ID,
+			CourseID:     enrollmentMockData[1].Course.ID,
+			Installments: 2,
+			Status:       enums.PENDING_FINANCIAL,
+		})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.EqualValues(t, 2, result.Installments)
+		assert.EqualValues(t, enums.PENDING_FINANCIAL, result.Status)
+		assert.True(t, result.CreatedAt.After(enrollmentMockData[1].CreatedAt))
+	})
+
 	t.Run("Should insert and return enrollment when not exists into enrollments table", func(t *testing.T) {
 		expected := &models.EnrollmentCreated{
 			Student:      models.EnrollmentCreatedStudent{ID: enrollmentMockData[0].Student.ID},
-			Course:       models.EnrollmentCreatedCourse{ID: enrollmentMockData[1].Course.ID},
+			Course:       models.EnrollmentCreatedCourse{ID: enrollmentMockData[1].Course.ID, Value: enrollmentMockData[1].Course.Value},
 			Installments: 10,
 			Status:       enums.ADIMPLENTE,
 		}
@@ -110,6 +135,7 @@ func TestEnrollmentRepository_Insert(t *testing.T) {
 		assert.NotNil(t, result)
 		assert.EqualValues(t, expected.Student.ID, result.Student.ID)
 		assert.EqualValues(t, expected.Course.ID, result.Course.ID)
+		assert.EqualValues(t, expected.Course.Value, result.Course.Value)
 		assert.EqualValues(t, expected.Installments, result.Installments)
 		assert.EqualValues(t, expected.Status, result.Status)
 		assert.NotEmpty(t, result.CreatedAt)
@@ -153,3 +179,40 @@ func TestEnrollmentRepository_UpdateStatus(t *testing.T) {
 		assert.EqualValues(t, enums.ADIMPLENTE, result.Status)
 	})
 }
+
+func TestEnrollmentRepository_FindAllSnapshots(t *testing.T) {
+	assert.NoError(t, pc.Dataset(basePath, enrollmentDatasets...))
+
+	snapshotOf := func(enrollment models.Enrollment) models.EnrollmentSnapshot {
+		return models.EnrollmentSnapshot{
+			StudentID:    enrollment.Student.ID,
+			CourseID:     enrollment.Course.ID,
+			CourseValue:  enrollment.Course.Value,
+			Installments: enrollment.Installments,
+			Status:       enrollment.Status,
+			CreatedAt:    enrollment.CreatedAt,
+		}
+	}
+
+	t.Run("Should return enrollments ordered by student and course", func(t *testing.T) {
+		expected := []models.EnrollmentSnapshot{snapshotOf(enrollmentMockData[2]), snapshotOf(enrollmentMockData[1])}
+
+		result, err := enrollmentRepository.FindAllSnapshots(ctx, &models.EnrollmentSnapshotParams{Limit: 2})
+
+		assert.NoError(t, err)
+		assert.EqualValues(t, expected, result)
+	})
+
+	t.Run("Should return enrollments after the given student and course", func(t *testing.T) {
+		expected := []models.EnrollmentSnapshot{snapshotOf(enrollmentMockData[0])}
+
+		result, err := enrollmentRepository.FindAllSnapshots(ctx, &models.EnrollmentSnapshotParams{
+			AfterStudentID: enrollmentMockData[1].Student.ID,
+			AfterCourseID:  enrollmentMockData[1].Course.ID,
+			Limit:          2,
+		})
+
+		assert.NoError(t, err)
+		assert.EqualValues(t, expected, result)
+	})
+}